@@ -0,0 +1,109 @@
+package kenall
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// Address templates for kenall.NewAddressFormatter.
+const (
+	// AddressTemplateLine renders the address on a single line.
+	AddressTemplateLine = `{{.Prefecture}}{{.City}}{{.KyotoStreet}}{{.Town}}{{.Koaza}}{{wrap " " .Building ""}}{{wrap " " .Floor ""}}`
+	// AddressTemplateLabel renders the address for shipping labels with the postal code.
+	AddressTemplateLabel = `〒{{postal .PostalCode}}
+{{.Prefecture}}{{.City}}{{.KyotoStreet}}{{.Town}}{{.Koaza}}{{wrap "\n" (join " " .Building .Floor) ""}}`
+)
+
+// An AddressFormatter renders kenall.Address with a text/template, so that business documents
+// like invoices, labels and letters render the same address consistently.
+//
+// In addition to the fields of kenall.Address, the template can use the following functions:
+//
+//   - postal: hyphenates a postal code, e.g. "100-0001".
+//   - join: joins non-empty strings with the separator given first.
+//   - wrap: surrounds a non-empty string with the prefix and the suffix, it returns an empty string otherwise.
+type AddressFormatter struct {
+	tmpl *template.Template
+}
+
+// NewAddressFormatter creates kenall.AddressFormatter from the template text.
+func NewAddressFormatter(text string) (*AddressFormatter, error) {
+	tmpl, err := template.New("address").Funcs(template.FuncMap{
+		"postal": formatPostalCode,
+		"join":   joinNonEmpty,
+		"wrap":   wrapNonEmpty,
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("kenall: failed to parse an address template: %w", err)
+	}
+
+	return &AddressFormatter{tmpl: tmpl}, nil
+}
+
+// Format renders the address.
+func (f *AddressFormatter) Format(addr *Address) (string, error) {
+	var b strings.Builder
+	if err := f.tmpl.Execute(&b, addr); err != nil {
+		return "", fmt.Errorf("kenall: failed to format an address: %w", err)
+	}
+
+	return b.String(), nil
+}
+
+// FormatLines composes the address into the lines of Japanese order, the first line is from the prefecture
+// to the block and lot, and the second line is the building and the floor if they exist.
+//
+// The street of Kyoto precedes the town as in the conventional notation, e.g. "烏丸通御池上る二条殿町",
+// and the town is omitted if it is the note of Japan Post for the whole area, e.g. "以下に掲載がない場合".
+func (a *Address) FormatLines() []string {
+	town := a.Town
+	if isAreaNote(town) {
+		town = ""
+	}
+
+	lines := []string{a.Prefecture + a.City + a.KyotoStreet + town + a.Koaza + a.Corporation.BlockLot}
+	if building := joinNonEmpty(" ", a.Building, a.Floor); building != "" {
+		lines = append(lines, building)
+	}
+
+	return lines
+}
+
+// FormatLine composes the address into a single line of Japanese order, see kenall.Address.FormatLines.
+func (a *Address) FormatLine() string {
+	return strings.Join(a.FormatLines(), " ")
+}
+
+// isAreaNote reports whether the town is the note of Japan Post instead of the name of the town.
+func isAreaNote(town string) bool {
+	return town == "以下に掲載がない場合" || strings.HasSuffix(town, "の次に番地がくる場合") ||
+		(strings.HasSuffix(town, "一円") && town != "一円")
+}
+
+func formatPostalCode(code string) string {
+	if len(code) != 7 { //nolint: gomnd
+		return code
+	}
+
+	return code[:3] + "-" + code[3:]
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	nonEmpty := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p != "" {
+			nonEmpty = append(nonEmpty, p)
+		}
+	}
+
+	return strings.Join(nonEmpty, sep)
+}
+
+func wrapNonEmpty(prefix, s, suffix string) string {
+	if s == "" {
+		return ""
+	}
+
+	return prefix + s + suffix
+}
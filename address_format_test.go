@@ -0,0 +1,137 @@
+package kenall_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestAddressFormatter_Format(t *testing.T) {
+	t.Parallel()
+
+	addr := &kenall.Address{
+		PostalCode: "1066290",
+		Prefecture: "東京都",
+		City:       "港区",
+		Town:       "六本木",
+		Building:   "六本木ヒルズ森タワー",
+		Floor:      "18F",
+	}
+
+	kyoto := &kenall.Address{
+		PostalCode:  "6008216",
+		Prefecture:  "京都府",
+		City:        "京都市下京区",
+		KyotoStreet: "烏丸通七条下る",
+		Town:        "東塩小路町",
+	}
+
+	cases := map[string]struct {
+		template string
+		give     *kenall.Address
+		want     string
+	}{
+		"Line":           {template: kenall.AddressTemplateLine, give: addr, want: "東京都港区六本木 六本木ヒルズ森タワー 18F"},
+		"Label":          {template: kenall.AddressTemplateLabel, give: addr, want: "〒106-6290\n東京都港区六本木\n六本木ヒルズ森タワー 18F"},
+		"Without build":  {template: kenall.AddressTemplateLabel, give: &kenall.Address{PostalCode: "1000001", Prefecture: "東京都", City: "千代田区", Town: "千代田"}, want: "〒100-0001\n東京都千代田区千代田"},
+		"Kyoto line":     {template: kenall.AddressTemplateLine, give: kyoto, want: "京都府京都市下京区烏丸通七条下る東塩小路町"},
+		"Kyoto label":    {template: kenall.AddressTemplateLabel, give: kyoto, want: "〒600-8216\n京都府京都市下京区烏丸通七条下る東塩小路町"},
+		"Custom":         {template: `{{join "/" .Prefecture .Koaza .City}}{{wrap "(" .Floor ")"}}`, give: addr, want: "東京都/港区(18F)"},
+		"Invalid postal": {template: `{{postal .PostalCode}}`, give: &kenall.Address{PostalCode: "100"}, want: "100"},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := kenall.NewAddressFormatter(c.template)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := f.Format(c.give)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != c.want {
+				t.Errorf("give: %q, want: %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestNewAddressFormatter(t *testing.T) {
+	t.Parallel()
+
+	if _, err := kenall.NewAddressFormatter("{{.Prefecture"); err == nil {
+		t.Error("an error should not be nil")
+	}
+
+	f, err := kenall.NewAddressFormatter("{{.Unknown}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := f.Format(&kenall.Address{}); err == nil {
+		t.Error("an error should not be nil")
+	}
+}
+
+func TestAddress_FormatLines(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give     *kenall.Address
+		want     []string
+		wantLine string
+	}{
+		"Building": {
+			give:     &kenall.Address{Prefecture: "東京都", City: "港区", Town: "六本木", Building: "六本木ヒルズ森タワー", Floor: "18F"},
+			want:     []string{"東京都港区六本木", "六本木ヒルズ森タワー 18F"},
+			wantLine: "東京都港区六本木 六本木ヒルズ森タワー 18F",
+		},
+		"Kyoto street": {
+			give:     &kenall.Address{Prefecture: "京都府", City: "京都市中京区", Town: "二条殿町", KyotoStreet: "烏丸通御池上る"},
+			want:     []string{"京都府京都市中京区烏丸通御池上る二条殿町"},
+			wantLine: "京都府京都市中京区烏丸通御池上る二条殿町",
+		},
+		"Koaza": {
+			give:     &kenall.Address{Prefecture: "北海道", City: "石狩郡当別町", Town: "弁華別", Koaza: "番外地"},
+			want:     []string{"北海道石狩郡当別町弁華別番外地"},
+			wantLine: "北海道石狩郡当別町弁華別番外地",
+		},
+		"Area note": {
+			give:     &kenall.Address{Prefecture: "東京都", City: "千代田区", Town: "以下に掲載がない場合"},
+			want:     []string{"東京都千代田区"},
+			wantLine: "東京都千代田区",
+		},
+		"Whole area": {
+			give:     &kenall.Address{Prefecture: "長野県", City: "北佐久郡軽井沢町", Town: "軽井沢町一円"},
+			want:     []string{"長野県北佐久郡軽井沢町"},
+			wantLine: "長野県北佐久郡軽井沢町",
+		},
+		"Floor only": {
+			give:     &kenall.Address{Prefecture: "東京都", City: "新宿区", Town: "西新宿", Floor: "地階・階層不明"},
+			want:     []string{"東京都新宿区西新宿", "地階・階層不明"},
+			wantLine: "東京都新宿区西新宿 地階・階層不明",
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := c.give.FormatLines(); !reflect.DeepEqual(got, c.want) {
+				t.Errorf("give: %q, want: %q", got, c.want)
+			}
+
+			if got := c.give.FormatLine(); got != c.wantLine {
+				t.Errorf("give: %q, want: %q", got, c.wantLine)
+			}
+		})
+	}
+}
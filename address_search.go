@@ -0,0 +1,190 @@
+package kenall
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type (
+	// A SearchAddressesResponse is a result from the kenall service of the API to search addresses by a query.
+	SearchAddressesResponse struct {
+		ResponseMeta `json:"-"`
+
+		Version   Version    `json:"version"`
+		Query     Query      `json:"query"`
+		Addresses []*Address `json:"data"`
+		// Count is the total number of the matched addresses.
+		Count  int `json:"count"`
+		Offset int `json:"offset"`
+		Limit  int `json:"limit"`
+		// Facets are the numbers of the matched addresses grouped by the area under the facet, see kenall.WithFacet.
+		Facets []*Facet `json:"facets"`
+		// DecodeErrors are the errors of the malformed elements skipped by kenall.WithTolerantDecoding.
+		DecodeErrors []error `json:"-"`
+	}
+	// A Facet is the number of the matched addresses in the area.
+	Facet struct {
+		// Path is the area joined with slashes, e.g. "/東京都/千代田区".
+		Path  string
+		Count int
+	}
+	// A SearchOption provides a customize option for searching addresses.
+	SearchOption interface {
+		applySearch(*addressSearch)
+	}
+
+	addressSearch struct {
+		facet    string
+		limit    int
+		offset   int
+		requests []RequestOption
+	}
+	withFacet struct {
+		path string
+	}
+	withLimit struct {
+		n int
+	}
+	withOffset struct {
+		n int
+	}
+)
+
+var (
+	_ kanaFielder     = (*SearchAddressesResponse)(nil)
+	_ validator       = (*SearchAddressesResponse)(nil)
+	_ partialResponse = (*SearchAddressesResponse)(nil)
+
+	_ json.Unmarshaler = (*Facet)(nil)
+)
+
+func (w withFacet) applySearch(s *addressSearch) {
+	s.facet = w.path
+}
+
+// WithFacet requests the facets under the area, e.g. "/" for prefectures or "/東京都" for cities of Tokyo.
+func WithFacet(path string) SearchOption {
+	return withFacet{path: path}
+}
+
+func (w withLimit) applySearch(s *addressSearch) {
+	s.limit = w.n
+}
+
+// WithLimit limits the number of the returned addresses, zero means the default of the kenall service.
+func WithLimit(n int) SearchOption {
+	return withLimit{n: n}
+}
+
+func (w withOffset) applySearch(s *addressSearch) {
+	s.offset = w.n
+}
+
+// WithOffset skips the number of the matched addresses, e.g. for pagination with kenall.WithLimit.
+func WithOffset(n int) SearchOption {
+	return withOffset{n: n}
+}
+
+// SearchAddresses requests to the kenall service to search addresses by the query,
+// e.g. a part of the address, the furigana or the postal code.
+func (cli *Client) SearchAddresses(
+	ctx context.Context, query string, opts ...SearchOption,
+) (*SearchAddressesResponse, error) {
+	s := &addressSearch{}
+	for _, opt := range opts {
+		if ro, ok := opt.(RequestOption); ok {
+			s.requests = append(s.requests, ro)
+		}
+
+		opt.applySearch(s)
+	}
+
+	ctx = contextWithRequestOptions(ctx, s.requests)
+
+	query = strings.TrimSpace(query)
+	if query == "" || s.limit < 0 || s.offset < 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	v := url.Values{"q": []string{query}}
+	if s.facet != "" {
+		v.Set("facet", s.facet)
+	}
+
+	if s.limit > 0 {
+		v.Set("limit", strconv.Itoa(s.limit))
+	}
+
+	if s.offset > 0 {
+		v.Set("offset", strconv.Itoa(s.offset))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/postalcode/?"+v.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
+	}
+
+	var res SearchAddressesResponse
+	if err := cli.sendRequest("SearchAddresses", req, &res); err != nil {
+		return nil, fmt.Errorf(errFailedRequestFormat, err)
+	}
+
+	return &res, nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (f *Facet) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullLiteral) {
+		return nil
+	}
+
+	var tmp []json.RawMessage
+	if err := json.Unmarshal(data, &tmp); err != nil || len(tmp) != 2 { //nolint: gomnd
+		return fmt.Errorf("kenall: failed to parse Facet: %s", data) //nolint: goerr113
+	}
+
+	if err := json.Unmarshal(tmp[0], &f.Path); err != nil {
+		return fmt.Errorf("kenall: failed to parse the path of Facet: %w", err)
+	}
+
+	if err := json.Unmarshal(tmp[1], &f.Count); err != nil {
+		return fmt.Errorf("kenall: failed to parse the count of Facet: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SearchAddressesResponse) kanaFields() []*string {
+	fields := []*string{&r.Query.PrefectureKana.String, &r.Query.CityKana.String, &r.Query.TownKana.String}
+	for _, a := range r.Addresses {
+		if a == nil {
+			continue
+		}
+
+		fields = append(fields, a.kanaFields()...)
+	}
+
+	return fields
+}
+
+func (r *SearchAddressesResponse) validate(v *validation) {
+	for i, a := range r.Addresses {
+		if a == nil {
+			v.null("data[%d]", i)
+
+			continue
+		}
+
+		a.validate(v.at("data[%d].", i))
+	}
+}
+
+func (r *SearchAddressesResponse) setDecodeErrors(errs []error) {
+	r.DecodeErrors = errs
+}
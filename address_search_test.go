@@ -0,0 +1,119 @@
+package kenall_test
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+//go:embed kenalltest/testdata/search_addresses_facets.json
+var searchAddressesFacetsResponse []byte
+
+func TestClient_SearchAddresses(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/postalcode/" || q.Get("q") != "千代田" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if q.Get("facet") != "/" || q.Get("limit") != "1" || q.Get("offset") != "2" {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		_, _ = w.Write(searchAddressesFacetsResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		query     string
+		opts      []kenall.SearchOption
+		wantError error
+	}{
+		"Normal case":     {query: " 千代田 ", opts: []kenall.SearchOption{kenall.WithFacet("/"), kenall.WithLimit(1), kenall.WithOffset(2)}},
+		"Empty query":     {query: " ", wantError: kenall.ErrInvalidArgument},
+		"Negative limit":  {query: "千代田", opts: []kenall.SearchOption{kenall.WithLimit(-1)}, wantError: kenall.ErrInvalidArgument},
+		"Negative offset": {query: "千代田", opts: []kenall.SearchOption{kenall.WithOffset(-1)}, wantError: kenall.ErrInvalidArgument},
+		"Not found":       {query: "存在しない", wantError: kenall.ErrNotFound},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := cli.SearchAddresses(context.Background(), c.query, c.opts...)
+			if !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if res == nil {
+				return
+			}
+			if res.Count != 2 || res.Limit != 1 || len(res.Addresses) != 1 || res.Query.Q.String != "千代田" {
+				t.Errorf("give: %+v, want: the search result", res)
+			}
+			if len(res.Facets) != 2 || *res.Facets[0] != (kenall.Facet{Path: "/東京都/千代田区", Count: 1}) {
+				t.Errorf("give: %+v, want: the facets", res.Facets)
+			}
+		})
+	}
+}
+
+func TestFacet_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		want      kenall.Facet
+		wantError bool
+	}{
+		"Normal case":  {give: `["/東京都", 10]`, want: kenall.Facet{Path: "/東京都", Count: 10}},
+		"Null":         {give: `null`},
+		"Too short":    {give: `["/東京都"]`, wantError: true},
+		"Wrong path":   {give: `[1, 10]`, wantError: true},
+		"Wrong count":  {give: `["/東京都", "10"]`, wantError: true},
+		"Not an array": {give: `{}`, wantError: true},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var f kenall.Facet
+			if err := f.UnmarshalJSON([]byte(c.give)); (err != nil) != c.wantError {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if !c.wantError && f != c.want {
+				t.Errorf("give: %v, want: %v", f, c.want)
+			}
+		})
+	}
+}
+
+func TestSearchOptions(t *testing.T) {
+	t.Parallel()
+
+	for _, opt := range []kenall.SearchOption{kenall.WithFacet("/"), kenall.WithLimit(1), kenall.WithOffset(1)} {
+		if opt == nil {
+			t.Error("a return value should not be nil")
+		}
+	}
+}
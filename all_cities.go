@@ -0,0 +1,59 @@
+package kenall
+
+import (
+	"context"
+	"sort"
+)
+
+// A GetAllCitiesResponse is a result of kenall.Client.GetAllCities.
+type GetAllCitiesResponse struct {
+	ResponseMeta `json:"-"`
+
+	// Version is the oldest version of the responses, so that the cities are known to be as new as it.
+	Version Version `json:"version"`
+	// Cities are the cities of all prefectures in order of JIS X 0402 code.
+	Cities []*City `json:"data"`
+	// DecodeErrors are the errors of the malformed elements skipped by kenall.WithTolerantDecoding.
+	DecodeErrors []error `json:"-"`
+}
+
+// GetAllCities requests to the kenall service to get the cities of the 47 prefectures concurrently up to the limit
+// of kenall.WithMaxConcurrency, since the kenall service returns the cities of a single prefecture per request.
+// It returns the first error if any of the requests failed.
+func (cli *Client) GetAllCities(ctx context.Context, opts ...RequestOption) (*GetAllCitiesResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+	start := cli.clock.Now()
+
+	workers := cli.maxConcurrency
+	if workers > len(prefectures) {
+		workers = len(prefectures)
+	}
+
+	results := make([]*GetCityResponse, len(prefectures))
+	if err := runWorkers(ctx, workers, len(prefectures), func(ctx context.Context, i int) error {
+		res, err := cli.GetCity(ctx, prefectures[i].Code)
+		results[i] = res
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	ret := &GetAllCitiesResponse{}
+
+	for _, res := range results {
+		if !res.Version.IsZero() && (ret.Version.IsZero() || res.Version.Before(ret.Version)) {
+			ret.Version = res.Version
+		}
+
+		ret.Cities = append(ret.Cities, res.Cities...)
+		ret.DecodeErrors = append(ret.DecodeErrors, res.DecodeErrors...)
+		ret.aggregate(&res.ResponseMeta)
+	}
+
+	ret.Duration = cli.clock.Now().Sub(start)
+
+	sort.SliceStable(ret.Cities, func(i, j int) bool { return ret.Cities[i].JISX0402 < ret.Cities[j].JISX0402 })
+
+	return ret, nil
+}
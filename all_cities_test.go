@@ -0,0 +1,97 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_GetAllCities(t *testing.T) {
+	t.Parallel()
+
+	var inflight, peak atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inflight.Add(1)
+		defer inflight.Add(-1)
+
+		for p := peak.Load(); n > p && !peak.CompareAndSwap(p, n); p = peak.Load() {
+		}
+
+		time.Sleep(time.Millisecond)
+
+		code := strings.TrimPrefix(r.URL.Path, "/cities/")
+
+		version := "2023-05-31"
+		if code == "13" {
+			version = "2023-04-28"
+		}
+
+		// NOTE: the cities are responded in reverse order to test the sort.
+		body := fmt.Sprintf(`{"version":%q,"data":[{"jisx0402":"%s202","prefecture_code":"%s"},{"jisx0402":"%s201","prefecture_code":"%s"}]}`,
+			version, code, code, code, code)
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithMaxConcurrency(4))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cli.GetAllCities(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Cities) != 94 {
+		t.Errorf("give: %v, want: %v", len(res.Cities), 94)
+	}
+	if !sort.SliceIsSorted(res.Cities, func(i, j int) bool { return res.Cities[i].JISX0402 < res.Cities[j].JISX0402 }) {
+		t.Error("the cities should be sorted by JIS X 0402 code")
+	}
+	if res.Cities[0].JISX0402 != "01201" || res.Cities[93].JISX0402 != "47202" {
+		t.Errorf("give: %v and %v, want: 01201 and 47202", res.Cities[0].JISX0402, res.Cities[93].JISX0402)
+	}
+	if want := "2023-04-28"; res.Version.String() != want {
+		t.Errorf("give: %v, want: %v", res.Version, want)
+	}
+	if got := peak.Load(); got > 4 {
+		t.Errorf("give: %v, want: at most 4 concurrent requests", got)
+	}
+}
+
+func TestClient_GetAllCities_Error(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/cities/27" {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		_, _ = w.Write(cityResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetAllCities(context.Background()); !errors.Is(err, kenall.ErrInternalServerError) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInternalServerError)
+	}
+}
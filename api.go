@@ -0,0 +1,55 @@
+package kenall
+
+import (
+	"context"
+	"time"
+)
+
+// API is the interface of the kenall APIs, it is implemented by kenall.Client and kenalltest.FakeClient
+// to replace the client in tests of the downstream packages. The methods take kenall.RequestOption
+// or an option type accepting it to customize the requests of the call.
+type API interface {
+	GetAddress(ctx context.Context, postalCode string, opts ...RequestOption) (*GetAddressResponse, error)
+	GetAddressInto(ctx context.Context, postalCode string, res *GetAddressResponse, opts ...RequestOption) error
+	GetAddresses(
+		ctx context.Context, postalCodes []string, opts ...BatchOption,
+	) (map[string]*GetAddressResponse, error)
+	GetAddressesByOldCode(
+		ctx context.Context, oldCode string, opts ...RequestOption,
+	) (*GetAddressesByOldCodeResponse, error)
+	GetAddressesByCityCode(
+		ctx context.Context, jisx0402 string, opts ...RequestOption,
+	) (*GetAddressesByCityCodeResponse, error)
+	GetOfficeAddress(ctx context.Context, postalCode string, opts ...RequestOption) (*GetOfficeAddressResponse, error)
+	SearchAddresses(ctx context.Context, query string, opts ...SearchOption) (*SearchAddressesResponse, error)
+	GetNormalizeAddress(
+		ctx context.Context, address string, opts ...RequestOption,
+	) (*GetNormalizeAddressResponse, error)
+	GetCity(ctx context.Context, prefectureCode string, opts ...RequestOption) (*GetCityResponse, error)
+	GetAllCities(ctx context.Context, opts ...RequestOption) (*GetAllCitiesResponse, error)
+	GetCorporation(
+		ctx context.Context, corporateNumber string, opts ...CorporationSearchOption,
+	) (*GetCorporationResponse, error)
+	SearchCorporationsByFurigana(
+		ctx context.Context, furigana string, opts ...CorporationSearchOption,
+	) (*SearchCorporationsResponse, error)
+	GetWhoami(ctx context.Context, opts ...RequestOption) (*GetWhoamiResponse, error)
+	GetHolidays(ctx context.Context, opts ...RequestOption) (*GetHolidaysResponse, error)
+	GetHolidaysByYear(ctx context.Context, year int, opts ...RequestOption) (*GetHolidaysResponse, error)
+	GetHolidaysByPeriod(ctx context.Context, from, to time.Time, opts ...RequestOption) (*GetHolidaysResponse, error)
+	GetBusinessDays(ctx context.Context, date time.Time, opts ...RequestOption) (*GetBusinessDaysResponse, error)
+	GetBusinessDaysByPeriod(
+		ctx context.Context, from, to time.Time, opts ...RequestOption,
+	) (*GetBusinessDaysByPeriodResponse, error)
+	GetBanks(ctx context.Context, opts ...RequestOption) (*GetBanksResponse, error)
+	GetBank(ctx context.Context, bankCode string, opts ...RequestOption) (*GetBankResponse, error)
+	GetBankBranches(ctx context.Context, bankCode string, opts ...RequestOption) (*GetBankBranchesResponse, error)
+	GetBankBranch(
+		ctx context.Context, bankCode, branchCode string, opts ...RequestOption,
+	) (*GetBankBranchResponse, error)
+	GetInvoiceIssuer(
+		ctx context.Context, registrationNumber string, opts ...RequestOption,
+	) (*GetInvoiceIssuerResponse, error)
+}
+
+var _ API = (*Client)(nil)
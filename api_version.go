@@ -0,0 +1,62 @@
+package kenall
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	// BaseURL is the base URL of the kenall service without the API version.
+	BaseURL = "https://api.kenall.jp"
+	// DefaultAPIVersion is the API version of kenall.Endpoint.
+	DefaultAPIVersion = "v1"
+)
+
+// isAPIVersion reports whether the path segment is an API version, e.g. "v1".
+func isAPIVersion(s string) bool {
+	if len(s) < 2 || s[0] != 'v' { //nolint: gomnd
+		return false
+	}
+
+	for _, r := range s[1:] {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// versionedEndpoint replaces the API version at the end of the endpoint with the version,
+// the version is appended if the endpoint does not end with an API version.
+func versionedEndpoint(endpoint, version string) string {
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	if i := strings.LastIndexByte(endpoint, '/'); i >= 0 && isAPIVersion(endpoint[i+1:]) {
+		endpoint = endpoint[:i]
+	}
+
+	return endpoint + "/" + version
+}
+
+// overrideAPIVersion rewrites the request URL to the API version overridden for the operation,
+// see kenall.WithOperationAPIVersion.
+func (cli *Client) overrideAPIVersion(operation string, req *http.Request) {
+	version, ok := cli.operationVersions[operation]
+	if !ok {
+		return
+	}
+
+	from, err := url.Parse(strings.TrimSuffix(cli.Endpoint, "/"))
+	if err != nil || !strings.HasPrefix(req.URL.Path, from.Path) {
+		return
+	}
+
+	to, err := url.Parse(versionedEndpoint(cli.Endpoint, version))
+	if err != nil {
+		return
+	}
+
+	req.URL.Path = to.Path + strings.TrimPrefix(req.URL.Path, from.Path)
+	req.URL.RawPath = ""
+}
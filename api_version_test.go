@@ -0,0 +1,91 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		opts    []kenall.ClientOption
+		want    string
+		wantErr error
+	}{
+		"Default":                   {want: kenall.Endpoint},
+		"Version 2":                 {opts: []kenall.ClientOption{kenall.WithAPIVersion("v2")}, want: kenall.BaseURL + "/v2"},
+		"Custom endpoint":           {opts: []kenall.ClientOption{kenall.WithEndpoint("http://localhost/api/"), kenall.WithAPIVersion("v2")}, want: "http://localhost/api/v2"},
+		"Versioned endpoint":        {opts: []kenall.ClientOption{kenall.WithAPIVersion("v2"), kenall.WithEndpoint("http://localhost/v1")}, want: "http://localhost/v2"},
+		"Invalid version":           {opts: []kenall.ClientOption{kenall.WithAPIVersion("latest")}, wantErr: kenall.ErrInvalidArgument},
+		"Invalid operation version": {opts: []kenall.ClientOption{kenall.WithOperationAPIVersion("GetAddress", "2")}, wantErr: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", c.opts...)
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("give: %v, want: %v", err, c.wantErr)
+			}
+
+			if err == nil && cli.Endpoint != c.want {
+				t.Errorf("give: %s, want: %s", cli.Endpoint, c.want)
+			}
+		})
+	}
+}
+
+func TestWithOperationAPIVersion(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu    sync.Mutex
+		paths []string
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(srv.Close)
+
+	var families []kenall.EndpointFamily
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL+"/v1"),
+		kenall.WithOperationAPIVersion("GetCity", "v2"),
+		kenall.WithCallObserver(func(ctx context.Context, call *kenall.Call) (context.Context, func(*kenall.CallResult)) {
+			families = append(families, call.Family)
+
+			return ctx, nil
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	_, _ = cli.GetAddress(ctx, "1008105")
+	_, _ = cli.GetCity(ctx, "13")
+
+	want := []string{"/v1/postalcode/1008105", "/v2/cities/13"}
+	if len(paths) != len(want) || paths[0] != want[0] || paths[1] != want[1] {
+		t.Errorf("give: %v, want: %v", paths, want)
+	}
+
+	if len(families) != 2 || families[1] != kenall.EndpointFamilyCities {
+		t.Errorf("give: %v, want: the family of the overridden request", families)
+	}
+}
@@ -0,0 +1,169 @@
+package kenall
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// An AutocompleteResponse is the response of kenall.AutocompleteHandler.
+	AutocompleteResponse struct {
+		PostalCode string                 `json:"postal_code"`
+		Addresses  []*AutocompleteAddress `json:"addresses"`
+	}
+	// An AutocompleteAddress is the part of kenall.Address to fill an address form.
+	AutocompleteAddress struct {
+		Prefecture string `json:"prefecture"`
+		City       string `json:"city"`
+		Town       string `json:"town"`
+	}
+	// An AutocompleteOption provides a customize option for kenall.AutocompleteHandler.
+	AutocompleteOption interface {
+		applyAutocomplete(*autocompleteHandler)
+	}
+
+	autocompleteHandler struct {
+		cli            *Client
+		allowedOrigins []string
+		maxAge         time.Duration
+	}
+
+	withAllowedOrigins struct {
+		origins []string
+	}
+	withCORSMaxAge struct {
+		d time.Duration
+	}
+)
+
+// AutocompleteHandler returns http.Handler which responds to "?postal_code=" with kenall.AutocompleteResponse
+// for the autofill of address forms. The postal code is normalized as kenall.ParsePostalCode does.
+// It responds with 400 for an invalid postal code and with the status code of the kenall service on its errors.
+// Cross-origin requests are not allowed unless kenall.WithAllowedOrigins is given.
+func AutocompleteHandler(cli *Client, opts ...AutocompleteOption) http.Handler {
+	h := &autocompleteHandler{cli: cli}
+	for _, opt := range opts {
+		opt.applyAutocomplete(h)
+	}
+
+	return h
+}
+
+// ServeHTTP implements http.Handler interface.
+func (h *autocompleteHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.setCORSHeaders(w, r)
+
+	switch r.Method {
+	case http.MethodGet:
+	case http.MethodOptions:
+		w.WriteHeader(http.StatusNoContent)
+
+		return
+	default:
+		w.Header().Set("Allow", http.MethodGet+", "+http.MethodOptions)
+		writeProxyError(w, http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	code, err := h.cli.parsePostalCode(r.URL.Query().Get("postal_code"))
+	if err != nil {
+		writeProxyError(w, http.StatusBadRequest)
+
+		return
+	}
+
+	res, err := h.cli.GetAddress(r.Context(), code.String())
+	if err != nil {
+		writeProxyError(w, proxyStatusOf(err))
+
+		return
+	}
+
+	ar := &AutocompleteResponse{PostalCode: code.String(), Addresses: make([]*AutocompleteAddress, 0, len(res.Addresses))}
+	seen := make(map[AutocompleteAddress]bool, len(res.Addresses))
+
+	for _, a := range res.Addresses {
+		aa := AutocompleteAddress{Prefecture: a.Prefecture, City: a.City, Town: a.Town}
+		if seen[aa] {
+			continue
+		}
+
+		seen[aa] = true
+		ar.Addresses = append(ar.Addresses, &aa)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(ar)
+}
+
+// setCORSHeaders sets the CORS headers if the origin of the request is allowed,
+// the preflight headers are set only for OPTIONS requests.
+func (h *autocompleteHandler) setCORSHeaders(w http.ResponseWriter, r *http.Request) {
+	if len(h.allowedOrigins) == 0 {
+		return
+	}
+
+	w.Header().Add("Vary", "Origin")
+
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return
+	}
+
+	allowed := ""
+
+	for _, o := range h.allowedOrigins {
+		if o == "*" {
+			allowed = "*"
+
+			break
+		}
+
+		if strings.EqualFold(o, origin) {
+			allowed = origin
+		}
+	}
+
+	if allowed == "" {
+		return
+	}
+
+	w.Header().Set("Access-Control-Allow-Origin", allowed)
+
+	if r.Method != http.MethodOptions {
+		return
+	}
+
+	w.Header().Set("Access-Control-Allow-Methods", http.MethodGet+", "+http.MethodOptions)
+
+	if v := r.Header.Get("Access-Control-Request-Headers"); v != "" {
+		w.Header().Set("Access-Control-Allow-Headers", v)
+	}
+
+	if h.maxAge > 0 {
+		w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(h.maxAge/time.Second)))
+	}
+}
+
+func (w withAllowedOrigins) applyAutocomplete(h *autocompleteHandler) {
+	h.allowedOrigins = append(h.allowedOrigins, w.origins...)
+}
+
+// WithAllowedOrigins allows the cross-origin requests from the origins, e.g. "https://example.com",
+// "*" allows any origin.
+func WithAllowedOrigins(origins ...string) AutocompleteOption {
+	return withAllowedOrigins{origins: origins}
+}
+
+func (w withCORSMaxAge) applyAutocomplete(h *autocompleteHandler) {
+	h.maxAge = w.d
+}
+
+// WithCORSMaxAge sets how long the result of a preflight request can be cached by browsers.
+func WithCORSMaxAge(d time.Duration) AutocompleteOption {
+	return withCORSMaxAge{d: d}
+}
@@ -0,0 +1,119 @@
+package kenall_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestAutocompleteHandler(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		method   string
+		target   string
+		wantCode int
+		want     *kenall.AutocompleteResponse
+	}{
+		"Found": {
+			method: http.MethodGet, target: "/?postal_code=100-8105", wantCode: http.StatusOK,
+			want: &kenall.AutocompleteResponse{
+				PostalCode: "1008105",
+				Addresses:  []*kenall.AutocompleteAddress{{Prefecture: "東京都", City: "新宿区", Town: "西新宿"}},
+			},
+		},
+		"Invalid postal code": {method: http.MethodGet, target: "/?postal_code=100", wantCode: http.StatusBadRequest},
+		"Empty postal code":   {method: http.MethodGet, target: "/", wantCode: http.StatusBadRequest},
+		"Not found":           {method: http.MethodGet, target: "/?postal_code=0000000", wantCode: http.StatusNotFound},
+		"Preflight":           {method: http.MethodOptions, target: "/?postal_code=1008105", wantCode: http.StatusNoContent},
+		"Method not allowed":  {method: http.MethodPost, target: "/?postal_code=1008105", wantCode: http.StatusMethodNotAllowed},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			kenall.AutocompleteHandler(cli).ServeHTTP(rec, httptest.NewRequest(c.method, c.target, nil))
+
+			if rec.Code != c.wantCode {
+				t.Fatalf("give: %v, want: %v", rec.Code, c.wantCode)
+			}
+
+			if c.want == nil {
+				return
+			}
+
+			give := &kenall.AutocompleteResponse{}
+			if err := json.Unmarshal(rec.Body.Bytes(), give); err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(give, c.want) {
+				t.Errorf("give: %+v, want: %+v", give, c.want)
+			}
+		})
+	}
+}
+
+func TestAutocompleteHandler_CORS(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		opts       []kenall.AutocompleteOption
+		method     string
+		origin     string
+		wantOrigin string
+		wantMaxAge string
+	}{
+		"No origins":     {method: http.MethodGet, origin: "https://example.com"},
+		"Allowed":        {opts: []kenall.AutocompleteOption{kenall.WithAllowedOrigins("https://example.com")}, method: http.MethodGet, origin: "https://example.com", wantOrigin: "https://example.com"},
+		"Disallowed":     {opts: []kenall.AutocompleteOption{kenall.WithAllowedOrigins("https://example.com")}, method: http.MethodGet, origin: "https://example.org"},
+		"Wildcard":       {opts: []kenall.AutocompleteOption{kenall.WithAllowedOrigins("*")}, method: http.MethodGet, origin: "https://example.org", wantOrigin: "*"},
+		"Preflight":      {opts: []kenall.AutocompleteOption{kenall.WithAllowedOrigins("*"), kenall.WithCORSMaxAge(10 * time.Minute)}, method: http.MethodOptions, origin: "https://example.org", wantOrigin: "*", wantMaxAge: "600"},
+		"Max age on GET": {opts: []kenall.AutocompleteOption{kenall.WithAllowedOrigins("*"), kenall.WithCORSMaxAge(10 * time.Minute)}, method: http.MethodGet, origin: "https://example.org", wantOrigin: "*"},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(c.method, "/?postal_code=1008105", nil)
+			req.Header.Set("Origin", c.origin)
+
+			rec := httptest.NewRecorder()
+			kenall.AutocompleteHandler(cli, c.opts...).ServeHTTP(rec, req)
+
+			if give := rec.Header().Get("Access-Control-Allow-Origin"); give != c.wantOrigin {
+				t.Errorf("give: %v, want: %v", give, c.wantOrigin)
+			}
+
+			if give := rec.Header().Get("Access-Control-Max-Age"); give != c.wantMaxAge {
+				t.Errorf("give: %v, want: %v", give, c.wantMaxAge)
+			}
+		})
+	}
+}
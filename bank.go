@@ -0,0 +1,123 @@
+package kenall
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+const (
+	bankCodeLength   = 4
+	branchCodeLength = 3
+)
+
+// A GetBanksResponse is a result from the kenall service of the API to get all banks.
+type GetBanksResponse struct {
+	ResponseMeta `json:"-"`
+
+	Version Version `json:"version"`
+	Banks   []*Bank `json:"data"`
+}
+
+// A GetBankResponse is a result from the kenall service of the API to get the bank from the bank code.
+type GetBankResponse struct {
+	ResponseMeta `json:"-"`
+
+	Version Version `json:"version"`
+	Bank    *Bank   `json:"data"`
+}
+
+// A GetBankBranchesResponse is a result from the kenall service of the API to get the branches of the bank.
+type GetBankBranchesResponse struct {
+	ResponseMeta `json:"-"`
+
+	Version Version `json:"version"`
+	// Branches are keyed by the branch code, since more than one branch may share a branch code.
+	Branches map[string][]*BankBranch `json:"data"`
+}
+
+// A GetBankBranchResponse is a result from the kenall service of the API to get the branches from the branch code.
+type GetBankBranchResponse struct {
+	ResponseMeta `json:"-"`
+
+	Version  Version       `json:"version"`
+	Branches []*BankBranch `json:"data"`
+}
+
+// GetBanks requests to the kenall service to get all banks.
+func (cli *Client) GetBanks(ctx context.Context, opts ...RequestOption) (*GetBanksResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
+	var res GetBanksResponse
+	if err := cli.getBankAPI(ctx, "GetBanks", "/bank", &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetBank requests to the kenall service to get the bank by 4-digit bank code.
+func (cli *Client) GetBank(ctx context.Context, bankCode string, opts ...RequestOption) (*GetBankResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
+	if !isDigits(bankCode, bankCodeLength) {
+		return nil, ErrInvalidArgument
+	}
+
+	var res GetBankResponse
+	if err := cli.getBankAPI(ctx, "GetBank", "/bank/"+bankCode, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetBankBranches requests to the kenall service to get all branches of the bank by 4-digit bank code.
+func (cli *Client) GetBankBranches(
+	ctx context.Context, bankCode string, opts ...RequestOption,
+) (*GetBankBranchesResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
+	if !isDigits(bankCode, bankCodeLength) {
+		return nil, ErrInvalidArgument
+	}
+
+	var res GetBankBranchesResponse
+	if err := cli.getBankAPI(ctx, "GetBankBranches", "/bank/"+bankCode+"/branches", &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetBankBranch requests to the kenall service to get the branches of the bank
+// by 4-digit bank code and 3-digit branch code.
+func (cli *Client) GetBankBranch(
+	ctx context.Context, bankCode, branchCode string, opts ...RequestOption,
+) (*GetBankBranchResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
+	if !isDigits(bankCode, bankCodeLength) || !isDigits(branchCode, branchCodeLength) {
+		return nil, ErrInvalidArgument
+	}
+
+	var res GetBankBranchResponse
+	if err := cli.getBankAPI(ctx, "GetBankBranch", "/bank/"+bankCode+"/branches/"+branchCode, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+func (cli *Client) getBankAPI(ctx context.Context, operation, path string, res interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+path, nil)
+	if err != nil {
+		return fmt.Errorf(errFailedGenerateRequestFormat, err)
+	}
+
+	if err := cli.sendRequest(operation, req, res); err != nil {
+		return fmt.Errorf(errFailedRequestFormat, err)
+	}
+
+	return nil
+}
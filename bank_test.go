@@ -0,0 +1,133 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_GetBanks(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cli.GetBanks(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Banks) != 2 || res.Banks[0].Code != "0001" || res.Banks[1].Romaji != "mitsubishiyuuefujiei" {
+		t.Errorf("give: %+v, want: 2 banks", res.Banks)
+	}
+}
+
+func TestClient_GetBank(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		bankCode  string
+		wantError error
+		wantName  string
+	}{
+		"Normal case":       {bankCode: "0001", wantError: nil, wantName: "みずほ"},
+		"Invalid bank code": {bankCode: "001", wantError: kenall.ErrInvalidArgument},
+		"Not found":         {bankCode: "9999", wantError: kenall.ErrNotFound},
+	}
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := cli.GetBank(context.Background(), c.bankCode)
+			if !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if res != nil && res.Bank.Name != c.wantName {
+				t.Errorf("give: %v, want: %v", res.Bank.Name, c.wantName)
+			}
+		})
+	}
+}
+
+func TestClient_GetBankBranches(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetBankBranches(context.Background(), "abcd"); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+
+	res, err := cli.GetBankBranches(context.Background(), "0001")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Branches) != 2 || res.Branches["004"][0].Name != "丸の内中央" {
+		t.Errorf("give: %+v, want: the branches keyed by the branch code", res.Branches)
+	}
+}
+
+func TestClient_GetBankBranch(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		bankCode   string
+		branchCode string
+		wantError  error
+		wantName   string
+	}{
+		"Normal case":         {bankCode: "0001", branchCode: "001", wantError: nil, wantName: "東京営業部"},
+		"Invalid branch code": {bankCode: "0001", branchCode: "01", wantError: kenall.ErrInvalidArgument},
+		"Invalid bank code":   {bankCode: "01", branchCode: "001", wantError: kenall.ErrInvalidArgument},
+		"Not found":           {bankCode: "0001", branchCode: "999", wantError: kenall.ErrNotFound},
+	}
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := cli.GetBankBranch(context.Background(), c.bankCode, c.branchCode)
+			if !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if res != nil && res.Branches[0].Name != c.wantName {
+				t.Errorf("give: %v, want: %v", res.Branches[0].Name, c.wantName)
+			}
+		})
+	}
+}
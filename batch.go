@@ -0,0 +1,323 @@
+package kenall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultMaxConcurrency is the number of concurrent requests of the batch APIs without kenall.WithMaxConcurrency.
+const defaultMaxConcurrency = 4
+
+type (
+	// A BatchError is an error value that will be returned when some requests of a batch API failed,
+	// it carries the error for each failed key, e.g. the postal code.
+	BatchError struct {
+		Errors map[string]error
+	}
+	// A BatchOption provides a customize option for the batch APIs, e.g. kenall.Client.GetAddresses.
+	BatchOption interface {
+		applyBatch(*batch)
+	}
+
+	batch struct {
+		onProgress ProgressFunc
+		config     *BulkConfig
+		requests   []RequestOption
+	}
+	withBatchProgress struct {
+		fn ProgressFunc
+	}
+	withBatchConfig struct {
+		config BulkConfig
+	}
+)
+
+func (w withBatchProgress) applyBatch(b *batch) {
+	b.onProgress = w.fn
+}
+
+// WithBatchProgress calls the function with the progress each time a key of the batch is processed,
+// failures are counted as errors.
+func WithBatchProgress(fn ProgressFunc) BatchOption {
+	return withBatchProgress{fn: fn}
+}
+
+func (w withBatchConfig) applyBatch(b *batch) {
+	b.config = &w.config
+}
+
+// WithBatchConfig configures the concurrency and the request budget of the batch instead of
+// kenall.WithMaxConcurrency, the call fails with kenall.ErrInvalidArgument if the config exceeds
+// the rate limits of the client.
+func WithBatchConfig(config BulkConfig) BatchOption {
+	return withBatchConfig{config: config}
+}
+
+func newBatch(opts []BatchOption) *batch {
+	b := &batch{}
+	for _, opt := range opts {
+		if ro, ok := opt.(RequestOption); ok {
+			b.requests = append(b.requests, ro)
+		}
+
+		opt.applyBatch(b)
+	}
+
+	return b
+}
+
+// workers returns the number of the workers for n keys and the limiter waited for before each request,
+// the limiter does not limit the requests without kenall.WithBatchConfig.
+func (b *batch) workers(cli *Client, n int, families ...EndpointFamily) (int, *tokenBucket, error) {
+	workers, limiter := cli.maxConcurrency, newTokenBucket(Rate{})
+
+	if b.config != nil {
+		cfg, err := b.config.resolve(cli, families...)
+		if err != nil {
+			return 0, nil, err
+		}
+
+		workers, limiter = cfg.Workers, cfg.limiter()
+	}
+
+	if workers > n {
+		workers = n
+	}
+
+	return workers, limiter, nil
+}
+
+// Error implements error interface.
+func (e *BatchError) Error() string {
+	keys := e.keys()
+
+	details := make([]string, 0, len(keys))
+	for _, k := range keys {
+		details = append(details, k+": "+e.Errors[k].Error())
+	}
+
+	return fmt.Sprintf("kenall: %d of the batch requests failed, %s", len(keys), strings.Join(details, ", "))
+}
+
+// Is reports whether any of the errors matches the target, so that errors.Is works with the errors.
+func (e *BatchError) Is(target error) bool {
+	for _, err := range e.Unwrap() {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// As finds the first error matching the target in order of the keys, so that errors.As works with the errors.
+func (e *BatchError) As(target interface{}) bool {
+	for _, err := range e.Unwrap() {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Unwrap returns the errors in order of the keys.
+func (e *BatchError) Unwrap() []error {
+	keys := e.keys()
+
+	errs := make([]error, 0, len(keys))
+	for _, k := range keys {
+		errs = append(errs, e.Errors[k])
+	}
+
+	return errs
+}
+
+func (e *BatchError) keys() []string {
+	keys := make([]string, 0, len(e.Errors))
+	for k := range e.Errors {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// GetAddresses requests to the kenall service to get the addresses by postal codes concurrently
+// up to the limit of kenall.WithMaxConcurrency or kenall.WithBatchConfig. Duplicated postal codes are requested once
+// by the form normalized as kenall.ParsePostalCode does, e.g. "100-8105" and "1008105".
+// It returns the results keyed by the given postal codes with *kenall.BatchError for the failed postal codes,
+// the results of the others are returned even if some failed.
+func (cli *Client) GetAddresses(
+	ctx context.Context, postalCodes []string, opts ...BatchOption,
+) (map[string]*GetAddressResponse, error) {
+	b := newBatch(opts)
+	ctx = contextWithRequestOptions(ctx, b.requests)
+
+	var (
+		codes     = make([]string, 0, len(postalCodes))
+		seen      = make(map[string]bool, len(postalCodes))
+		spellings = make(map[string][]string, len(postalCodes))
+	)
+
+	for _, given := range postalCodes {
+		if seen[given] {
+			continue
+		}
+
+		seen[given] = true
+
+		// NOTE: an invalid postal code is requested as given to report the error of it.
+		code := given
+		if c, err := ParsePostalCode(given); err == nil {
+			code = c.String()
+		}
+
+		if _, ok := spellings[code]; !ok {
+			codes = append(codes, code)
+		}
+
+		spellings[code] = append(spellings[code], given)
+	}
+
+	workers, limiter, err := b.workers(cli, len(codes), EndpointFamilyPostalCode)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mu       sync.Mutex
+		results  = make(map[string]*GetAddressResponse, len(seen))
+		errs     = map[string]error{}
+		progress = newProgressTracker(b.onProgress, cli.clock, len(codes))
+	)
+
+	//nolint: errcheck
+	_ = runWorkers(ctx, workers, len(codes), func(ctx context.Context, i int) error {
+		var res *GetAddressResponse
+
+		err := limiter.Wait(ctx, cli.clock)
+		if err != nil {
+			err = fmt.Errorf("kenall: failed to wait for the rate limit: %w", err)
+		} else {
+			res, err = cli.GetAddress(ctx, codes[i])
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		for _, given := range spellings[codes[i]] {
+			if err != nil {
+				errs[given] = err
+			} else {
+				results[given] = res
+			}
+		}
+
+		progress.done(err != nil)
+
+		return nil
+	})
+
+	// NOTE: the postal codes not dispatched before the ctx is done are reported with the error of the ctx.
+	for given := range seen {
+		_, ok := results[given]
+		if _, failed := errs[given]; !ok && !failed {
+			errs[given] = fmt.Errorf(errFailedRequestFormat, ctx.Err())
+		}
+	}
+
+	if len(errs) > 0 {
+		return results, &BatchError{Errors: errs}
+	}
+
+	return results, nil
+}
+
+// A GetBusinessDaysByPeriodResponse is a result of kenall.Client.GetBusinessDaysByPeriod.
+type GetBusinessDaysByPeriodResponse struct {
+	ResponseMeta `json:"-"`
+
+	// Version is the latest version of the responses.
+	Version Version
+	// BusinessDays are the days of the period in order of date.
+	BusinessDays []*BusinessDay
+}
+
+// GetBusinessDaysByPeriod requests to the kenall service to check each date from from to to in Japan concurrently
+// up to the limit of kenall.WithMaxConcurrency, since the kenall service checks a single date per request.
+// The titles of the national holidays in the period are joined from kenall.Client.GetHolidaysByPeriod.
+// The period must be up to 366 days, it returns the first error if any of the requests failed.
+func (cli *Client) GetBusinessDaysByPeriod(
+	ctx context.Context, from, to time.Time, opts ...RequestOption,
+) (*GetBusinessDaysByPeriodResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+	start := cli.clock.Now()
+
+	if from.IsZero() || to.IsZero() {
+		return nil, ErrInvalidArgument
+	}
+
+	var dates []time.Time
+	for d, end := truncateDate(from), truncateDate(to); !d.After(end); d = d.AddDate(0, 0, 1) {
+		if len(dates) == maxClosedDays {
+			return nil, fmt.Errorf("kenall: the period must be up to %d days: %w", maxClosedDays, ErrInvalidArgument)
+		}
+
+		dates = append(dates, d)
+	}
+
+	if len(dates) == 0 {
+		return nil, fmt.Errorf("kenall: from must not be after to: %w", ErrInvalidArgument)
+	}
+
+	workers := cli.maxConcurrency
+	if workers > len(dates) {
+		workers = len(dates)
+	}
+
+	holidays, err := cli.GetHolidaysByPeriod(ctx, dates[0], dates[len(dates)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	titles := make(map[string]string, len(holidays.Holidays))
+	for _, h := range holidays.Holidays {
+		titles[h.In(jst).Format(RFC3339DateFormat)] = h.Title
+	}
+
+	results := make([]*GetBusinessDaysResponse, len(dates))
+	if err := runWorkers(ctx, workers, len(dates), func(ctx context.Context, i int) error {
+		res, err := cli.GetBusinessDays(ctx, dates[i])
+		results[i] = res
+
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	ret := &GetBusinessDaysByPeriodResponse{
+		BusinessDays: make([]*BusinessDay, 0, len(results)),
+	}
+	ret.aggregate(&holidays.ResponseMeta)
+
+	for i, res := range results {
+		if res.Version.After(ret.Version) {
+			ret.Version = res.Version
+		}
+
+		res.BusinessDay.HolidayTitle = titles[dates[i].Format(RFC3339DateFormat)]
+		ret.BusinessDays = append(ret.BusinessDays, res.BusinessDay)
+		ret.aggregate(&res.ResponseMeta)
+	}
+
+	ret.Duration = cli.clock.Now().Sub(start)
+
+	return ret, nil
+}
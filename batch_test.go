@@ -0,0 +1,242 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithMaxConcurrency(t *testing.T) {
+	t.Parallel()
+
+	if kenall.WithMaxConcurrency(8) == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestClient_GetAddresses(t *testing.T) {
+	t.Parallel()
+
+	var inflight, peak, requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inflight.Add(1)
+		defer inflight.Add(-1)
+
+		for p := peak.Load(); n > p && !peak.CompareAndSwap(p, n); p = peak.Load() {
+		}
+
+		requests.Add(1)
+		time.Sleep(10 * time.Millisecond)
+
+		if r.URL.Path != "/postalcode/1008105" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithMaxConcurrency(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cli.GetAddresses(context.Background(),
+		[]string{"1008105", "1000001", "1008105", "abc", "100-8105", "1000002", "1000003"})
+
+	var be *kenall.BatchError
+	if !errors.As(err, &be) {
+		t.Fatalf("give: %v, want: *kenall.BatchError", err)
+	}
+
+	if len(res) != 2 || res["1008105"] == nil || res["100-8105"] != res["1008105"] {
+		t.Errorf("give: %v, want: the result of 1008105 keyed by each given postal code", res)
+	}
+	if got := requests.Load(); got != 4 {
+		t.Errorf("give: %v, want: 4 requests without the invalid and duplicated postal codes", got)
+	}
+	if len(be.Errors) != 4 {
+		t.Errorf("give: %v, want: 4 errors", be.Errors)
+	}
+	if !errors.Is(be.Errors["abc"], kenall.ErrInvalidArgument) || !errors.Is(be.Errors["1000001"], kenall.ErrNotFound) {
+		t.Errorf("give: %v, want: the error for each postal code", be.Errors)
+	}
+	if !errors.Is(err, kenall.ErrNotFound) || errors.Is(err, kenall.ErrForbidden) {
+		t.Errorf("give: %v, want: errors.Is matching the errors of the postal codes", err)
+	}
+
+	var ae *kenall.APIError
+	if !errors.As(err, &ae) || ae.StatusCode != http.StatusNotFound {
+		t.Errorf("give: %v, want: errors.As finding *kenall.APIError", err)
+	}
+	if !strings.Contains(err.Error(), "4 of the batch requests failed") {
+		t.Errorf("give: %v", err)
+	}
+	if got := peak.Load(); got > 2 {
+		t.Errorf("give: %v, want: at most 2 concurrent requests", got)
+	}
+}
+
+func TestClient_GetAddresses_Canceled(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res, err := cli.GetAddresses(ctx, []string{"1008105", "1000001"})
+	if len(res) != 0 {
+		t.Errorf("give: %v, want: no results", res)
+	}
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("give: %v, want: %v", err, context.Canceled)
+	}
+}
+
+func TestClient_GetAddresses_Options(t *testing.T) {
+	t.Parallel()
+
+	var inflight, peak atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inflight.Add(1)
+		defer inflight.Add(-1)
+
+		for p := peak.Load(); n > p && !peak.CompareAndSwap(p, n); p = peak.Load() {
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		if r.URL.Path != "/postalcode/1008105" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL),
+		kenall.WithMaxConcurrency(4), kenall.WithRateLimit(1000, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var progress []kenall.Progress
+
+	_, err = cli.GetAddresses(context.Background(), []string{"1008105", "1000001", "1000002", "1000003"},
+		kenall.WithBatchConfig(kenall.BulkConfig{Workers: 1}),
+		kenall.WithBatchProgress(func(p kenall.Progress) { progress = append(progress, p) }),
+		kenall.WithHeader("X-Test", "batch"))
+
+	var be *kenall.BatchError
+	if !errors.As(err, &be) || len(be.Errors) != 3 {
+		t.Fatalf("give: %v, want: 3 errors", err)
+	}
+	if got := peak.Load(); got != 1 {
+		t.Errorf("give: %v, want: 1 concurrent request", got)
+	}
+	if len(progress) != 4 {
+		t.Fatalf("give: %v, want: 4 progress", len(progress))
+	}
+	if last := progress[3]; last.Processed != 4 || last.Total != 4 || last.Errors != 3 || last.ETA != 0 {
+		t.Errorf("give: %+v", last)
+	}
+
+	_, err = cli.GetAddresses(context.Background(), []string{"1008105"},
+		kenall.WithBatchConfig(kenall.BulkConfig{Workers: 1, QPS: 2000}))
+	if !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+}
+
+func TestClient_GetBusinessDaysByPeriod(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/holidays" {
+			_, _ = w.Write(holidaysResponse)
+
+			return
+		}
+
+		switch date := r.URL.Query().Get("date"); date {
+		case "2022-01-08", "2022-01-09", "2022-01-10":
+			_, _ = w.Write([]byte(`{"version":"2022-01-01","result":true}`))
+		case "2022-02-01":
+			w.WriteHeader(http.StatusBadRequest)
+		default:
+			_, _ = w.Write([]byte(`{"version":"2021-12-01","result":false}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithMaxConcurrency(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	res, err := cli.GetBusinessDaysByPeriod(ctx,
+		time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 11, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []bool{false, true, true, true, false}
+	if len(res.BusinessDays) != len(want) {
+		t.Fatalf("give: %v, want: %v", len(res.BusinessDays), len(want))
+	}
+
+	for i, bd := range res.BusinessDays {
+		if d := bd.Day(); d != 7+i || bd.LegalHoliday != want[i] {
+			t.Errorf("give: %v %v, want: %v %v", d, bd.LegalHoliday, 7+i, want[i])
+		}
+		if weekend := i == 1 || i == 2; bd.Weekend != weekend {
+			t.Errorf("give: %v, want: %v", bd.Weekend, weekend)
+		}
+	}
+
+	if got := res.BusinessDays[3].HolidayTitle; got != "成人の日" {
+		t.Errorf("give: %v, want: %v", got, "成人の日")
+	}
+
+	if got := res.Version.String(); got != "2022-01-01" {
+		t.Errorf("give: %v, want: %v", got, "2022-01-01")
+	}
+
+	cases := map[string]struct {
+		from time.Time
+		to   time.Time
+	}{
+		"Zero":     {from: time.Time{}, to: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		"Reversed": {from: time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC), to: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		"Too long": {from: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), to: time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)},
+		"Failed":   {from: time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC), to: time.Date(2022, 2, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for name, c := range cases {
+		if _, err := cli.GetBusinessDaysByPeriod(ctx, c.from, c.to); err == nil {
+			t.Errorf("%s: an error should not be nil", name)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package kenall
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// A BulkConfig configures the concurrency and the request budget of a bulk operation,
+// e.g. kenall.Exporter, kenall.Syncer and kenall.Rechecker.
+type BulkConfig struct {
+	// Workers is the number of concurrent workers, zero means 1.
+	Workers int
+	// QPS is the number of requests allowed per second for the operation,
+	// zero means the rate limit of the client for the endpoints used by the operation.
+	QPS float64
+	// Burst is the number of requests allowed at once for the operation,
+	// zero means the burst of the client for the endpoints used by the operation, or 1 without it.
+	Burst int
+}
+
+// resolve fills the defaults of the config from the rate limits of the client for the families
+// and validates the config does not exceed them, since the rate limits of the client
+// are expected to be configured with the limit of the account.
+func (c BulkConfig) resolve(cli *Client, families ...EndpointFamily) (BulkConfig, error) {
+	if c.Workers < 0 || c.QPS < 0 || c.Burst < 0 {
+		return c, fmt.Errorf("%w: negative bulk config", ErrInvalidArgument)
+	}
+
+	if c.Workers == 0 {
+		c.Workers = 1
+	}
+
+	limit, ok := cli.strictestRate(families...)
+	if !ok {
+		if c.Burst == 0 {
+			c.Burst = 1
+		}
+
+		return c, nil
+	}
+
+	if c.QPS == 0 {
+		c.QPS = limit.QPS
+	}
+
+	if c.Burst == 0 {
+		c.Burst = limit.Burst
+	}
+
+	if c.QPS > limit.QPS || c.Burst > limit.Burst {
+		return c, fmt.Errorf("%w: bulk config %v QPS with %d burst exceeds the rate limit %v QPS with %d burst",
+			ErrInvalidArgument, c.QPS, c.Burst, limit.QPS, limit.Burst)
+	}
+
+	return c, nil
+}
+
+func (c BulkConfig) limiter() *tokenBucket {
+	return newTokenBucket(Rate{QPS: c.QPS, Burst: c.Burst})
+}
+
+// strictestRate returns the smallest rate limit of the client among the families and the limit of all families.
+func (cli *Client) strictestRate(families ...EndpointFamily) (Rate, bool) {
+	var (
+		strictest Rate
+		found     bool
+	)
+
+	limiters := make([]*tokenBucket, 0, len(families)+1)
+	if cli.globalRateLimiter != nil {
+		limiters = append(limiters, cli.globalRateLimiter)
+	}
+
+	for _, f := range families {
+		if l, ok := cli.rateLimiters[f]; ok {
+			limiters = append(limiters, l)
+		}
+	}
+
+	for _, l := range limiters {
+		if l.rate.QPS <= 0 {
+			continue
+		}
+
+		if !found || l.rate.QPS < strictest.QPS {
+			strictest.QPS = l.rate.QPS
+		}
+
+		if !found || l.rate.Burst < strictest.Burst {
+			strictest.Burst = l.rate.Burst
+		}
+
+		found = true
+	}
+
+	return strictest, found
+}
+
+// runWorkers calls the function for each index from 0 to n-1 with the workers concurrently.
+// It returns the first error and cancels the context given to the remaining calls.
+func runWorkers(ctx context.Context, workers, n int, fn func(ctx context.Context, i int) error) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+		indexes  = make(chan int)
+	)
+
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range indexes {
+				if err := fn(ctx, i); err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+loop:
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			break loop
+		case indexes <- i:
+		}
+	}
+
+	close(indexes)
+	wg.Wait()
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	//nolint: wrapcheck
+	return ctx.Err()
+}
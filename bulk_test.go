@@ -0,0 +1,97 @@
+package kenall_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestBulkConfig(t *testing.T) {
+	t.Parallel()
+
+	srv := runExporterServer(t)
+
+	limits := kenall.WithEndpointRateLimits(map[kenall.EndpointFamily]kenall.Rate{
+		kenall.EndpointFamilyCities:     {QPS: 10, Burst: 5},
+		kenall.EndpointFamilyPostalCode: {QPS: 4, Burst: 2},
+	})
+
+	cases := map[string]struct {
+		opts      []kenall.ClientOption
+		give      kenall.BulkConfig
+		wantError error
+	}{
+		"No rate limit":       {opts: nil, give: kenall.BulkConfig{Workers: 4, QPS: 100, Burst: 10}, wantError: nil},
+		"Derived from client": {opts: []kenall.ClientOption{limits}, give: kenall.BulkConfig{Workers: 2}, wantError: nil},
+		"Within rate limit":   {opts: []kenall.ClientOption{limits}, give: kenall.BulkConfig{Workers: 2, QPS: 4, Burst: 2}, wantError: nil},
+		"Exceeding QPS":       {opts: []kenall.ClientOption{limits}, give: kenall.BulkConfig{QPS: 5}, wantError: kenall.ErrInvalidArgument},
+		"Exceeding burst":     {opts: []kenall.ClientOption{limits}, give: kenall.BulkConfig{Burst: 3}, wantError: kenall.ErrInvalidArgument},
+		"Negative workers":    {opts: nil, give: kenall.BulkConfig{Workers: -1}, wantError: kenall.ErrInvalidArgument},
+		"Exceeding global":    {opts: []kenall.ClientOption{kenall.WithRateLimit(3, 2)}, give: kenall.BulkConfig{QPS: 4}, wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", append(c.opts, kenall.WithEndpoint(srv.URL))...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			e := kenall.NewExporter(cli, kenall.ExportFormatCSV, 0)
+			e.Config = c.give
+
+			var buf bytes.Buffer
+			_, err = e.ExportPrefecture(context.Background(), "13", &buf)
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+
+			if err == nil {
+				want := "postal_code,jisx0402,prefecture,city,town,koaza,kyoto_street,building,floor,prefecture_kana,city_kana,town_kana\n" +
+					"1000000,13101,東京都,千代田区,,,,,,,,\n" +
+					"1040061,13102,東京都,中央区,銀座,,,,,,,\n"
+				if got := buf.String(); got != want {
+					t.Errorf("give: %q, want: %q", got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestSyncer_Workers(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	records := map[string]*kenall.StoredAddress{}
+	for _, id := range strings.Fields("a b c d e f g") {
+		records[id] = &kenall.StoredAddress{ID: id, PostalCode: "9999999"}
+	}
+
+	syncer := kenall.NewSyncer(cli, &memoryStore{records: records})
+	syncer.BatchSize = 3
+	syncer.Config = kenall.BulkConfig{Workers: 3}
+
+	summary, err := syncer.Sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (kenall.SyncSummary{Listed: 7, Unresolved: 7}); *summary != want {
+		t.Errorf("give: %+v, want: %+v", *summary, want)
+	}
+}
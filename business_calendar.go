@@ -0,0 +1,152 @@
+package kenall
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// maxClosedDays is the maximum number of consecutive closed days to look for a business day.
+const maxClosedDays = 366
+
+type (
+	// A WeekendPolicy is a set of weekdays on which the business is closed.
+	WeekendPolicy []time.Weekday
+	// A ClosedDay is a day on which the business is closed in addition to national holidays,
+	// e.g. the year-end and New Year holidays or the company foundation day.
+	ClosedDay struct {
+		// Year is the year of the closed day, zero means the day is closed every year.
+		Year  int        `json:"year,omitempty" yaml:"year,omitempty"`
+		Month time.Month `json:"month" yaml:"month"`
+		Day   int        `json:"day" yaml:"day"`
+		Title string     `json:"title,omitempty" yaml:"title,omitempty"`
+	}
+	// A BusinessCalendar decides business days from national holidays, the weekend policy and closed days.
+	BusinessCalendar struct {
+		Holidays *HolidayCache
+		Weekend  WeekendPolicy
+		Closures []ClosedDay
+	}
+)
+
+// NewBusinessCalendar creates kenall.BusinessCalendar.
+func NewBusinessCalendar(holidays *HolidayCache, weekend WeekendPolicy, closures ...ClosedDay) *BusinessCalendar {
+	return &BusinessCalendar{
+		Holidays: holidays,
+		Weekend:  weekend,
+		Closures: closures,
+	}
+}
+
+// SaturdayAndSunday returns the weekend policy closing on Saturdays and Sundays.
+func SaturdayAndSunday() WeekendPolicy {
+	return WeekendPolicy{time.Saturday, time.Sunday}
+}
+
+// YearEndClosures returns the year-end and New Year holidays from December 29 to January 3
+// which are commonly closed by Japanese companies, January 1 is excluded since it is a national holiday.
+func YearEndClosures() []ClosedDay {
+	return []ClosedDay{
+		{Month: time.December, Day: 29, Title: "年末休暇"},
+		{Month: time.December, Day: 30, Title: "年末休暇"},
+		{Month: time.December, Day: 31, Title: "年末休暇"},
+		{Month: time.January, Day: 2, Title: "年始休暇"},
+		{Month: time.January, Day: 3, Title: "年始休暇"},
+	}
+}
+
+// Contains reports whether the weekday is a part of the weekend.
+func (wp WeekendPolicy) Contains(wd time.Weekday) bool {
+	for _, w := range wp {
+		if w == wd {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Matches reports whether the date of t in Japan is the closed day.
+func (cd ClosedDay) Matches(t time.Time) bool {
+	y, m, d := t.In(jst).Date()
+
+	return (cd.Year == 0 || cd.Year == y) && cd.Month == m && cd.Day == d
+}
+
+func matchesAny(days []ClosedDay, t time.Time) bool {
+	for _, cd := range days {
+		if cd.Matches(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsBusinessDay reports whether the date of t in Japan is a business day.
+func (bc *BusinessCalendar) IsBusinessDay(ctx context.Context, t time.Time) (bool, error) {
+	return bc.isBusinessDay(ctx, t, map[int]map[string]bool{})
+}
+
+// AddBusinessDays returns the date n business days after t, or before t if n is negative.
+// If n is zero, t is returned as it is.
+func (bc *BusinessCalendar) AddBusinessDays(ctx context.Context, t time.Time, n int) (time.Time, error) {
+	memo := map[int]map[string]bool{}
+
+	return addBusinessDays(t, n, func(t time.Time) (bool, error) {
+		return bc.isBusinessDay(ctx, t, memo)
+	})
+}
+
+func addBusinessDays(t time.Time, n int, isBusinessDay func(time.Time) (bool, error)) (time.Time, error) {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	for closed := 0; n > 0; {
+		t = t.AddDate(0, 0, step)
+
+		ok, err := isBusinessDay(t)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		if !ok {
+			if closed++; closed > maxClosedDays {
+				return time.Time{}, fmt.Errorf("kenall: no business day is found: %w", ErrInvalidArgument)
+			}
+
+			continue
+		}
+
+		closed = 0
+		n--
+	}
+
+	return t, nil
+}
+
+func (bc *BusinessCalendar) isBusinessDay(ctx context.Context, t time.Time, memo map[int]map[string]bool) (bool, error) {
+	d := t.In(jst)
+	if bc.Weekend.Contains(d.Weekday()) || matchesAny(bc.Closures, d) {
+		return false, nil
+	}
+
+	holidays, ok := memo[d.Year()]
+	if !ok {
+		hs, err := bc.Holidays.Holidays(ctx, d.Year())
+		if err != nil {
+			return false, err
+		}
+
+		holidays = make(map[string]bool, len(hs))
+		for _, h := range hs {
+			holidays[h.In(jst).Format(RFC3339DateFormat)] = true
+		}
+
+		memo[d.Year()] = holidays
+	}
+
+	return !holidays[d.Format(RFC3339DateFormat)], nil
+}
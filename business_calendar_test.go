@@ -0,0 +1,104 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func newTestingBusinessCalendar(t *testing.T, closures ...kenall.ClosedDay) *kenall.BusinessCalendar {
+	t.Helper()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return kenall.NewBusinessCalendar(kenall.NewHolidayCache(cli, 0), kenall.SaturdayAndSunday(), closures...)
+}
+
+func TestBusinessCalendar_IsBusinessDay(t *testing.T) {
+	t.Parallel()
+
+	bc := newTestingBusinessCalendar(t, append(kenall.YearEndClosures(), kenall.ClosedDay{Year: 2022, Month: time.April, Day: 1, Title: "創立記念日"})...)
+
+	cases := map[string]struct {
+		give time.Time
+		want bool
+	}{
+		"Weekday":          {give: time.Date(2022, 1, 11, 0, 0, 0, 0, time.UTC), want: true},
+		"National holiday": {give: time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC), want: false},
+		"Weekend":          {give: time.Date(2022, 1, 8, 0, 0, 0, 0, time.UTC), want: false},
+		"Year-end closure": {give: time.Date(2022, 12, 29, 0, 0, 0, 0, time.UTC), want: false},
+		"New Year closure": {give: time.Date(2022, 1, 3, 0, 0, 0, 0, time.UTC), want: false},
+		"Foundation day":   {give: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC), want: false},
+		"Next foundation":  {give: time.Date(2023, 4, 3, 0, 0, 0, 0, time.UTC), want: true},
+		"Date in Japan":    {give: time.Date(2022, 1, 9, 15, 0, 0, 0, time.UTC), want: false},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := bc.IsBusinessDay(context.Background(), c.give)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != c.want {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestBusinessCalendar_AddBusinessDays(t *testing.T) {
+	t.Parallel()
+
+	bc := newTestingBusinessCalendar(t, kenall.YearEndClosures()...)
+
+	cases := map[string]struct {
+		give time.Time
+		n    int
+		want time.Time
+	}{
+		"Skip holiday and weekend": {give: time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC), n: 1, want: time.Date(2022, 1, 11, 0, 0, 0, 0, time.UTC)},
+		"Backward":                 {give: time.Date(2022, 1, 11, 0, 0, 0, 0, time.UTC), n: -1, want: time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC)},
+		"Across the year end":      {give: time.Date(2022, 12, 28, 0, 0, 0, 0, time.UTC), n: 1, want: time.Date(2023, 1, 4, 0, 0, 0, 0, time.UTC)},
+		"Zero":                     {give: time.Date(2022, 1, 8, 0, 0, 0, 0, time.UTC), n: 0, want: time.Date(2022, 1, 8, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := bc.AddBusinessDays(context.Background(), c.give, c.n)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(c.want) {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestBusinessCalendar_AddBusinessDays_NoBusinessDay(t *testing.T) {
+	t.Parallel()
+
+	bc := newTestingBusinessCalendar(t)
+	bc.Weekend = kenall.WeekendPolicy{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+
+	if _, err := bc.AddBusinessDays(context.Background(), time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), 1); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+}
@@ -0,0 +1,177 @@
+package kenall
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type (
+	// A Cache stores the responses of kenall.Client keyed by the request URL, see kenall.WithCache.
+	// The keys of the calls with the token overridden by kenall.WithToken also have the hash of the token.
+	// The values are opaque bytes, a Cache may drop them at any time, e.g. when they expire.
+	Cache interface {
+		Get(ctx context.Context, key string) ([]byte, bool)
+		Set(ctx context.Context, key string, value []byte)
+	}
+
+	// A MemoryCache is an in-memory Cache which expires values after the TTL
+	// and evicts the least recently used values over the limit.
+	MemoryCache struct {
+		maxEntries int
+		ttl        time.Duration
+		now        func() time.Time
+
+		mu      sync.Mutex
+		order   *list.List
+		entries map[string]*list.Element
+	}
+
+	memoryCacheEntry struct {
+		key       string
+		value     []byte
+		expiresAt time.Time
+	}
+
+	// cacheEntry is the value stored in a Cache, the body is kept as is to be decoded again.
+	cacheEntry struct {
+		Header   http.Header     `json:"header"`
+		Body     json.RawMessage `json:"body"`
+		StoredAt time.Time       `json:"stored_at"`
+	}
+)
+
+var _ Cache = (*MemoryCache)(nil)
+
+// NewMemoryCache creates kenall.MemoryCache, a zero maxEntries means no limit and a zero ttl means no expiration.
+func NewMemoryCache(maxEntries int, ttl time.Duration) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		now:        time.Now,
+		order:      list.New(),
+		entries:    map[string]*list.Element{},
+	}
+}
+
+// Get implements kenall.Cache interface.
+func (c *MemoryCache) Get(_ context.Context, key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	me := e.Value.(*memoryCacheEntry) //nolint: forcetypeassert
+	if !me.expiresAt.IsZero() && !c.now().Before(me.expiresAt) {
+		c.order.Remove(e)
+		delete(c.entries, key)
+
+		return nil, false
+	}
+
+	c.order.MoveToFront(e)
+
+	return me.value, true
+}
+
+// Set implements kenall.Cache interface.
+func (c *MemoryCache) Set(_ context.Context, key string, value []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	me := &memoryCacheEntry{key: key, value: value}
+	if c.ttl > 0 {
+		me.expiresAt = c.now().Add(c.ttl)
+	}
+
+	if e, ok := c.entries[key]; ok {
+		e.Value = me
+		c.order.MoveToFront(e)
+
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(me)
+
+	for c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		e := c.order.Back()
+		c.order.Remove(e)
+		delete(c.entries, e.Value.(*memoryCacheEntry).key) //nolint: forcetypeassert
+	}
+}
+
+// Len returns the number of the values including expired ones not evicted yet.
+func (c *MemoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// caches reports whether the response of the request is stored in the cache of the client.
+// The whoami API is not cached since it depends on the network of the client.
+func (cli *Client) caches(req *http.Request) bool {
+	return cli.cache != nil && req.Method == http.MethodGet &&
+		endpointFamilyOf(cli.Endpoint, req.URL) != EndpointFamilyWhoami
+}
+
+// cacheKey returns the key of the request in the caches, the responses for a token overridden by
+// kenall.WithToken are kept apart from the ones for the other tokens not to be served to another tenant.
+func cacheKey(req *http.Request) string {
+	token, ok := tokenOf(req.Context())
+	if !ok {
+		return req.URL.String()
+	}
+
+	sum := sha256.Sum256([]byte(token))
+
+	return req.URL.String() + "#token=" + hex.EncodeToString(sum[:])
+}
+
+// getCache returns the cached response of the request.
+func (cli *Client) getCache(req *http.Request) (*cacheEntry, bool) {
+	b, ok := cli.cache.Get(req.Context(), cacheKey(req))
+	if !ok {
+		return nil, false
+	}
+
+	var e cacheEntry
+	if err := cli.unmarshalJSON(b, &e); err != nil {
+		return nil, false
+	}
+
+	return &e, true
+}
+
+// isFresh reports whether the cached response can be used without revalidation, see kenall.WithCacheRevalidation.
+func (cli *Client) isFresh(e *cacheEntry) bool {
+	return cli.cacheMaxAge <= 0 || cli.clock.Now().Sub(e.StoredAt) < cli.cacheMaxAge
+}
+
+// setValidators makes the request conditional on the validators of the cached response.
+func setValidators(req *http.Request, e *cacheEntry) {
+	if v := e.Header.Get("ETag"); v != "" {
+		req.Header.Set("If-None-Match", v)
+	}
+
+	if v := e.Header.Get("Last-Modified"); v != "" {
+		req.Header.Set("If-Modified-Since", v)
+	}
+}
+
+func (cli *Client) storeCache(req *http.Request, body []byte, header http.Header) {
+	b, err := cli.marshalJSON(&cacheEntry{Header: header, Body: body, StoredAt: cli.clock.Now()})
+	if err != nil {
+		return
+	}
+
+	cli.cache.Set(req.Context(), cacheKey(req), b)
+}
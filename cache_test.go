@@ -0,0 +1,237 @@
+package kenall_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithCache(t *testing.T) {
+	t.Parallel()
+
+	if kenall.WithCache(kenall.NewMemoryCache(0, 0)) == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	c := kenall.NewMemoryCache(2, 0)
+	c.Set(ctx, "a", []byte("1"))
+	c.Set(ctx, "b", []byte("2"))
+
+	if _, ok := c.Get(ctx, "a"); !ok {
+		t.Error("a should be cached")
+	}
+
+	c.Set(ctx, "c", []byte("3"))
+
+	if _, ok := c.Get(ctx, "b"); ok {
+		t.Error("b should be evicted as the least recently used")
+	}
+	if v, ok := c.Get(ctx, "a"); !ok || string(v) != "1" {
+		t.Errorf("give: %s, want: 1", v)
+	}
+	if c.Len() != 2 {
+		t.Errorf("give: %v, want: %v", c.Len(), 2)
+	}
+
+	expiring := kenall.NewMemoryCache(0, 10*time.Millisecond)
+	expiring.Set(ctx, "a", []byte("1"))
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := expiring.Get(ctx, "a"); ok {
+		t.Error("a should be expired")
+	}
+	if expiring.Len() != 0 {
+		t.Errorf("give: %v, want: the expired value removed", expiring.Len())
+	}
+}
+
+func TestClient_Cache(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		switch r.URL.Path {
+		case "/postalcode/1008105":
+			_, _ = w.Write(addressResponse)
+		case "/whoami":
+			_, _ = w.Write(whoamiResponse)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithCache(kenall.NewMemoryCache(10, time.Hour)),
+		kenall.WithAddressHook(func(a *kenall.Address) { a.Town += "!" }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		res, err := cli.GetAddress(ctx, "1008105")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := res.Addresses[0].Town; got != "西新宿!" {
+			t.Errorf("give: %v, want: the hooks applied once to each response", got)
+		}
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := cli.GetAddress(ctx, "1000001"); err == nil {
+			t.Error("an error should not be nil")
+		}
+		if _, err := cli.GetWhoami(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if got := requests.Load(); got != 5 {
+		t.Errorf("give: %v, want: 1 for the cached address, 2 for errors and 2 for whoami", got)
+	}
+}
+
+func TestClient_Cache_Decoding(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		body := `{"version":"2021-04-30","data":[` +
+			`{"jisx0402":"13101","city":"千代田区"},` +
+			`{"jisx0402":13102,"city":"中央区"},` +
+			`{"jisx0402":"99103","city":"港区"}]}`
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	var warnings atomic.Int32
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithCache(kenall.NewMemoryCache(10, time.Hour)),
+		kenall.WithTolerantDecoding(nil),
+		kenall.WithValidation(func(w kenall.ValidationWarning) { warnings.Add(1) }),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var want int32
+
+	for i := 0; i < 2; i++ {
+		res, err := cli.GetCity(context.Background(), "13")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Cities) != 2 || len(res.DecodeErrors) != 1 {
+			t.Errorf("give: %v and %v, want: 2 cities and an error of the element 1", res.Cities, res.DecodeErrors)
+		}
+		if i == 0 {
+			want = 2 * warnings.Load()
+		}
+	}
+
+	if got := requests.Load(); got != 1 {
+		t.Errorf("give: %v, want: %v", got, 1)
+	}
+	if got := warnings.Load(); want == 0 || got != want {
+		t.Errorf("give: %v, want: %v, the same warnings for the cached response", got, want)
+	}
+}
+
+func TestWithCacheRevalidation(t *testing.T) {
+	t.Parallel()
+
+	if kenall.WithCacheRevalidation(time.Hour) == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestClient_CacheRevalidation(t *testing.T) {
+	t.Parallel()
+
+	var full, notModified atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` && r.Header.Get("If-Modified-Since") != "" {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		full.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		w.Header().Set("Last-Modified", "Fri, 01 Jan 2021 00:00:00 GMT")
+		_, _ = w.Write(holidaysResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithClock(clock),
+		kenall.WithCache(kenall.NewMemoryCache(0, 0)),
+		kenall.WithCacheRevalidation(time.Hour),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	steps := []struct {
+		advance         time.Duration
+		wantFull        int32
+		wantNotModified int32
+	}{
+		{advance: 0, wantFull: 1, wantNotModified: 0},
+		{advance: 30 * time.Minute, wantFull: 1, wantNotModified: 0},
+		{advance: 31 * time.Minute, wantFull: 1, wantNotModified: 1},
+		{advance: 59 * time.Minute, wantFull: 1, wantNotModified: 1},
+		{advance: 2 * time.Minute, wantFull: 1, wantNotModified: 2},
+	}
+
+	for i, s := range steps {
+		clock.now = clock.now.Add(s.advance)
+
+		res, err := cli.GetHolidays(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Holidays) == 0 {
+			t.Errorf("step %d: the holidays should be decoded", i)
+		}
+		if full.Load() != s.wantFull || notModified.Load() != s.wantNotModified {
+			t.Errorf("step %d: give: %v full and %v not modified, want: %v and %v",
+				i, full.Load(), notModified.Load(), s.wantFull, s.wantNotModified)
+		}
+	}
+}
@@ -0,0 +1,265 @@
+package kenall
+
+import (
+	"context"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// A Calendar is a serializable company calendar combining national holidays, the weekend policy and closed days.
+// It can be marshaled to JSON or YAML, loaded at startup and merged,
+// so that business units with different calendars can be served at once.
+type Calendar struct {
+	Name     string        `json:"name" yaml:"name"`
+	Weekend  WeekendPolicy `json:"weekend" yaml:"weekend"`
+	Holidays []ClosedDay   `json:"holidays" yaml:"holidays"`
+	Closures []ClosedDay   `json:"closures" yaml:"closures"`
+}
+
+var (
+	_ json.Marshaler           = WeekendPolicy(nil)
+	_ json.Unmarshaler         = (*WeekendPolicy)(nil)
+	_ encoding.TextMarshaler   = WeekendPolicy(nil)
+	_ encoding.TextUnmarshaler = (*WeekendPolicy)(nil)
+)
+
+// NewCalendar creates kenall.Calendar from holidays retrieved from the kenall service.
+func NewCalendar(name string, weekend WeekendPolicy, holidays []*Holiday, closures ...ClosedDay) *Calendar {
+	c := &Calendar{
+		Name:     name,
+		Weekend:  weekend,
+		Holidays: make([]ClosedDay, 0, len(holidays)),
+		Closures: closures,
+	}
+
+	for _, h := range holidays {
+		y, m, d := h.In(jst).Date()
+		c.Holidays = append(c.Holidays, ClosedDay{Year: y, Month: m, Day: d, Title: h.Title})
+	}
+
+	return c
+}
+
+// Calendar creates kenall.Calendar from the holidays of the response with the weekend on Saturday and Sunday,
+// so that business days are computed client-side without calling the API.
+func (r *GetHolidaysResponse) Calendar(name string) *Calendar {
+	return NewCalendar(name, SaturdayAndSunday(), r.Holidays)
+}
+
+// Between returns the holidays on and between the dates of from and to in Japan in order of the response.
+func (r *GetHolidaysResponse) Between(from, to time.Time) []*Holiday {
+	from, to = truncateDate(from), truncateDate(to)
+
+	holidays := make([]*Holiday, 0, len(r.Holidays))
+	for _, h := range r.Holidays {
+		if d := truncateDate(h.Time); !d.Before(from) && !d.After(to) {
+			holidays = append(holidays, h)
+		}
+	}
+
+	return holidays
+}
+
+// LoadCalendar decodes kenall.Calendar from JSON.
+func LoadCalendar(r io.Reader) (*Calendar, error) {
+	var c Calendar
+	if err := json.NewDecoder(r).Decode(&c); err != nil {
+		return nil, fmt.Errorf("kenall: failed to decode Calendar: %w", err)
+	}
+
+	return &c, nil
+}
+
+// Calendar takes a snapshot of the business calendar with national holidays for the years.
+func (bc *BusinessCalendar) Calendar(ctx context.Context, name string, years ...int) (*Calendar, error) {
+	holidays := make([]*Holiday, 0, len(years)*16) //nolint: gomnd
+	for _, year := range years {
+		hs, err := bc.Holidays.Holidays(ctx, year)
+		if err != nil {
+			return nil, err
+		}
+
+		holidays = append(holidays, hs...)
+	}
+
+	return NewCalendar(name, append(WeekendPolicy(nil), bc.Weekend...), holidays,
+		append([]ClosedDay(nil), bc.Closures...)...), nil
+}
+
+// Merge returns a new calendar closed on any day closed by the calendar or the others, it keeps the name of the calendar.
+func (c *Calendar) Merge(others ...*Calendar) *Calendar {
+	merged := &Calendar{
+		Name:     c.Name,
+		Weekend:  append(WeekendPolicy(nil), c.Weekend...),
+		Holidays: append([]ClosedDay(nil), c.Holidays...),
+		Closures: append([]ClosedDay(nil), c.Closures...),
+	}
+
+	for _, o := range others {
+		for _, wd := range o.Weekend {
+			if !merged.Weekend.Contains(wd) {
+				merged.Weekend = append(merged.Weekend, wd)
+			}
+		}
+
+		merged.Holidays = appendClosedDays(merged.Holidays, o.Holidays)
+		merged.Closures = appendClosedDays(merged.Closures, o.Closures)
+	}
+
+	return merged
+}
+
+// IsHoliday reports whether the date of t in Japan is a national holiday of the calendar.
+func (c *Calendar) IsHoliday(t time.Time) bool {
+	return matchesAny(c.Holidays, t)
+}
+
+// IsBusinessDay reports whether the date of t in Japan is a business day of the calendar.
+func (c *Calendar) IsBusinessDay(t time.Time) bool {
+	return !c.Weekend.Contains(t.In(jst).Weekday()) && !matchesAny(c.Closures, t) && !c.IsHoliday(t)
+}
+
+// AddBusinessDays returns the date n business days after t, or before t if n is negative.
+// If n is zero, t is returned as it is.
+func (c *Calendar) AddBusinessDays(t time.Time, n int) (time.Time, error) {
+	return addBusinessDays(t, n, func(t time.Time) (bool, error) {
+		return c.IsBusinessDay(t), nil
+	})
+}
+
+// NextBusinessDay returns the first business day after the date of t.
+func (c *Calendar) NextBusinessDay(t time.Time) (time.Time, error) {
+	return c.AddBusinessDays(truncateDate(t), 1)
+}
+
+// WorkdaysBetween returns the number of business days on and between the dates of from and to in Japan,
+// it returns zero if to is before from.
+func (c *Calendar) WorkdaysBetween(from, to time.Time) int {
+	n := 0
+	for d, end := truncateDate(from), truncateDate(to); !d.After(end); d = d.AddDate(0, 0, 1) {
+		if c.IsBusinessDay(d) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// NthBusinessDay returns the nth business day of the month, or counted from the end of the month if n is negative.
+func (c *Calendar) NthBusinessDay(year int, month time.Month, n int) (time.Time, error) {
+	start := time.Date(year, month, 1, 0, 0, 0, 0, jst)
+
+	return nthBusinessDay(start, start.AddDate(0, 1, -1), n, c.IsBusinessDay)
+}
+
+// LastBusinessDay returns the last business day of the month.
+func (c *Calendar) LastBusinessDay(year int, month time.Month) (time.Time, error) {
+	return c.NthBusinessDay(year, month, -1)
+}
+
+// BusinessDayOnOrBefore returns the date of t if it is a business day, otherwise the previous business day,
+// e.g. for a payday on the 25th or the previous business day.
+func (c *Calendar) BusinessDayOnOrBefore(t time.Time) (time.Time, error) {
+	d := truncateDate(t)
+	if c.IsBusinessDay(d) {
+		return d, nil
+	}
+
+	return c.AddBusinessDays(d, -1)
+}
+
+// BusinessDayOnOrAfter returns the date of t if it is a business day, otherwise the next business day.
+func (c *Calendar) BusinessDayOnOrAfter(t time.Time) (time.Time, error) {
+	d := truncateDate(t)
+	if c.IsBusinessDay(d) {
+		return d, nil
+	}
+
+	return c.AddBusinessDays(d, 1)
+}
+
+// MarshalJSON implements json.Marshaler interface.
+func (wp WeekendPolicy) MarshalJSON() ([]byte, error) {
+	//nolint: wrapcheck
+	return json.Marshal(wp.names())
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (wp *WeekendPolicy) UnmarshalJSON(data []byte) error {
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return fmt.Errorf("kenall: failed to parse WeekendPolicy: %w", err)
+	}
+
+	return wp.UnmarshalText([]byte(strings.Join(names, ",")))
+}
+
+// MarshalText implements encoding.TextMarshaler interface, the weekdays are separated by commas.
+func (wp WeekendPolicy) MarshalText() ([]byte, error) {
+	return []byte(strings.Join(wp.names(), ",")), nil
+}
+
+func (wp WeekendPolicy) names() []string {
+	names := make([]string, 0, len(wp))
+	for _, wd := range wp {
+		names = append(names, strings.ToLower(wd.String()))
+	}
+
+	return names
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler interface.
+func (wp *WeekendPolicy) UnmarshalText(text []byte) error {
+	policy := WeekendPolicy{}
+
+	for _, name := range strings.Split(string(text), ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+
+		wd, ok := parseWeekday(name)
+		if !ok {
+			return fmt.Errorf("kenall: undefined weekday of WeekendPolicy, weekday = %s: %w", name, ErrInvalidArgument)
+		}
+
+		policy = append(policy, wd)
+	}
+
+	*wp = policy
+
+	return nil
+}
+
+func parseWeekday(name string) (time.Weekday, bool) {
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		if strings.EqualFold(wd.String(), name) {
+			return wd, true
+		}
+	}
+
+	return 0, false
+}
+
+func appendClosedDays(days, others []ClosedDay) []ClosedDay {
+	for _, o := range others {
+		found := false
+
+		for _, d := range days {
+			if d.Year == o.Year && d.Month == o.Month && d.Day == o.Day {
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			days = append(days, o)
+		}
+	}
+
+	return days
+}
@@ -0,0 +1,258 @@
+package kenall_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func newTestingCalendar(t *testing.T) *kenall.Calendar {
+	t.Helper()
+
+	var res kenall.GetHolidaysResponse
+	if err := json.Unmarshal(holidaysResponse, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	return kenall.NewCalendar("head office", kenall.SaturdayAndSunday(), res.Holidays, kenall.YearEndClosures()...)
+}
+
+func TestCalendar_JSON(t *testing.T) {
+	t.Parallel()
+
+	c := newTestingCalendar(t)
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(b, []byte(`"weekend":["saturday","sunday"]`)) {
+		t.Errorf("weekend should be marshaled with names, give: %s", b)
+	}
+
+	loaded, err := kenall.LoadCalendar(bytes.NewReader(b))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Name != c.Name || len(loaded.Weekend) != 2 || len(loaded.Holidays) != 16 || len(loaded.Closures) != 5 {
+		t.Errorf("give: %+v, want: %+v", loaded, c)
+	}
+
+	if _, err := kenall.LoadCalendar(bytes.NewReader([]byte(`{"weekend":["someday"]}`))); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+}
+
+func TestWeekendPolicy_Text(t *testing.T) {
+	t.Parallel()
+
+	b, err := kenall.SaturdayAndSunday().MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != "saturday,sunday" {
+		t.Errorf("give: %s, want: %s", b, "saturday,sunday")
+	}
+
+	var wp kenall.WeekendPolicy
+	if err := wp.UnmarshalText([]byte("Friday, saturday")); err != nil {
+		t.Fatal(err)
+	}
+	if len(wp) != 2 || wp[0] != time.Friday || wp[1] != time.Saturday {
+		t.Errorf("give: %v", wp)
+	}
+}
+
+func TestCalendar_Merge(t *testing.T) {
+	t.Parallel()
+
+	c := newTestingCalendar(t)
+	factory := &kenall.Calendar{
+		Name:     "factory",
+		Weekend:  kenall.WeekendPolicy{time.Sunday, time.Monday},
+		Closures: []kenall.ClosedDay{{Month: time.August, Day: 15, Title: "夏季休暇"}, {Month: time.December, Day: 29}},
+	}
+
+	merged := c.Merge(factory)
+	if merged.Name != "head office" {
+		t.Errorf("give: %v, want: %v", merged.Name, "head office")
+	}
+	if len(merged.Weekend) != 3 {
+		t.Errorf("give: %v, want: %v", len(merged.Weekend), 3)
+	}
+	if len(merged.Closures) != 6 {
+		t.Errorf("give: %v, want: %v", len(merged.Closures), 6)
+	}
+	if len(c.Weekend) != 2 || len(c.Closures) != 5 {
+		t.Error("the original calendar should not be modified")
+	}
+	if merged.IsBusinessDay(time.Date(2022, 8, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Error("2022-08-15 should not be a business day")
+	}
+}
+
+func TestCalendar_AddBusinessDays(t *testing.T) {
+	t.Parallel()
+
+	c := newTestingCalendar(t)
+
+	if !c.IsHoliday(time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Error("2022-01-10 should be a holiday")
+	}
+
+	got, err := c.AddBusinessDays(time.Date(2022, 1, 7, 0, 0, 0, 0, time.UTC), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2022, 1, 11, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("give: %v, want: %v", got, want)
+	}
+}
+
+func TestBusinessCalendar_Calendar(t *testing.T) {
+	t.Parallel()
+
+	bc := newTestingBusinessCalendar(t, kenall.YearEndClosures()...)
+
+	c, err := bc.Calendar(context.Background(), "head office", 2022)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Holidays) != 16 || len(c.Closures) != 5 || len(c.Weekend) != 2 {
+		t.Errorf("give: %+v", c)
+	}
+	if c.IsBusinessDay(time.Date(2022, 12, 30, 0, 0, 0, 0, time.UTC)) {
+		t.Error("2022-12-30 should not be a business day")
+	}
+}
+
+func TestCalendar_NthBusinessDay(t *testing.T) {
+	t.Parallel()
+
+	cal := newTestingCalendar(t)
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+
+	cases := map[string]struct {
+		month     time.Month
+		n         int
+		want      time.Time
+		wantError error
+	}{
+		"First business day":  {month: time.January, n: 1, want: time.Date(2022, 1, 4, 0, 0, 0, 0, jst), wantError: nil},
+		"Second to last":      {month: time.February, n: -2, want: time.Date(2022, 2, 25, 0, 0, 0, 0, jst), wantError: nil},
+		"Beyond business day": {month: time.January, n: 25, want: time.Time{}, wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := cal.NthBusinessDay(2022, c.month, c.n)
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if !got.Equal(c.want) {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+
+	last, err := cal.LastBusinessDay(2022, time.April)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2022, 4, 28, 0, 0, 0, 0, jst); !last.Equal(want) {
+		t.Errorf("give: %v, want: %v", last, want)
+	}
+}
+
+func TestCalendar_BusinessDayOnOrBefore(t *testing.T) {
+	t.Parallel()
+
+	cal := newTestingCalendar(t)
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+
+	payday, err := cal.BusinessDayOnOrBefore(time.Date(2022, 9, 25, 0, 0, 0, 0, jst))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2022, 9, 22, 0, 0, 0, 0, jst); !payday.Equal(want) {
+		t.Errorf("give: %v, want: %v", payday, want)
+	}
+
+	settlement, err := cal.BusinessDayOnOrAfter(time.Date(2022, 5, 3, 10, 0, 0, 0, jst))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2022, 5, 6, 0, 0, 0, 0, jst); !settlement.Equal(want) {
+		t.Errorf("give: %v, want: %v", settlement, want)
+	}
+
+	same, err := cal.BusinessDayOnOrAfter(time.Date(2022, 5, 2, 10, 0, 0, 0, jst))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2022, 5, 2, 0, 0, 0, 0, jst); !same.Equal(want) {
+		t.Errorf("give: %v, want: %v", same, want)
+	}
+}
+
+func TestGetHolidaysResponse_Calendar(t *testing.T) {
+	t.Parallel()
+
+	var res kenall.GetHolidaysResponse
+	if err := json.Unmarshal(holidaysResponse, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	c := res.Calendar("")
+
+	if !c.IsHoliday(time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Error("2022-01-10 should be a holiday")
+	}
+
+	got, err := c.NextBusinessDay(time.Date(2022, 1, 7, 15, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2022, 1, 11, 0, 0, 0, 0, time.FixedZone("Asia/Tokyo", 9*60*60)); !got.Equal(want) {
+		t.Errorf("give: %v, want: %v", got, want)
+	}
+
+	cases := map[string]struct {
+		from time.Time
+		to   time.Time
+		want int
+	}{
+		"January":  {from: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), to: time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC), want: 20},
+		"Same day": {from: time.Date(2022, 1, 11, 0, 0, 0, 0, time.UTC), to: time.Date(2022, 1, 11, 0, 0, 0, 0, time.UTC), want: 1},
+		"Reversed": {from: time.Date(2022, 1, 31, 0, 0, 0, 0, time.UTC), to: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), want: 0},
+	}
+
+	for name, c2 := range cases {
+		if got := c.WorkdaysBetween(c2.from, c2.to); got != c2.want {
+			t.Errorf("%s: give: %v, want: %v", name, got, c2.want)
+		}
+	}
+}
+
+func TestGetHolidaysResponse_Between(t *testing.T) {
+	t.Parallel()
+
+	var res kenall.GetHolidaysResponse
+	if err := json.Unmarshal(holidaysResponse, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	got := res.Between(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC))
+	if len(got) != 2 || got[0].Title != "元日" || got[1].Title != "成人の日" {
+		t.Errorf("give: %+v", got)
+	}
+}
@@ -0,0 +1,93 @@
+package kenall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+type (
+	// A CheckpointStore persists checkpoints of bulk operations to resume them after a crash or deploy.
+	CheckpointStore interface {
+		// LoadCheckpoint returns the checkpoint of the job, an empty string if there is none.
+		LoadCheckpoint(ctx context.Context, job string) (string, error)
+		// SaveCheckpoint persists the checkpoint of the job, an empty checkpoint means the job is completed.
+		SaveCheckpoint(ctx context.Context, job, checkpoint string) error
+	}
+	// A FileCheckpointStore is a kenall.CheckpointStore which persists a checkpoint per file in the directory.
+	FileCheckpointStore struct {
+		dir string
+	}
+)
+
+var _ CheckpointStore = (*FileCheckpointStore)(nil)
+
+// NewFileCheckpointStore creates kenall.FileCheckpointStore with the directory, which must exist.
+func NewFileCheckpointStore(dir string) *FileCheckpointStore {
+	return &FileCheckpointStore{dir: dir}
+}
+
+// LoadCheckpoint implements kenall.CheckpointStore interface.
+func (s *FileCheckpointStore) LoadCheckpoint(_ context.Context, job string) (string, error) {
+	b, err := os.ReadFile(s.path(job))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("kenall: failed to load a checkpoint: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// SaveCheckpoint implements kenall.CheckpointStore interface.
+// The checkpoint is written to a temporary file and renamed not to be torn by a crash.
+func (s *FileCheckpointStore) SaveCheckpoint(_ context.Context, job, checkpoint string) error {
+	p := s.path(job)
+
+	if checkpoint == "" {
+		if err := os.Remove(p); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("kenall: failed to save a checkpoint: %w", err)
+		}
+
+		return nil
+	}
+
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, []byte(checkpoint), 0o600); err != nil { //nolint: gomnd
+		return fmt.Errorf("kenall: failed to save a checkpoint: %w", err)
+	}
+
+	if err := os.Rename(tmp, p); err != nil {
+		return fmt.Errorf("kenall: failed to save a checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+func (s *FileCheckpointStore) path(job string) string {
+	return filepath.Join(s.dir, url.PathEscape(job)+".checkpoint")
+}
+
+func loadCheckpoint(ctx context.Context, s CheckpointStore, job string) (string, error) {
+	if s == nil {
+		return "", nil
+	}
+
+	//nolint: wrapcheck
+	return s.LoadCheckpoint(ctx, job)
+}
+
+func saveCheckpoint(ctx context.Context, s CheckpointStore, job, checkpoint string) error {
+	if s == nil {
+		return nil
+	}
+
+	//nolint: wrapcheck
+	return s.SaveCheckpoint(ctx, job, checkpoint)
+}
@@ -0,0 +1,115 @@
+package kenall_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestFileCheckpointStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := kenall.NewFileCheckpointStore(t.TempDir())
+
+	if cp, err := s.LoadCheckpoint(ctx, "export/13"); err != nil || cp != "" {
+		t.Fatalf("give: %q, %v, want: empty", cp, err)
+	}
+
+	if err := s.SaveCheckpoint(ctx, "export/13", "13101"); err != nil {
+		t.Fatal(err)
+	}
+
+	if cp, err := s.LoadCheckpoint(ctx, "export/13"); err != nil || cp != "13101" {
+		t.Errorf("give: %q, %v, want: %q", cp, err, "13101")
+	}
+
+	if err := s.SaveCheckpoint(ctx, "export/13", ""); err != nil {
+		t.Fatal(err)
+	}
+
+	if cp, err := s.LoadCheckpoint(ctx, "export/13"); err != nil || cp != "" {
+		t.Errorf("give: %q, %v, want: empty", cp, err)
+	}
+}
+
+func TestExporter_Checkpoints(t *testing.T) {
+	t.Parallel()
+
+	srv := runExporterServer(t)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	store := kenall.NewFileCheckpointStore(t.TempDir())
+
+	if err := store.SaveCheckpoint(ctx, "export/13", "13101"); err != nil {
+		t.Fatal(err)
+	}
+
+	e := kenall.NewExporter(cli, kenall.ExportFormatCSV, 0)
+	e.Checkpoints = store
+
+	var buf bytes.Buffer
+	if _, err := e.ExportPrefecture(ctx, "13", &buf); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := "1040061,13102,東京都,中央区,銀座,,,,,,,"; strings.TrimSpace(buf.String()) != want {
+		t.Errorf("give: %q, want: %q", buf.String(), want)
+	}
+
+	if cp, err := store.LoadCheckpoint(ctx, "export/13"); err != nil || cp != "" {
+		t.Errorf("give: %q, %v, want: empty", cp, err)
+	}
+
+	if err := store.SaveCheckpoint(ctx, "export/13", "99999"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := e.ExportPrefecture(ctx, "13", &buf); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+}
+
+func TestSyncer_Checkpoints(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	store := kenall.NewFileCheckpointStore(t.TempDir())
+
+	if err := store.SaveCheckpoint(ctx, "sync", "2"); err != nil {
+		t.Fatal(err)
+	}
+
+	syncer := kenall.NewSyncer(cli, &memoryStore{records: map[string]*kenall.StoredAddress{
+		"1": {ID: "1", PostalCode: "9999999"},
+		"2": {ID: "2", PostalCode: "9999999"},
+		"3": {ID: "3", PostalCode: "9999999"},
+	}})
+	syncer.Checkpoints = store
+
+	summary, err := syncer.Sync(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if summary.Listed != 1 {
+		t.Errorf("give: %v, want: %v", summary.Listed, 1)
+	}
+}
@@ -0,0 +1,82 @@
+package kenall
+
+import (
+	"context"
+	"fmt"
+)
+
+// A GetAddressesByCityCodeResponse is a result of kenall.Client.GetAddressesByCityCode.
+type GetAddressesByCityCodeResponse struct {
+	ResponseMeta `json:"-"`
+
+	Version Version `json:"version"`
+	// City is the municipality of the JIS X 0402 code.
+	City      *City      `json:"city"`
+	Addresses []*Address `json:"data"`
+	// DecodeErrors are the errors of the malformed elements skipped by kenall.WithTolerantDecoding.
+	DecodeErrors []error `json:"-"`
+}
+
+// GetAddressesByCityCode requests to the kenall service to get all addresses in the municipality of the JIS X 0402
+// code, e.g. "13101", which is normalized as kenall.ParseJISX0402 does. The municipality is looked up by the city API
+// and its addresses are searched by the name page by page, only the addresses whose code matches exactly are returned.
+// It returns ErrNotFound if the code is not of a municipality, e.g. a designated city which consists of wards.
+func (cli *Client) GetAddressesByCityCode(
+	ctx context.Context, jisx0402 string, opts ...RequestOption,
+) (*GetAddressesByCityCodeResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+	start := cli.clock.Now()
+
+	code, err := ParseJISX0402(jisx0402)
+	if err != nil {
+		return nil, err
+	}
+
+	cities, err := cli.GetCity(ctx, code.PrefectureCode().String())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &GetAddressesByCityCodeResponse{Version: cities.Version}
+	res.aggregate(&cities.ResponseMeta)
+
+	for _, c := range cities.Cities {
+		if c.JISX0402 == code.String() {
+			res.City = c
+
+			break
+		}
+	}
+
+	if res.City == nil {
+		return nil, fmt.Errorf(errFailedRequestFormat, ErrNotFound)
+	}
+
+	pager := cli.SearchAddressesPager(ctx, res.City.Prefecture+res.City.City, searchPageLimit)
+
+	err = pager.walk(ctx, nil, func(page *SearchAddressesResponse) error {
+		res.Version = page.Version
+		res.DecodeErrors = append(res.DecodeErrors, page.DecodeErrors...)
+		res.aggregate(&page.ResponseMeta)
+
+		for _, a := range page.Addresses {
+			if a.JISX0402 == code.String() {
+				res.Addresses = append(res.Addresses, a)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res.Duration = cli.clock.Now().Sub(start)
+
+	return res, nil
+}
+
+// PostalCodes returns the distinct postal codes of the addresses in order of appearance.
+func (r *GetAddressesByCityCodeResponse) PostalCodes() []string {
+	return distinctPostalCodes(r.Addresses)
+}
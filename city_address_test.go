@@ -0,0 +1,87 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_GetAddressesByCityCode(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/cities/13" {
+			_, _ = w.Write(cityResponse)
+
+			return
+		}
+
+		q := r.URL.Query()
+		if r.URL.Path != "/postalcode/" || q.Get("q") != "東京都千代田区" || q.Get("limit") != "100" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		var body string
+		switch offset, _ := strconv.Atoi(q.Get("offset")); offset {
+		case 0:
+			body = `{"version":"2022-03-31","count":3,"data":[{"postal_code":"1000004","jisx0402":"13101"},{"postal_code":"1040061","jisx0402":"13102"}]}`
+		case 2:
+			body = `{"version":"2022-03-31","count":3,"data":[{"postal_code":"1008105","jisx0402":"13101"}]}`
+		default:
+			body = `{"version":"2022-03-31","count":3,"data":[]}`
+		}
+
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		give      string
+		want      []string
+		wantError error
+	}{
+		"Normal case":     {give: "13101", want: []string{"1000004", "1008105"}, wantError: nil},
+		"With the digit":  {give: "131016", want: []string{"1000004", "1008105"}, wantError: nil},
+		"Unknown city":    {give: "13999", want: nil, wantError: kenall.ErrNotFound},
+		"Invalid code":    {give: "13x01", want: nil, wantError: kenall.ErrInvalidArgument},
+		"Undefined pref.": {give: "99101", want: nil, wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := cli.GetAddressesByCityCode(context.Background(), c.give)
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if res == nil {
+				return
+			}
+			if !reflect.DeepEqual(res.PostalCodes(), c.want) {
+				t.Errorf("give: %v, want: %v", res.PostalCodes(), c.want)
+			}
+			if res.City.City != "千代田区" || res.Version.String() != "2022-03-31" {
+				t.Errorf("give: %+v", res)
+			}
+		})
+	}
+}
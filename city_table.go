@@ -0,0 +1,163 @@
+package kenall
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+//go:generate go run ./internal/cmd/citytablegen -out city_table_data.json
+
+// cityTableData is a snapshot of the city API responses generated by internal/cmd/citytablegen.
+// NOTE: it holds only the cities of Tokyo as of 2021-04-30 until it is regenerated for all prefectures.
+//
+//go:embed city_table_data.json
+var cityTableData []byte //nolint: gochecknoglobals
+
+var (
+	embeddedCityTable     *CityTable //nolint: gochecknoglobals
+	embeddedCityTableOnce sync.Once  //nolint: gochecknoglobals
+)
+
+type (
+	// A CityTable is a local table of cities looked up by JIS X 0402 code without API requests.
+	// It keeps a snapshot for each data version, so that a code can be resolved as of a version,
+	// e.g. for records written before a merger of municipalities.
+	CityTable struct {
+		mu        sync.RWMutex
+		snapshots []*citySnapshot
+	}
+
+	citySnapshot struct {
+		version Version
+		cities  map[string]*City
+	}
+)
+
+// NewCityTable creates kenall.CityTable from responses of the city API.
+func NewCityTable(responses ...*GetCityResponse) *CityTable {
+	t := &CityTable{}
+	for _, res := range responses {
+		t.Add(res)
+	}
+
+	return t
+}
+
+// LoadCityTable creates kenall.CityTable from a stream of JSON encoded responses of the city API,
+// e.g. a file embedded with go:embed that concatenates the responses of all prefectures.
+func LoadCityTable(r io.Reader) (*CityTable, error) {
+	t := &CityTable{}
+
+	for dec := json.NewDecoder(r); ; {
+		var res GetCityResponse
+		if err := dec.Decode(&res); errors.Is(err, io.EOF) {
+			return t, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("kenall: failed to decode CityTable: %w", err)
+		}
+
+		t.Add(&res)
+	}
+}
+
+// Add adds cities of the response to the snapshot of the response version.
+func (t *CityTable) Add(res *GetCityResponse) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	i := sort.Search(len(t.snapshots), func(i int) bool {
+		return !t.snapshots[i].version.Before(res.Version)
+	})
+	if i == len(t.snapshots) || !t.snapshots[i].version.Equal(res.Version) {
+		t.snapshots = append(t.snapshots, nil)
+		copy(t.snapshots[i+1:], t.snapshots[i:])
+		t.snapshots[i] = &citySnapshot{version: res.Version, cities: map[string]*City{}}
+	}
+
+	for _, c := range res.Cities {
+		t.snapshots[i].cities[c.JISX0402] = c.Clone()
+	}
+}
+
+// CityByJISX0402 returns the city for the JIS X 0402 code from the latest snapshot including the code,
+// with the version of the snapshot. A 6-digit code with the check digit is accepted as well.
+func (t *CityTable) CityByJISX0402(code string) (*City, Version, error) {
+	return t.lookup(code, func(Version) bool { return true })
+}
+
+// CityByJISX0402AsOf returns the city for the JIS X 0402 code from the latest snapshot not newer than the version.
+func (t *CityTable) CityByJISX0402AsOf(code string, asOf Version) (*City, Version, error) {
+	return t.lookup(code, func(v Version) bool { return !v.After(asOf) })
+}
+
+// CityByJISX0402 returns the city for the JIS X 0402 code from the table embedded in the package,
+// with the version of the snapshot. The embedded table holds only the cities of Tokyo for now,
+// use kenall.NewCityTable or kenall.LoadCityTable with the responses of kenall.Client.GetCity for the others.
+func CityByJISX0402(code string) (*City, Version, error) {
+	return embeddedCities().CityByJISX0402(code)
+}
+
+// CityByJISX0402AsOf returns the city for the JIS X 0402 code from the table embedded in the package
+// as of the version.
+func CityByJISX0402AsOf(code string, asOf Version) (*City, Version, error) {
+	return embeddedCities().CityByJISX0402AsOf(code, asOf)
+}
+
+func embeddedCities() *CityTable {
+	embeddedCityTableOnce.Do(func() {
+		t, err := LoadCityTable(bytes.NewReader(cityTableData))
+		if err != nil {
+			panic(err)
+		}
+
+		embeddedCityTable = t
+	})
+
+	return embeddedCityTable
+}
+
+func (t *CityTable) lookup(code string, available func(Version) bool) (*City, Version, error) {
+	if len(code) == 6 { //nolint: gomnd
+		code = code[:5]
+	}
+
+	if !isDigits(code, 5) { //nolint: gomnd
+		return nil, Version{}, ErrInvalidArgument
+	}
+
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	for i := len(t.snapshots) - 1; i >= 0; i-- {
+		s := t.snapshots[i]
+		if !available(s.version) {
+			continue
+		}
+
+		if c, ok := s.cities[code]; ok {
+			return c.Clone(), s.version, nil
+		}
+	}
+
+	return nil, Version{}, fmt.Errorf("kenall: the city is not found in the table, jisx0402 = %s: %w", code, ErrNotFound)
+}
+
+func isDigits(s string, n int) bool {
+	if len(s) != n {
+		return false
+	}
+
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,129 @@
+package kenall_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestCityTable_CityByJISX0402(t *testing.T) {
+	t.Parallel()
+
+	newer := bytes.Replace(cityResponse, []byte(`"2021-04-30"`), []byte(`"2022-04-30"`), 1)
+	newer = bytes.Replace(newer, []byte(`"千代田区"`), []byte(`"新千代田区"`), 1)
+
+	table, err := kenall.LoadCityTable(bytes.NewReader(append(append([]byte{}, cityResponse...), newer...)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	older := kenall.Version(time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	cases := map[string]struct {
+		code        string
+		asOf        *kenall.Version
+		wantCity    string
+		wantVersion time.Time
+		wantError   error
+	}{
+		"Latest":          {code: "13101", asOf: nil, wantCity: "新千代田区", wantVersion: time.Date(2022, 4, 30, 0, 0, 0, 0, time.UTC), wantError: nil},
+		"As of version":   {code: "13101", asOf: &older, wantCity: "千代田区", wantVersion: time.Date(2021, 4, 30, 0, 0, 0, 0, time.UTC), wantError: nil},
+		"With check digt": {code: "131016", asOf: nil, wantCity: "新千代田区", wantVersion: time.Date(2022, 4, 30, 0, 0, 0, 0, time.UTC), wantError: nil},
+		"Not found":       {code: "99999", asOf: nil, wantCity: "", wantVersion: time.Time{}, wantError: kenall.ErrNotFound},
+		"Invalid code":    {code: "1310", asOf: nil, wantCity: "", wantVersion: time.Time{}, wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				city *kenall.City
+				v    kenall.Version
+				err  error
+			)
+			if c.asOf != nil {
+				city, v, err = table.CityByJISX0402AsOf(c.code, *c.asOf)
+			} else {
+				city, v, err = table.CityByJISX0402(c.code)
+			}
+
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if city != nil && city.City != c.wantCity {
+				t.Errorf("give: %v, want: %v", city.City, c.wantCity)
+			}
+			if !time.Time(v).Equal(c.wantVersion) {
+				t.Errorf("give: %v, want: %v", time.Time(v), c.wantVersion)
+			}
+		})
+	}
+}
+
+func TestNewCityTable(t *testing.T) {
+	t.Parallel()
+
+	var res kenall.GetCityResponse
+	if err := json.Unmarshal(cityResponse, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	table := kenall.NewCityTable(&res)
+	if city, _, err := table.CityByJISX0402("13104"); err != nil || city.City != "新宿区" {
+		t.Errorf("give: %v, %v", city, err)
+	}
+
+	if _, err := kenall.LoadCityTable(bytes.NewReader([]byte("wrong"))); err == nil {
+		t.Error("an error should not be nil")
+	}
+}
+
+func TestCityByJISX0402(t *testing.T) {
+	t.Parallel()
+
+	before := kenall.Version(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	cases := map[string]struct {
+		code      string
+		asOf      *kenall.Version
+		wantCity  string
+		wantError error
+	}{
+		"Latest":       {code: "13101", asOf: nil, wantCity: "千代田区", wantError: nil},
+		"Before data":  {code: "13101", asOf: &before, wantCity: "", wantError: kenall.ErrNotFound},
+		"Unknown code": {code: "13999", asOf: nil, wantCity: "", wantError: kenall.ErrNotFound},
+		"Invalid code": {code: "1310", asOf: nil, wantCity: "", wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var (
+				city *kenall.City
+				err  error
+			)
+			if c.asOf != nil {
+				city, _, err = kenall.CityByJISX0402AsOf(c.code, *c.asOf)
+			} else {
+				city, _, err = kenall.CityByJISX0402(c.code)
+			}
+
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if city != nil && city.City != c.wantCity {
+				t.Errorf("give: %v, want: %v", city.City, c.wantCity)
+			}
+		})
+	}
+}
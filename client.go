@@ -1,68 +1,328 @@
 package kenall
 
 import (
+	"bytes"
 	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
-	"os"
 	"strconv"
 	"strings"
 	"time"
 )
 
 const (
-	// Endpoint is an endpoint provided by the kenall service.
-	Endpoint = "https://api.kenall.jp/v1"
+	// Endpoint is an endpoint provided by the kenall service, it is kenall.BaseURL with kenall.DefaultAPIVersion.
+	Endpoint = BaseURL + "/" + DefaultAPIVersion
 	// RFC3339DateFormat is the RFC3339-Date format for Go.
 	RFC3339DateFormat = "2006-01-02"
 
+	// maxQueryLength is the maximum length of an encoded query string to be sent in the URL.
+	maxQueryLength = 2000
+
 	errFailedGenerateRequestFormat = "kenall: failed to generate an http request: %w"
 	errFailedRequestFormat         = "kenall: failed to send a request for kenall service: %w"
 )
 
+const (
+	// EndpointFamilyPostalCode is the family of the postal code APIs.
+	EndpointFamilyPostalCode EndpointFamily = "postalcode"
+	// EndpointFamilyCities is the family of the city APIs.
+	EndpointFamilyCities EndpointFamily = "cities"
+	// EndpointFamilyHoujinbangou is the family of the corporate number APIs.
+	EndpointFamilyHoujinbangou EndpointFamily = "houjinbangou"
+	// EndpointFamilyWhoami is the family of the whoami APIs.
+	EndpointFamilyWhoami EndpointFamily = "whoami"
+	// EndpointFamilyHolidays is the family of the holiday APIs.
+	EndpointFamilyHolidays EndpointFamily = "holidays"
+	// EndpointFamilyBusinessDays is the family of the business day APIs.
+	EndpointFamilyBusinessDays EndpointFamily = "businessdays"
+	// EndpointFamilyBank is the family of the bank APIs.
+	EndpointFamilyBank EndpointFamily = "bank"
+	// EndpointFamilyInvoice is the family of the qualified invoice issuer APIs.
+	EndpointFamilyInvoice EndpointFamily = "invoice"
+)
+
 type (
 	// A Client implements API requests to the kenall service.
 	Client struct {
 		HTTPClient *http.Client
 		Endpoint   string
 
-		token string
+		token        string
+		retryPolicy  RetryPolicy
+		idempotency  map[EndpointFamily]bool
+		rateLimiters map[EndpointFamily]*tokenBucket
+		clock        Clock
+		versions     *versionTracker
+		kanaScript   KanaScript
+
+		// globalRateLimiter and rateLimiter limit the requests of all endpoint families.
+		globalRateLimiter *tokenBucket
+		rateLimiter       RateLimiter
+
+		tolerant           bool
+		decodeErrorHandler DecodeErrorHandler
+		validationHandler  ValidationHandler
+		hooks              decodeHooks
+		pooled             bool
+		stale              *staleCache
+		maxConcurrency     int
+		cache              Cache
+		cacheMaxAge        time.Duration
+		middlewares        []Middleware
+		observers          []CallObserver
+		apiVersion         string
+		operationVersions  map[string]string
+		tokenProvider      TokenProvider
+		strictValidation   bool
+		timeout            time.Duration
+		flights            *flightGroup
+		compression        bool
+		codec              *JSONCodec
 	}
+	// A TokenProvider returns the authorization token for each request, e.g. to fetch the rotated token
+	// from a secret manager, see kenall.WithTokenProvider.
+	TokenProvider func(ctx context.Context) (string, error)
 	// A ClientOption provides a customize option for kenall.Client.
 	ClientOption interface {
 		Apply(*Client)
 	}
+	// An EndpointFamily is a group of APIs sharing the first path segment, e.g. "postalcode".
+	EndpointFamily string
 )
 
-// NewClient creates kenall.Client with the authorization token provided by the kenall service.
+// NewClient creates kenall.Client with the authorization token provided by the kenall service,
+// the token may be empty if kenall.WithTokenProvider is given.
 func NewClient(token string, opts ...ClientOption) (*Client, error) {
-	if token == "" {
-		return nil, ErrInvalidArgument
-	}
-
 	cli := &Client{
-		HTTPClient: http.DefaultClient,
-		Endpoint:   Endpoint,
-		token:      token,
+		HTTPClient:     http.DefaultClient,
+		Endpoint:       Endpoint,
+		token:          token,
+		idempotency:    map[EndpointFamily]bool{},
+		rateLimiters:   map[EndpointFamily]*tokenBucket{},
+		clock:          systemClock{},
+		versions:       &versionTracker{last: map[EndpointFamily]Version{}},
+		maxConcurrency: defaultMaxConcurrency,
+		compression:    true,
 	}
 
 	for _, opt := range opts {
 		opt.Apply(cli)
 	}
 
+	if token == "" && cli.tokenProvider == nil {
+		return nil, ErrInvalidArgument
+	}
+
+	if cli.apiVersion != "" {
+		if !isAPIVersion(cli.apiVersion) {
+			return nil, ErrInvalidArgument
+		}
+
+		cli.Endpoint = versionedEndpoint(cli.Endpoint, cli.apiVersion)
+	}
+
+	if cli.codec != nil && (cli.codec.Marshal == nil || cli.codec.Unmarshal == nil) {
+		return nil, ErrInvalidArgument
+	}
+
+	for _, v := range cli.operationVersions {
+		if !isAPIVersion(v) {
+			return nil, ErrInvalidArgument
+		}
+	}
+
 	return cli, nil
 }
 
-func (cli *Client) sendRequest(req *http.Request, res interface{}) error { //nolint: cyclop
-	req.Header.Add("Authorization", "token "+cli.token)
+// sendRequest sends the request of the operation, e.g. "GetAddress", and decodes the response.
+func (cli *Client) sendRequest(operation string, req *http.Request, res interface{}) error {
+	if err := cli.authorize(req); err != nil {
+		return err
+	}
+
+	applyRequestOptions(req)
+	cli.overrideAPIVersion(operation, req)
+
+	if d := cli.timeoutOf(req.Context()); d > 0 {
+		ctx, cancel := context.WithTimeout(req.Context(), d)
+		defer cancel()
+
+		req = req.WithContext(ctx)
+	}
+
+	start := cli.clock.Now()
+	finish := func(res interface{}) {
+		if ff, ok := res.(furiganaFiller); ok {
+			ff.fillFurigana()
+		}
+
+		if m := responseMetaOf(res); m != nil {
+			m.Duration = cli.clock.Now().Sub(start)
+		}
+	}
+
+	if len(cli.observers) == 0 {
+		return cli.dispatch(req, res, finish)
+	}
+
+	return cli.observe(operation, req, res, finish)
+}
+
+// authorize sets the token to the request, the token provider is called for each request if it is given.
+// The token overridden by kenall.WithToken takes precedence over both.
+func (cli *Client) authorize(req *http.Request) error {
+	if token, ok := tokenOf(req.Context()); ok {
+		if token == "" {
+			return fmt.Errorf("kenall: failed to get the token: %w", ErrInvalidArgument)
+		}
+
+		req.Header.Set("Authorization", "token "+token)
+
+		return nil
+	}
+
+	token := cli.token
+
+	if cli.tokenProvider != nil {
+		t, err := cli.tokenProvider(req.Context())
+		if err != nil {
+			return fmt.Errorf("kenall: failed to get the token: %w", err)
+		}
+
+		if t == "" {
+			return fmt.Errorf("kenall: failed to get the token: %w", ErrInvalidArgument)
+		}
+
+		token = t
+	}
+
+	req.Header.Set("Authorization", "token "+token)
+
+	return nil
+}
+
+// dispatch sends the request coalesced with the identical requests in flight if kenall.WithSingleflight is given.
+// The finish function completes the successful response of the caller, it is called before the response is shared
+// with the coalesced callers, since the shared response must not be written after that.
+func (cli *Client) dispatch(req *http.Request, res interface{}, finish func(interface{})) error {
+	if cli.coalesces(req) {
+		return cli.flights.do(req, res, cli.dispatchCached, finish)
+	}
+
+	if err := cli.dispatchCached(req, res); err != nil {
+		return err
+	}
+
+	finish(res)
+
+	return nil
+}
+
+// dispatchCached sends the request through the cache and the stale result if they are enabled.
+func (cli *Client) dispatchCached(req *http.Request, res interface{}) error {
+	var cached *cacheEntry
+	if cli.caches(req) {
+		if e, ok := cli.getCache(req); ok {
+			if cli.isFresh(e) && cli.decodeKept(req, e.Body, res) == nil {
+				callStateFrom(req.Context()).hitCache()
+
+				return nil
+			}
+
+			cached = e
+			setValidators(req, e)
+		}
+	}
+
+	var err error
+	if cli.cachesStale(req, res) {
+		err = cli.sendRequestWithStale(req, res)
+	} else {
+		err = cli.send(req, res)
+	}
+
+	// NOTE: a not modified response is a hit of the revalidated cache.
+	if cached != nil && errors.Is(err, errNotModified) {
+		callStateFrom(req.Context()).hitCache()
+		cli.storeCache(req, cached.Body, cached.Header)
+
+		return cli.decodeKept(req, cached.Body, res)
+	}
+
+	return err
+}
+
+func (cli *Client) send(req *http.Request, res interface{}) error {
+	family := endpointFamilyOf(cli.Endpoint, req.URL)
+	retryable := cli.isIdempotent(req.Method, family)
+	reconnected := false
+	attempts := 0
+
+	for attempt := 0; ; attempt++ {
+		if err := cli.waitRateLimit(req.Context(), family); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return ErrTimeout(err)
+			}
+
+			return fmt.Errorf("kenall: failed to wait for the rate limit: %w", err)
+		}
 
-	resp, err := cli.HTTPClient.Do(req)
+		attempts++
+
+		err := cli.doRequest(req, res)
+		if err == nil {
+			if m := responseMetaOf(res); m != nil {
+				m.Attempts = attempts
+			}
+
+			cli.versions.observe(family, res)
+			cli.validateResponse(family, res)
+			cli.kanaScript.apply(res)
+			cli.hooks.apply(res)
+
+			return nil
+		}
+
+		// A reset connection is retried once immediately on a fresh connection regardless of the retry policy.
+		if req.Method == http.MethodGet && !reconnected && isConnectionResetError(req.Context(), err) {
+			reconnected = true
+			attempt--
+
+			cli.HTTPClient.CloseIdleConnections()
+
+			continue
+		}
+
+		if !retryable || attempt >= cli.retryPolicy.MaxRetries || !isRetryableError(req.Context(), err) {
+			return err
+		}
+
+		if werr := cli.clock.Sleep(req.Context(), cli.retryPolicy.backoff(attempt)); werr != nil {
+			return err
+		}
+
+		if req.GetBody != nil {
+			body, berr := req.GetBody()
+			if berr != nil {
+				return err
+			}
+
+			req.Body = body
+		}
+	}
+}
+
+func (cli *Client) doRequest(req *http.Request, res interface{}) error { //nolint: cyclop
+	state := callStateFrom(req.Context())
+	state.attempt()
+
+	resp, err := cli.roundTrip(req)
 	if err != nil {
-		if errors.Is(err, context.DeadlineExceeded) || os.IsTimeout(err) {
+		if errors.Is(err, context.DeadlineExceeded) || isTimeoutError(err) {
 			return ErrTimeout(err)
 		}
 
@@ -74,64 +334,169 @@ func (cli *Client) sendRequest(req *http.Request, res interface{}) error { //nol
 		_ = resp.Body.Close()
 	}()
 
-	switch resp.StatusCode {
-	case http.StatusOK:
-		if err := json.NewDecoder(resp.Body).Decode(res); err != nil {
-			return fmt.Errorf("kenall: failed to decode to response: %w", err)
-		}
-	case http.StatusUnauthorized:
-		return ErrUnauthorized
-	case http.StatusPaymentRequired:
-		return ErrPaymentRequired
-	case http.StatusForbidden:
-		return ErrForbidden
-	case http.StatusNotFound:
-		return ErrNotFound
-	case http.StatusMethodNotAllowed:
-		return ErrMethodNotAllowed
-	case http.StatusInternalServerError:
-		return ErrInternalServerError
-	default:
-		//nolint: goerr113
-		return fmt.Errorf("kenall: not registered in the error handling, http status code = %d", resp.StatusCode)
+	state.respond(resp.StatusCode)
+
+	if resp.StatusCode == http.StatusNotModified {
+		return errNotModified
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return newAPIError(req, resp, cli.clock.Now())
+	}
+
+	var (
+		body     = io.LimitReader(resp.Body, maxResponseBodySize)
+		captured *bytes.Buffer
+	)
+
+	if cli.cachesStale(req, res) || cli.caches(req) {
+		captured = &bytes.Buffer{}
+		body = io.TeeReader(body, captured)
+	}
+
+	if err := cli.decodeBody(req, body, res); err != nil {
+		return err
+	}
+
+	setResponseMeta(res, resp)
+
+	if captured != nil && cli.cachesStale(req, res) {
+		cli.stale.put(cacheKey(req), captured.Bytes())
+	}
+
+	if captured != nil && cli.caches(req) {
+		cli.storeCache(req, captured.Bytes(), resp.Header)
 	}
 
 	return nil
 }
 
+// decodeBody decodes the response body of the request into the response, tolerating malformed elements with
+// kenall.WithTolerantDecoding.
+func (cli *Client) decodeBody(req *http.Request, body io.Reader, res interface{}) error {
+	if cli.tolerant {
+		errs, err := decodeTolerant(body, res)
+		if err != nil {
+			return err
+		}
+
+		if pr, ok := res.(partialResponse); ok {
+			pr.setDecodeErrors(errs)
+		}
+
+		if cli.decodeErrorHandler != nil {
+			family := endpointFamilyOf(cli.Endpoint, req.URL)
+			for _, err := range errs {
+				cli.decodeErrorHandler(family, err)
+			}
+		}
+
+		return nil
+	}
+
+	if pd, ok := res.(pooledDecoder); ok && cli.pooled {
+		return pd.decodePooled(cli, body)
+	}
+
+	return cli.decodeJSON(body, res)
+}
+
 // A GetAddressResponse is a result from the kenall service of the API to get the address from the postal code.
 type GetAddressResponse struct {
+	ResponseMeta `json:"-"`
+
 	Version   Version    `json:"version"`
 	Addresses []*Address `json:"data"`
+	// VersionSkewed is true if the version differs from the previous response of the same endpoint family.
+	VersionSkewed bool `json:"-"`
+	// Stale is true if the response is a previous result returned by kenall.WithStaleOnTimeout.
+	Stale bool `json:"-"`
+	// DecodeErrors are the errors of the malformed elements skipped by kenall.WithTolerantDecoding.
+	DecodeErrors []error `json:"-"`
+
+	pooled int32
+	// shared is true if the addresses may be referred by the other callers, they are not reused by reset then.
+	shared bool
 }
 
-// GetAddress requests to the kenall service to get the address by postal code.
-func (cli *Client) GetAddress(ctx context.Context, postalCode string) (*GetAddressResponse, error) {
-	if _, err := strconv.Atoi(postalCode); err != nil || len(postalCode) != 7 {
-		return nil, ErrInvalidArgument
+// GetAddress requests to the kenall service to get the address by postal code. The postal code is normalized
+// as kenall.ParsePostalCode does, e.g. "100-8105", unless kenall.WithStrictValidation is given.
+func (cli *Client) GetAddress(
+	ctx context.Context, postalCode string, opts ...RequestOption,
+) (*GetAddressResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
+	var res GetAddressResponse
+	if err := cli.GetAddressInto(ctx, postalCode, &res); err != nil {
+		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/postalcode/"+postalCode, nil)
+	return &res, nil
+}
+
+// GetAddressInto requests to the kenall service to get the address by postal code and decodes it into res,
+// reusing the addresses of res to reduce the allocations in high-QPS services.
+// The values referred by res before the call must not be used after it, call Clone beforehand to keep them.
+// The addresses are not reused if res was shared with the identical calls coalesced by kenall.WithSingleflight.
+func (cli *Client) GetAddressInto(
+	ctx context.Context, postalCode string, res *GetAddressResponse, opts ...RequestOption,
+) error {
+	ctx = contextWithRequestOptions(ctx, opts)
+
+	if res == nil {
+		return ErrInvalidArgument
+	}
+
+	code, err := cli.parsePostalCode(postalCode)
 	if err != nil {
-		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
+		return err
 	}
 
-	var res GetAddressResponse
-	if err := cli.sendRequest(req, &res); err != nil {
-		return nil, fmt.Errorf(errFailedRequestFormat, err)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/postalcode/"+code.String(), nil)
+	if err != nil {
+		return fmt.Errorf(errFailedGenerateRequestFormat, err)
 	}
 
-	return &res, nil
+	res.reset()
+
+	if err := cli.sendRequest("GetAddress", req, res); err != nil {
+		return fmt.Errorf(errFailedRequestFormat, err)
+	}
+
+	return nil
+}
+
+// parsePostalCode normalizes and validates the postal code of the argument, see kenall.WithStrictValidation.
+func (cli *Client) parsePostalCode(s string) (PostalCode, error) {
+	if cli.strictValidation {
+		return PostalCode(s), PostalCode(s).Validate()
+	}
+
+	return ParsePostalCode(s)
 }
 
 // A GetCityResponse is a result from the kenall service of the API to get the city from the prefecture code.
 type GetCityResponse struct {
+	ResponseMeta `json:"-"`
+
 	Version Version `json:"version"`
 	Cities  []*City `json:"data"`
+	// VersionSkewed is true if the version differs from the previous response of the same endpoint family.
+	VersionSkewed bool `json:"-"`
+	// Stale is true if the response is a previous result returned by kenall.WithStaleOnTimeout.
+	Stale bool `json:"-"`
+	// DecodeErrors are the errors of the malformed elements skipped by kenall.WithTolerantDecoding.
+	DecodeErrors []error `json:"-"`
+
+	pooled int32
 }
 
 // GetCity requests to the kenall service to get the city by prefecture code.
-func (cli *Client) GetCity(ctx context.Context, prefectureCode string) (*GetCityResponse, error) {
+func (cli *Client) GetCity(
+	ctx context.Context, prefectureCode string, opts ...RequestOption,
+) (*GetCityResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
 	if _, err := strconv.Atoi(prefectureCode); err != nil || len(prefectureCode) != 2 {
 		return nil, ErrInvalidArgument
 	}
@@ -142,7 +507,7 @@ func (cli *Client) GetCity(ctx context.Context, prefectureCode string) (*GetCity
 	}
 
 	var res GetCityResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest("GetCity", req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
@@ -152,43 +517,63 @@ func (cli *Client) GetCity(ctx context.Context, prefectureCode string) (*GetCity
 // A GetCorporationResponse is a result from the kenall service of the API to get the corporation
 // from the corporate number.
 type GetCorporationResponse struct {
+	ResponseMeta `json:"-"`
+
 	Version     Version      `json:"version"`
 	Corporation *Corporation `json:"data"`
+	// VersionSkewed is true if the version differs from the previous response of the same endpoint family.
+	VersionSkewed bool `json:"-"`
+	// Stale is true if the response is a previous result returned by kenall.WithStaleOnTimeout.
+	Stale bool `json:"-"`
 }
 
 // GetCorporation requests to the kenall service to get the corporation by corporate number.
-func (cli *Client) GetCorporation(ctx context.Context, corporateNumber string) (*GetCorporationResponse, error) {
-	if _, err := strconv.Atoi(corporateNumber); err != nil || len(corporateNumber) != 13 {
-		return nil, ErrInvalidArgument
+// An impossible corporate number by the check digit is rejected without the request.
+func (cli *Client) GetCorporation(
+	ctx context.Context, corporateNumber string, opts ...CorporationSearchOption,
+) (*GetCorporationResponse, error) {
+	if err := CorporateNumber(corporateNumber).Validate(); err != nil {
+		return nil, err
 	}
 
+	s := newCorporationSearch(opts)
+	ctx = contextWithRequestOptions(ctx, s.requests)
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/houjinbangou/"+corporateNumber, nil)
 	if err != nil {
 		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
 	}
 
 	var res GetCorporationResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest("GetCorporation", req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
+	if s.excludeClosed && res.Corporation != nil && res.Corporation.IsClosed() {
+		return nil, fmt.Errorf(errFailedRequestFormat, ErrClosedCorporation)
+	}
+
 	return &res, nil
 }
 
 // A GetWhoamiResponse is a result from the kenall service of the API to get whoami information.
 type GetWhoamiResponse struct {
+	ResponseMeta `json:"-"`
+
 	RemoteAddress *RemoteAddress `json:"remote_addr"`
 }
 
 // GetWhoami requests to the kenall service to get the whoami information by access point.
-func (cli *Client) GetWhoami(ctx context.Context) (*GetWhoamiResponse, error) {
+func (cli *Client) GetWhoami(ctx context.Context, opts ...RequestOption) (*GetWhoamiResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/whoami", nil)
 	if err != nil {
 		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
 	}
 
 	var res GetWhoamiResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest("GetWhoami", req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
@@ -197,7 +582,16 @@ func (cli *Client) GetWhoami(ctx context.Context) (*GetWhoamiResponse, error) {
 
 // A GetHolidaysResponse is a result from the kenall service of the API to get the holidays.
 type GetHolidaysResponse struct {
+	ResponseMeta `json:"-"`
+
+	// Version is the version of the holidays, it is zero if the kenall service does not return it,
+	// see kenall.ResponseMeta.LastModified for the time of the response instead.
+	Version  Version    `json:"version"`
 	Holidays []*Holiday `json:"data"`
+	// Stale is true if the response is a previous result returned by kenall.WithStaleOnTimeout.
+	Stale bool `json:"-"`
+	// DecodeErrors are the errors of the malformed elements skipped by kenall.WithTolerantDecoding.
+	DecodeErrors []error `json:"-"`
 }
 
 func (cli *Client) getHolidays(ctx context.Context, v url.Values) (*GetHolidaysResponse, error) {
@@ -207,7 +601,7 @@ func (cli *Client) getHolidays(ctx context.Context, v url.Values) (*GetHolidaysR
 	}
 
 	var res GetHolidaysResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest("GetHolidays", req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
@@ -215,17 +609,27 @@ func (cli *Client) getHolidays(ctx context.Context, v url.Values) (*GetHolidaysR
 }
 
 // GetHolidays requests to the kenall service to get all holidays after 1970.
-func (cli *Client) GetHolidays(ctx context.Context) (*GetHolidaysResponse, error) {
+func (cli *Client) GetHolidays(ctx context.Context, opts ...RequestOption) (*GetHolidaysResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
 	return cli.getHolidays(ctx, nil)
 }
 
 // GetHolidaysByYear requests to the kenall service to get holidays for the year.
-func (cli *Client) GetHolidaysByYear(ctx context.Context, year int) (*GetHolidaysResponse, error) {
+func (cli *Client) GetHolidaysByYear(
+	ctx context.Context, year int, opts ...RequestOption,
+) (*GetHolidaysResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
 	return cli.getHolidays(ctx, url.Values{"year": []string{strconv.Itoa(year)}})
 }
 
 // GetHolidaysByPeriod requests to the kenall service to get holidays for the period.
-func (cli *Client) GetHolidaysByPeriod(ctx context.Context, from, to time.Time) (*GetHolidaysResponse, error) {
+func (cli *Client) GetHolidaysByPeriod(
+	ctx context.Context, from, to time.Time, opts ...RequestOption,
+) (*GetHolidaysResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
 	return cli.getHolidays(ctx, url.Values{
 		"from": []string{from.Format(RFC3339DateFormat)},
 		"to":   []string{to.Format(RFC3339DateFormat)},
@@ -234,24 +638,58 @@ func (cli *Client) GetHolidaysByPeriod(ctx context.Context, from, to time.Time)
 
 // A GetNormalizeAddressResponse is a result from the kenall service of the API to normalize address.
 type GetNormalizeAddressResponse struct {
+	ResponseMeta `json:"-"`
+
 	Version Version `json:"version"`
 	Query   Query   `json:"query"`
+	// Addresses are the addresses matched with the normalized query, up to the limit of the kenall service.
+	Addresses []*Address `json:"data"`
+	// Count is the total number of the matched addresses.
+	Count int `json:"count"`
+	// VersionSkewed is true if the version differs from the previous response of the same endpoint family.
+	VersionSkewed bool `json:"-"`
+	// Stale is true if the response is a previous result returned by kenall.WithStaleOnTimeout.
+	Stale bool `json:"-"`
+	// DecodeErrors are the errors of the malformed elements skipped by kenall.WithTolerantDecoding.
+	DecodeErrors []error `json:"-"`
+
+	pooled int32
 }
 
 // GetNormalizeAddress requests to the kenall service to normalize address.
-func (cli *Client) GetNormalizeAddress(ctx context.Context, address string) (*GetNormalizeAddressResponse, error) {
+// A long address which does not fit in the URL is sent with POST, which is not retried automatically
+// unless kenall.WithIdempotency marks kenall.EndpointFamilyPostalCode as idempotent.
+func (cli *Client) GetNormalizeAddress(
+	ctx context.Context, address string, opts ...RequestOption,
+) (*GetNormalizeAddressResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
 	address = strings.TrimSpace(address)
 	if address == "" {
 		return nil, ErrInvalidArgument
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/postalcode/?t="+address, nil)
+	q := url.Values{"t": []string{address}}.Encode()
+
+	var (
+		req *http.Request
+		err error
+	)
+	if len(q) > maxQueryLength {
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, cli.Endpoint+"/postalcode/", strings.NewReader(q))
+		if req != nil {
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		}
+	} else {
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/postalcode/?"+q, nil)
+	}
+
 	if err != nil {
 		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
 	}
 
 	var res GetNormalizeAddressResponse
-	if err := cli.sendRequest(req, &res); err != nil {
+	if err := cli.sendRequest("GetNormalizeAddress", req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
@@ -260,11 +698,27 @@ func (cli *Client) GetNormalizeAddress(ctx context.Context, address string) (*Ge
 
 // A GetBusinessDaysResponse is a result from the kenall service of the API to get the business days.
 type GetBusinessDaysResponse struct {
+	ResponseMeta `json:"-"`
+
+	// Version is the version of the holidays, it is zero if the kenall service does not return it,
+	// see kenall.ResponseMeta.LastModified for the time of the response instead.
+	Version     Version
 	BusinessDay *BusinessDay
 }
 
+type businessDaysResult struct {
+	ResponseMeta `json:"-"`
+
+	Version Version `json:"version"`
+	Result  bool    `json:"result"`
+}
+
 // GetBusinessDays requests to the kenall service to get business days by a date.
-func (cli *Client) GetBusinessDays(ctx context.Context, date time.Time) (*GetBusinessDaysResponse, error) {
+func (cli *Client) GetBusinessDays(
+	ctx context.Context, date time.Time, opts ...RequestOption,
+) (*GetBusinessDaysResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
 	if date.IsZero() {
 		return nil, ErrInvalidArgument
 	}
@@ -275,16 +729,17 @@ func (cli *Client) GetBusinessDays(ctx context.Context, date time.Time) (*GetBus
 		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
 	}
 
-	res := struct {
-		Result bool `json:"result"`
-	}{}
-	if err := cli.sendRequest(req, &res); err != nil {
+	var res businessDaysResult
+	if err := cli.sendRequest("GetBusinessDays", req, &res); err != nil {
 		return nil, fmt.Errorf(errFailedRequestFormat, err)
 	}
 
 	return &GetBusinessDaysResponse{
+		ResponseMeta: res.ResponseMeta,
+		Version:      res.Version,
 		BusinessDay: &BusinessDay{
 			LegalHoliday: res.Result,
+			Weekend:      isWeekend(date),
 			Time:         date,
 		},
 	}, nil
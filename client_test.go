@@ -16,23 +16,34 @@ import (
 	"time"
 
 	"github.com/osamingo/go-kenall/v2"
+	"github.com/osamingo/go-kenall/v2/kenalltest"
 )
 
 var (
-	//go:embed testdata/addresses.json
+	//go:embed kenalltest/testdata/addresses.json
 	addressResponse []byte
-	//go:embed testdata/cities.json
+	//go:embed kenalltest/testdata/cities.json
 	cityResponse []byte
-	//go:embed testdata/corporation.json
+	//go:embed kenalltest/testdata/corporation.json
 	corporationResponse []byte
-	//go:embed testdata/whoami.json
+	//go:embed kenalltest/testdata/whoami.json
 	whoamiResponse []byte
-	//go:embed testdata/holidays.json
+	//go:embed kenalltest/testdata/holidays.json
 	holidaysResponse []byte
-	//go:embed testdata/search_address.json
+	//go:embed kenalltest/testdata/search_address.json
 	searchAddressResponse []byte
-	//go:embed testdata/business_day.json
+	//go:embed kenalltest/testdata/business_day.json
 	businessDaysResponse []byte
+	//go:embed kenalltest/testdata/banks.json
+	banksResponse []byte
+	//go:embed kenalltest/testdata/bank.json
+	bankResponse []byte
+	//go:embed kenalltest/testdata/bank_branches.json
+	bankBranchesResponse []byte
+	//go:embed kenalltest/testdata/bank_branch.json
+	bankBranchResponse []byte
+	//go:embed kenalltest/testdata/invoice.json
+	invoiceResponse []byte
 )
 
 func TestNewClient(t *testing.T) {
@@ -105,7 +116,7 @@ func TestClient_GetAddress(t *testing.T) {
 		"Forbidden":             {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), postalCode: "4030000", checkAsError: false, wantError: kenall.ErrForbidden, wantJISX0402: ""},
 		"Method Not Allowed":    {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), postalCode: "4050000", checkAsError: false, wantError: kenall.ErrMethodNotAllowed, wantJISX0402: ""},
 		"Internal server error": {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), postalCode: "5000000", checkAsError: false, wantError: kenall.ErrInternalServerError, wantJISX0402: ""},
-		"Unknown status code":   {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), postalCode: "5030000", checkAsError: true, wantError: fmt.Errorf(""), wantJISX0402: ""},
+		"Service unavailable":   {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), postalCode: "5030000", checkAsError: false, wantError: kenall.ErrServiceUnavailable, wantJISX0402: ""},
 		"Wrong endpoint":        {endpoint: "", token: "opencollector", ctx: context.Background(), postalCode: "0000000", checkAsError: true, wantError: &url.Error{}, wantJISX0402: ""},
 		"Wrong response":        {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), postalCode: "0000001", checkAsError: true, wantError: &json.MarshalerError{}, wantJISX0402: ""},
 		"Nil context":           {endpoint: srv.URL, token: "opencollector", ctx: nil, postalCode: "0000000", checkAsError: true, wantError: errors.New("net/http: nil Context"), wantJISX0402: ""},
@@ -163,7 +174,7 @@ func TestClient_GetCity(t *testing.T) {
 		"Forbidden":               {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), prefectureCode: "91", checkAsError: false, wantError: kenall.ErrForbidden, wantJISX0402: ""},
 		"Method Not Allowed":      {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), prefectureCode: "96", checkAsError: false, wantError: kenall.ErrMethodNotAllowed, wantJISX0402: ""},
 		"Internal server error":   {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), prefectureCode: "92", checkAsError: false, wantError: kenall.ErrInternalServerError, wantJISX0402: ""},
-		"Unknown status code":     {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), prefectureCode: "94", checkAsError: true, wantError: fmt.Errorf(""), wantJISX0402: ""},
+		"Service unavailable":     {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), prefectureCode: "94", checkAsError: false, wantError: kenall.ErrServiceUnavailable, wantJISX0402: ""},
 		"Wrong endpoint":          {endpoint: "", token: "opencollector", ctx: context.Background(), prefectureCode: "00", checkAsError: true, wantError: &url.Error{}, wantJISX0402: ""},
 		"Wrong response":          {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), prefectureCode: "95", checkAsError: true, wantError: &json.MarshalerError{}, wantJISX0402: ""},
 		"Nil context":             {endpoint: srv.URL, token: "opencollector", ctx: nil, prefectureCode: "00", checkAsError: true, wantError: errors.New("net/http: nil Context"), wantJISX0402: ""},
@@ -215,15 +226,16 @@ func TestClient_GetCorporation(t *testing.T) {
 	}{
 		"Normal case":              {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "2021001052596", checkAsError: false, wantError: nil, wantJISX0402: "13101"},
 		"Invalid corporate number": {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "alphabet", checkAsError: false, wantError: kenall.ErrInvalidArgument, wantJISX0402: ""},
-		"Not found":                {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "0000000000001", checkAsError: false, wantError: kenall.ErrNotFound, wantJISX0402: ""},
+		"Invalid check digit":      {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "3021001052596", checkAsError: false, wantError: kenall.ErrInvalidArgument, wantJISX0402: ""},
+		"Not found":                {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "8000000000001", checkAsError: false, wantError: kenall.ErrNotFound, wantJISX0402: ""},
 		"Unauthorized":             {endpoint: srv.URL, token: "bad_token", ctx: context.Background(), corporateNumber: "2021001052596", checkAsError: false, wantError: kenall.ErrUnauthorized, wantJISX0402: ""},
-		"Payment Required":         {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "0000000000402", checkAsError: false, wantError: kenall.ErrPaymentRequired, wantJISX0402: ""},
-		"Forbidden":                {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "0000000000403", checkAsError: false, wantError: kenall.ErrForbidden, wantJISX0402: ""},
-		"Method Not Allowed":       {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "0000000000405", checkAsError: false, wantError: kenall.ErrMethodNotAllowed, wantJISX0402: ""},
-		"Internal server error":    {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "0000000000500", checkAsError: false, wantError: kenall.ErrInternalServerError, wantJISX0402: ""},
-		"Unknown status code":      {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "0000000000503", checkAsError: true, wantError: fmt.Errorf(""), wantJISX0402: ""},
+		"Payment Required":         {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "3000000000402", checkAsError: false, wantError: kenall.ErrPaymentRequired, wantJISX0402: ""},
+		"Forbidden":                {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "2000000000403", checkAsError: false, wantError: kenall.ErrForbidden, wantJISX0402: ""},
+		"Method Not Allowed":       {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "9000000000405", checkAsError: false, wantError: kenall.ErrMethodNotAllowed, wantJISX0402: ""},
+		"Internal server error":    {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "4000000000500", checkAsError: false, wantError: kenall.ErrInternalServerError, wantJISX0402: ""},
+		"Service unavailable":      {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "1000000000503", checkAsError: false, wantError: kenall.ErrServiceUnavailable, wantJISX0402: ""},
 		"Wrong endpoint":           {endpoint: "", token: "opencollector", ctx: context.Background(), corporateNumber: "2021001052596", checkAsError: true, wantError: &url.Error{}, wantJISX0402: ""},
-		"Wrong response":           {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "0000000000000", checkAsError: true, wantError: &json.MarshalerError{}, wantJISX0402: ""},
+		"Wrong response":           {endpoint: srv.URL, token: "opencollector", ctx: context.Background(), corporateNumber: "9000000000000", checkAsError: true, wantError: &json.MarshalerError{}, wantJISX0402: ""},
 		"Nil context":              {endpoint: srv.URL, token: "opencollector", ctx: nil, corporateNumber: "2021001052596", checkAsError: true, wantError: errors.New("net/http: nil Context"), wantJISX0402: ""},
 		"Timeout context":          {endpoint: srv.URL, token: "opencollector", ctx: toctx, corporateNumber: "2021001052596", checkAsError: true, wantError: kenall.ErrTimeout(context.DeadlineExceeded), wantJISX0402: ""},
 	}
@@ -510,6 +522,51 @@ func TestClient_GetNormalizeAddress(t *testing.T) {
 	}
 }
 
+func TestClient_GetNormalizeAddress_Encoding(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		giveAddress string
+		wantMethod  string
+	}{
+		"Reserved characters": {giveAddress: "東京都港区 六本木6-10-1 #18F&A=B+C", wantMethod: http.MethodGet},
+		"Long address":        {giveAddress: strings.Repeat("東京都港区六本木六丁目10番1号", 100), wantMethod: http.MethodPost},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != c.wantMethod {
+					t.Errorf("give: %v, want: %v", r.Method, c.wantMethod)
+				}
+				if r.URL.Path != "/postalcode/" {
+					t.Errorf("give: %v, want: %v", r.URL.Path, "/postalcode/")
+				}
+				if got := r.FormValue("t"); got != c.giveAddress {
+					t.Errorf("give: %v, want: %v", got, c.giveAddress)
+				}
+				if _, err := w.Write(searchAddressResponse); err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := cli.GetNormalizeAddress(context.Background(), c.giveAddress); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func TestClient_GetBusinessDays(t *testing.T) {
 	t.Parallel()
 
@@ -742,195 +799,34 @@ func ExampleClient_GetBusinessDays() {
 	// 2000-01-01
 }
 
-func runTestingServer(t *testing.T) *httptest.Server {
-	t.Helper()
-
-	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		token := strings.Fields(r.Header.Get("Authorization"))
-
-		if len(token) != 2 || token[1] != "opencollector" {
-			w.WriteHeader(http.StatusUnauthorized)
-
-			return
-		}
-
-		switch uri := r.URL.RequestURI(); {
-		case strings.HasPrefix(uri, "/postalcode/"):
-			handlePostalAPI(t, w, uri)
-		case strings.HasPrefix(uri, "/cities/"):
-			handleCityAPI(t, w, uri)
-		case strings.HasPrefix(uri, "/houjinbangou/"):
-			handleCorporationAPI(t, w, uri)
-		case strings.HasPrefix(uri, "/whoami"):
-			handleWhoamiAPI(t, w, uri)
-		case strings.HasPrefix(uri, "/holidays"):
-			handleHolidaysAPI(t, w, uri)
-		case strings.HasPrefix(uri, "/businessdays"):
-			handleBusinessDaysAPI(t, w, uri)
-		default:
-			w.WriteHeader(http.StatusNotFound)
-		}
-	}))
-}
-
-func handlePostalAPI(t *testing.T, w http.ResponseWriter, uri string) {
-	t.Helper()
-
-	if strings.HasPrefix(uri, "/postalcode/?") {
-		//nolint: errcheck
-		u, _ := url.Parse(uri)
-
-		switch u.Query().Get("t") {
-		case "東京都港区六本木六丁目10番1号六本木ヒルズ森タワー18F":
-			if _, err := w.Write(searchAddressResponse); err != nil {
-				w.WriteHeader(http.StatusInternalServerError)
-			}
-		case "wrong":
-			if _, err := w.Write([]byte("wrong")); err != nil {
-				w.WriteHeader(http.StatusInternalServerError)
-			}
-		}
-	}
-
-	switch uri {
-	case "/postalcode/1008105":
-		if _, err := w.Write(addressResponse); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-	case "/postalcode/4020000":
-		w.WriteHeader(http.StatusPaymentRequired)
-	case "/postalcode/4030000":
-		w.WriteHeader(http.StatusForbidden)
-	case "/postalcode/4050000":
-		w.WriteHeader(http.StatusMethodNotAllowed)
-	case "/postalcode/5000000":
-		w.WriteHeader(http.StatusInternalServerError)
-	case "/postalcode/5030000":
-		w.WriteHeader(http.StatusServiceUnavailable)
-	case "/postalcode/0000001":
-		if _, err := w.Write([]byte("wrong")); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-	default:
-		w.WriteHeader(http.StatusNotFound)
-	}
-}
-
-func handleCityAPI(t *testing.T, w http.ResponseWriter, uri string) {
-	t.Helper()
-
-	switch uri {
-	case "/cities/13":
-		if _, err := w.Write(cityResponse); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-	case "/cities/90":
-		w.WriteHeader(http.StatusPaymentRequired)
-	case "/cities/91":
-		w.WriteHeader(http.StatusForbidden)
-	case "/cities/92":
-		w.WriteHeader(http.StatusInternalServerError)
-	case "/cities/94":
-		w.WriteHeader(http.StatusServiceUnavailable)
-	case "/cities/95":
-		if _, err := w.Write([]byte("wrong")); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-	case "/cities/96":
-		w.WriteHeader(http.StatusMethodNotAllowed)
-	default:
-		w.WriteHeader(http.StatusNotFound)
-	}
-}
-
-func handleCorporationAPI(t *testing.T, w http.ResponseWriter, uri string) {
-	t.Helper()
-
-	switch uri {
-	case "/houjinbangou/2021001052596":
-		if _, err := w.Write(corporationResponse); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-	case "/houjinbangou/0000000000402":
-		w.WriteHeader(http.StatusPaymentRequired)
-	case "/houjinbangou/0000000000403":
-		w.WriteHeader(http.StatusForbidden)
-	case "/houjinbangou/0000000000405":
-		w.WriteHeader(http.StatusMethodNotAllowed)
-	case "/houjinbangou/0000000000500":
-		w.WriteHeader(http.StatusInternalServerError)
-	case "/houjinbangou/0000000000503":
-		w.WriteHeader(http.StatusServiceUnavailable)
-	case "/houjinbangou/0000000000000":
-		if _, err := w.Write([]byte("wrong")); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-	default:
-		w.WriteHeader(http.StatusNotFound)
-	}
-}
-
-func handleWhoamiAPI(t *testing.T, w http.ResponseWriter, uri string) {
-	t.Helper()
-
-	switch uri {
-	case "/whoami":
-		if _, err := w.Write(whoamiResponse); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-	default:
-		w.WriteHeader(http.StatusNotFound)
-	}
-}
-
-func handleHolidaysAPI(t *testing.T, w http.ResponseWriter, uri string) {
+func runTestingServer(t *testing.T) *kenalltest.Server {
 	t.Helper()
 
-	switch uri {
-	case "/holidays?":
-		if _, err := w.Write(holidaysResponse); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-
-		return
-	case "/holidays?year=2022":
-		if _, err := w.Write(holidaysResponse); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-
-		return
-	case "/holidays?from=2022-01-01&to=2022-12-31":
-		if _, err := w.Write(holidaysResponse); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-
-		return
-	}
-
-	if strings.HasPrefix(uri, "/holidays") {
-		if _, err := w.Write([]byte(`{"data":[]}`)); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-
-		return
-	}
-
-	w.WriteHeader(http.StatusNotFound)
-}
-
-func handleBusinessDaysAPI(t *testing.T, w http.ResponseWriter, uri string) {
-	t.Helper()
-
-	switch uri {
-	case "/businessdays/check?date=2023-01-01":
-		if _, err := w.Write(businessDaysResponse); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-	case "/businessdays/check?date=0001-01-02":
-		if _, err := w.Write([]byte(`{"result": "worng"}`)); err != nil {
-			w.WriteHeader(http.StatusInternalServerError)
-		}
-	default:
-		w.WriteHeader(http.StatusNotFound)
-	}
+	wrong := []byte("wrong")
+
+	return kenalltest.NewServer(
+		kenalltest.WithToken("opencollector"),
+		kenalltest.WithError("/postalcode/4020000", http.StatusPaymentRequired, 0),
+		kenalltest.WithError("/postalcode/4030000", http.StatusForbidden, 0),
+		kenalltest.WithError("/postalcode/4050000", http.StatusMethodNotAllowed, 0),
+		kenalltest.WithError("/postalcode/5000000", http.StatusInternalServerError, 0),
+		kenalltest.WithError("/postalcode/5030000", http.StatusServiceUnavailable, 0),
+		kenalltest.WithFixture("/postalcode/0000001", wrong),
+		kenalltest.WithFixture("/postalcode/?t=wrong", wrong),
+		kenalltest.WithError("/cities/90", http.StatusPaymentRequired, 0),
+		kenalltest.WithError("/cities/91", http.StatusForbidden, 0),
+		kenalltest.WithError("/cities/92", http.StatusInternalServerError, 0),
+		kenalltest.WithError("/cities/94", http.StatusServiceUnavailable, 0),
+		kenalltest.WithFixture("/cities/95", wrong),
+		kenalltest.WithError("/cities/96", http.StatusMethodNotAllowed, 0),
+		kenalltest.WithError("/houjinbangou/3000000000402", http.StatusPaymentRequired, 0),
+		kenalltest.WithError("/houjinbangou/2000000000403", http.StatusForbidden, 0),
+		kenalltest.WithError("/houjinbangou/9000000000405", http.StatusMethodNotAllowed, 0),
+		kenalltest.WithError("/houjinbangou/4000000000500", http.StatusInternalServerError, 0),
+		kenalltest.WithError("/houjinbangou/1000000000503", http.StatusServiceUnavailable, 0),
+		kenalltest.WithFixture("/houjinbangou/9000000000000", wrong),
+		kenalltest.WithFixture("/holidays?year=1969", []byte(`{"data":[]}`)),
+		kenalltest.WithFixture("/holidays?from=2022-01-02&to=2022-12-31", []byte(`{"data":[]}`)),
+		kenalltest.WithFixture("/businessdays/check?date=0001-01-02", []byte(`{"result": "worng"}`)),
+	)
 }
@@ -0,0 +1,40 @@
+package kenall
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// A Clock provides the current time and waiting to kenall.Client.
+	// It is used by retries and rate limiting, and can be replaced to verify them without real sleeps.
+	Clock interface {
+		Now() time.Time
+		// Sleep waits for the duration, it must return an error if the ctx is done before.
+		Sleep(ctx context.Context, d time.Duration) error
+	}
+
+	systemClock struct{}
+)
+
+// Now implements kenall.Clock interface.
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Sleep implements kenall.Clock interface.
+func (systemClock) Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err() //nolint: wrapcheck
+	case <-t.C:
+		return nil
+	}
+}
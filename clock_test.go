@@ -0,0 +1,101 @@
+package kenall_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+type fakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	slept []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if d > 0 {
+		c.slept = append(c.slept, d)
+		c.now = c.now.Add(d)
+	}
+
+	return ctx.Err()
+}
+
+func (c *fakeClock) Slept() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return append([]time.Duration(nil), c.slept...)
+}
+
+func TestClock_RetryBackoff(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	t.Cleanup(srv.Close)
+
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithClock(clock),
+		kenall.WithRetryPolicy(kenall.RetryPolicy{MaxRetries: 4, MinBackoff: time.Second, MaxBackoff: 5 * time.Second}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetAddress(context.Background(), "1008105"); err == nil {
+		t.Error("an error should not be nil")
+	}
+
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+	if got := clock.Slept(); !reflect.DeepEqual(got, want) {
+		t.Errorf("give: %v, want: %v", got, want)
+	}
+}
+
+func TestClock_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithEndpointRateLimits(map[kenall.EndpointFamily]kenall.Rate{kenall.EndpointFamilyCities: {QPS: 2, Burst: 2}}),
+		kenall.WithClock(clock),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 4; i++ {
+		if _, err := cli.GetCity(context.Background(), "13"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	want := []time.Duration{500 * time.Millisecond, 500 * time.Millisecond}
+	if got := clock.Slept(); !reflect.DeepEqual(got, want) {
+		t.Errorf("give: %v, want: %v", got, want)
+	}
+}
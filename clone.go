@@ -0,0 +1,442 @@
+package kenall
+
+import "net"
+
+// Clone returns a deep copy of the address.
+func (a *Address) Clone() *Address {
+	if a == nil {
+		return nil
+	}
+
+	c := *a
+
+	return &c
+}
+
+// Clone returns a deep copy of the city.
+func (c *City) Clone() *City {
+	if c == nil {
+		return nil
+	}
+
+	v := *c
+
+	return &v
+}
+
+// Clone returns a deep copy of the corporation.
+func (c *Corporation) Clone() *Corporation {
+	if c == nil {
+		return nil
+	}
+
+	v := *c
+
+	return &v
+}
+
+// Clone returns a deep copy of the holiday.
+func (h *Holiday) Clone() *Holiday {
+	if h == nil {
+		return nil
+	}
+
+	v := *h
+
+	return &v
+}
+
+// Clone returns a deep copy of the business day.
+func (bd *BusinessDay) Clone() *BusinessDay {
+	if bd == nil {
+		return nil
+	}
+
+	v := *bd
+
+	return &v
+}
+
+// Clone returns a deep copy of the remote address.
+func (ra *RemoteAddress) Clone() *RemoteAddress {
+	if ra == nil {
+		return nil
+	}
+
+	v := *ra
+	if ra.IPAddr != nil {
+		v.IPAddr = &net.IPAddr{IP: append(net.IP(nil), ra.IPAddr.IP...), Zone: ra.IPAddr.Zone}
+	}
+
+	return &v
+}
+
+// Clone returns a deep copy of the invoice issuer.
+func (ii *InvoiceIssuer) Clone() *InvoiceIssuer {
+	if ii == nil {
+		return nil
+	}
+
+	v := *ii
+
+	return &v
+}
+
+// Clone returns a deep copy of the bank.
+func (b *Bank) Clone() *Bank {
+	if b == nil {
+		return nil
+	}
+
+	v := *b
+
+	return &v
+}
+
+// Clone returns a deep copy of the bank branch.
+func (b *BankBranch) Clone() *BankBranch {
+	if b == nil {
+		return nil
+	}
+
+	v := *b
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetAddressResponse) Clone() *GetAddressResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.pooled = 0
+	v.shared = false
+	v.Addresses = cloneAddresses(r.Addresses)
+	v.DecodeErrors = cloneErrors(r.DecodeErrors)
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetCityResponse) Clone() *GetCityResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.pooled = 0
+	v.Cities = cloneCities(r.Cities)
+	v.DecodeErrors = cloneErrors(r.DecodeErrors)
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetAllCitiesResponse) Clone() *GetAllCitiesResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.Cities = cloneCities(r.Cities)
+	v.DecodeErrors = cloneErrors(r.DecodeErrors)
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetCorporationResponse) Clone() *GetCorporationResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.Corporation = r.Corporation.Clone()
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetWhoamiResponse) Clone() *GetWhoamiResponse {
+	if r == nil {
+		return nil
+	}
+
+	return &GetWhoamiResponse{ResponseMeta: r.ResponseMeta.clone(), RemoteAddress: r.RemoteAddress.Clone()}
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetHolidaysResponse) Clone() *GetHolidaysResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.Holidays = cloneHolidays(r.Holidays)
+	v.DecodeErrors = cloneErrors(r.DecodeErrors)
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetNormalizeAddressResponse) Clone() *GetNormalizeAddressResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.pooled = 0
+	v.Addresses = cloneAddresses(r.Addresses)
+	v.DecodeErrors = cloneErrors(r.DecodeErrors)
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *SearchAddressesResponse) Clone() *SearchAddressesResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.Addresses = cloneAddresses(r.Addresses)
+	v.DecodeErrors = cloneErrors(r.DecodeErrors)
+
+	if r.Facets != nil {
+		v.Facets = make([]*Facet, 0, len(r.Facets))
+	}
+
+	for _, f := range r.Facets {
+		c := *f
+		v.Facets = append(v.Facets, &c)
+	}
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetBusinessDaysResponse) Clone() *GetBusinessDaysResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.BusinessDay = r.BusinessDay.Clone()
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetAddressesByOldCodeResponse) Clone() *GetAddressesByOldCodeResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.Addresses = cloneAddresses(r.Addresses)
+	v.DecodeErrors = cloneErrors(r.DecodeErrors)
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetAddressesByCityCodeResponse) Clone() *GetAddressesByCityCodeResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.City = r.City.Clone()
+	v.Addresses = cloneAddresses(r.Addresses)
+	v.DecodeErrors = cloneErrors(r.DecodeErrors)
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *SearchCorporationsResponse) Clone() *SearchCorporationsResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.Corporations = cloneCorporations(r.Corporations)
+	v.DecodeErrors = cloneErrors(r.DecodeErrors)
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *ResolveAddressResponse) Clone() *ResolveAddressResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.Normalized = r.Normalized.Clone()
+	v.Address = r.Address.Clone()
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetBanksResponse) Clone() *GetBanksResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	if r.Banks != nil {
+		v.Banks = make([]*Bank, 0, len(r.Banks))
+	}
+
+	for _, b := range r.Banks {
+		v.Banks = append(v.Banks, b.Clone())
+	}
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetBankResponse) Clone() *GetBankResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.Bank = r.Bank.Clone()
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetBankBranchesResponse) Clone() *GetBankBranchesResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	if r.Branches != nil {
+		v.Branches = make(map[string][]*BankBranch, len(r.Branches))
+		for code, branches := range r.Branches {
+			v.Branches[code] = cloneBankBranches(branches)
+		}
+	}
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetBankBranchResponse) Clone() *GetBankBranchResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.Branches = cloneBankBranches(r.Branches)
+
+	return &v
+}
+
+// Clone returns a deep copy of the response.
+func (r *GetInvoiceIssuerResponse) Clone() *GetInvoiceIssuerResponse {
+	if r == nil {
+		return nil
+	}
+
+	v := *r
+	v.ResponseMeta = r.ResponseMeta.clone()
+	v.InvoiceIssuer = r.InvoiceIssuer.Clone()
+
+	return &v
+}
+
+func cloneAddresses(addresses []*Address) []*Address {
+	if addresses == nil {
+		return nil
+	}
+
+	ret := make([]*Address, 0, len(addresses))
+	for _, a := range addresses {
+		ret = append(ret, a.Clone())
+	}
+
+	return ret
+}
+
+func cloneCities(cities []*City) []*City {
+	if cities == nil {
+		return nil
+	}
+
+	ret := make([]*City, 0, len(cities))
+	for _, c := range cities {
+		ret = append(ret, c.Clone())
+	}
+
+	return ret
+}
+
+func cloneCorporations(corporations []*Corporation) []*Corporation {
+	if corporations == nil {
+		return nil
+	}
+
+	ret := make([]*Corporation, 0, len(corporations))
+	for _, c := range corporations {
+		ret = append(ret, c.Clone())
+	}
+
+	return ret
+}
+
+func cloneHolidays(holidays []*Holiday) []*Holiday {
+	if holidays == nil {
+		return nil
+	}
+
+	ret := make([]*Holiday, 0, len(holidays))
+	for _, h := range holidays {
+		ret = append(ret, h.Clone())
+	}
+
+	return ret
+}
+
+func cloneBankBranches(branches []*BankBranch) []*BankBranch {
+	if branches == nil {
+		return nil
+	}
+
+	ret := make([]*BankBranch, 0, len(branches))
+	for _, b := range branches {
+		ret = append(ret, b.Clone())
+	}
+
+	return ret
+}
+
+// cloneErrors copies the slice of errors, the errors themselves are immutable.
+func cloneErrors(errs []error) []error {
+	if errs == nil {
+		return nil
+	}
+
+	return append([]error(nil), errs...)
+}
@@ -0,0 +1,71 @@
+package kenall_test
+
+import (
+	"net"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestGetAddressResponse_Clone(t *testing.T) {
+	t.Parallel()
+
+	var nilRes *kenall.GetAddressResponse
+	if nilRes.Clone() != nil {
+		t.Error("a clone of nil should be nil")
+	}
+
+	res := &kenall.GetAddressResponse{Addresses: []*kenall.Address{{Town: "西新宿"}}}
+	c := res.Clone()
+
+	c.Addresses[0].Town = "丸の内"
+	c.Addresses = append(c.Addresses, &kenall.Address{})
+
+	if res.Addresses[0].Town != "西新宿" || len(res.Addresses) != 1 {
+		t.Errorf("give: %+v, want: the original not mutated", res.Addresses[0])
+	}
+}
+
+func TestSearchCorporationsResponse_Clone(t *testing.T) {
+	t.Parallel()
+
+	res := &kenall.SearchCorporationsResponse{Corporations: []*kenall.Corporation{{Name: "A"}}, Count: 1}
+	c := res.Clone()
+
+	c.Corporations[0].Name = "B"
+
+	if res.Corporations[0].Name != "A" || c.Count != 1 {
+		t.Errorf("give: %v, want: A", res.Corporations[0].Name)
+	}
+}
+
+func TestGetWhoamiResponse_Clone(t *testing.T) {
+	t.Parallel()
+
+	res := &kenall.GetWhoamiResponse{RemoteAddress: &kenall.RemoteAddress{
+		Type: "v4", Address: "192.0.2.1", IPAddr: &net.IPAddr{IP: net.ParseIP("192.0.2.1")},
+	}}
+	c := res.Clone()
+
+	c.RemoteAddress.IPAddr.IP[len(c.RemoteAddress.IPAddr.IP)-1] = 2
+
+	if got := res.RemoteAddress.IPAddr.String(); got != "192.0.2.1" {
+		t.Errorf("give: %v, want: %v", got, "192.0.2.1")
+	}
+}
+
+func TestResolveAddressResponse_Clone(t *testing.T) {
+	t.Parallel()
+
+	res := &kenall.ResolveAddressResponse{
+		Normalized: &kenall.GetNormalizeAddressResponse{Addresses: []*kenall.Address{{PostalCode: "1638001"}}},
+		PostalCode: "1638001",
+	}
+	c := res.Clone()
+
+	c.Normalized.Addresses[0].PostalCode = "1000001"
+
+	if res.Normalized.Addresses[0].PostalCode != "1638001" || c.Address != nil {
+		t.Error("the original should not be mutated")
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"math/rand"
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2/kenalltest"
+)
+
+// A profile configures the latency and the errors of the mock server.
+type profile struct {
+	// Latency is the base delay before each response.
+	Latency time.Duration
+	// Jitter is the maximum random delay added to the latency.
+	Jitter time.Duration
+	// ErrorRate is the probability from 0 to 1 to respond with one of the error statuses.
+	ErrorRate float64
+	// ErrorStatuses are HTTP status codes responded by the error rate, it defaults to 500.
+	ErrorStatuses []int
+}
+
+// A handler delays the responses of the next handler and replaces them with errors by the profile.
+type handler struct {
+	next    http.Handler
+	profile profile
+
+	mu  sync.Mutex
+	rnd *rand.Rand
+}
+
+func newHandler(next http.Handler, p profile) *handler {
+	if len(p.ErrorStatuses) == 0 {
+		p.ErrorStatuses = []int{http.StatusInternalServerError}
+	}
+
+	//nolint: gosec
+	return &handler{next: next, profile: p, rnd: rand.New(rand.NewSource(time.Now().UnixNano()))}
+}
+
+// ServeHTTP implements http.Handler interface.
+func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	delay, status := h.draw()
+	if err := sleep(r.Context(), delay); err != nil {
+		return
+	}
+
+	if status != 0 {
+		w.WriteHeader(status)
+
+		return
+	}
+
+	h.next.ServeHTTP(w, r)
+}
+
+// draw returns the delay and the error status of a response, zero status means a successful response.
+func (h *handler) draw() (time.Duration, int) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	delay := h.profile.Latency
+	if h.profile.Jitter > 0 {
+		delay += time.Duration(h.rnd.Int63n(int64(h.profile.Jitter)))
+	}
+
+	if h.profile.ErrorRate > 0 && h.rnd.Float64() < h.profile.ErrorRate {
+		return delay, h.profile.ErrorStatuses[h.rnd.Intn(len(h.profile.ErrorStatuses))]
+	}
+
+	return delay, 0
+}
+
+// loadFixtures returns the options of kenalltest.Server serving the fixture files of the directory.
+//
+// A fixture file with the ".json" extension is served for the request path without it, e.g.
+// "postalcode/1008105.json" for "/postalcode/1008105". "index.json" is served for the path of its directory,
+// e.g. "postalcode/index.json" for the search "/postalcode/?q=...". Query strings are ignored.
+func loadFixtures(fixtures fs.FS) ([]kenalltest.ServerOption, error) {
+	var opts []kenalltest.ServerOption
+
+	err := fs.WalkDir(fixtures, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(name) != ".json" {
+			return err
+		}
+
+		body, err := fs.ReadFile(fixtures, name)
+		if err != nil {
+			return err //nolint: wrapcheck
+		}
+
+		p := "/" + strings.TrimSuffix(name, ".json")
+		if path.Base(p) == "index" {
+			dir := path.Dir(p)
+			opts = append(opts, kenalltest.WithFixture(dir, body))
+			p = strings.TrimSuffix(dir, "/") + "/"
+		}
+
+		opts = append(opts, kenalltest.WithFixture(p, body))
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the fixtures: %w", err)
+	}
+
+	return opts, nil
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/osamingo/go-kenall/v2"
+	"github.com/osamingo/go-kenall/v2/kenalltest"
+)
+
+func TestHandler(t *testing.T) {
+	t.Parallel()
+
+	opts, err := loadFixtures(fstest.MapFS{
+		"postalcode/1000001.json": {Data: []byte(`{"version":"2022-11-30","data":[{"town":"千代田"}]}`)},
+		"postalcode/index.json":   {Data: []byte(`{"version":"2022-11-30","query":{},"data":[]}`)},
+		"README.md":               {Data: []byte("not a fixture")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	next := kenalltest.NewHandler(append(opts, kenalltest.WithToken("opencollector"))...)
+	srv := httptest.NewServer(newHandler(next, profile{}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	if _, err := cli.GetAddress(ctx, "1008105"); err != nil {
+		t.Error(err)
+	}
+	if res, err := cli.GetAddress(ctx, "1000001"); err != nil || res.Addresses[0].Town != "千代田" {
+		t.Errorf("give: %v, want: the address of the fixture directory", err)
+	}
+	if _, err := cli.GetCity(ctx, "13"); err != nil {
+		t.Error(err)
+	}
+	if _, err := cli.GetCorporation(ctx, "2021001052596"); err != nil {
+		t.Error(err)
+	}
+	if _, err := cli.GetWhoami(ctx); err != nil {
+		t.Error(err)
+	}
+	if _, err := cli.GetHolidays(ctx); err != nil {
+		t.Error(err)
+	}
+	if res, err := cli.GetNormalizeAddress(ctx, "東京都港区六本木"); err != nil || len(res.Addresses) != 0 {
+		t.Errorf("give: %v, want: the search of the fixture directory", err)
+	}
+	if _, err := cli.GetAddress(ctx, "0000000"); err == nil {
+		t.Error("an error should not be nil for a missing fixture")
+	}
+
+	unauthorized, err := kenall.NewClient("wrong", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := unauthorized.GetWhoami(ctx); err == nil {
+		t.Error("an error should not be nil for a wrong token")
+	}
+}
+
+func TestHandler_ErrorProfile(t *testing.T) {
+	t.Parallel()
+
+	next := kenalltest.NewHandler(kenalltest.WithToken(""))
+	srv := httptest.NewServer(newHandler(next, profile{ErrorRate: 1, ErrorStatuses: []int{http.StatusServiceUnavailable}}))
+	t.Cleanup(srv.Close)
+
+	resp, err := http.Get(srv.URL + "/whoami") //nolint: noctx
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+
+	if resp.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("give: %v, want: %v", resp.StatusCode, http.StatusServiceUnavailable)
+	}
+}
+
+func TestParseStatuses(t *testing.T) {
+	t.Parallel()
+
+	if codes, err := parseStatuses("500, 503"); err != nil || len(codes) != 2 {
+		t.Errorf("give: %v, %v, want: [500 503]", codes, err)
+	}
+	if _, err := parseStatuses("50x"); err == nil {
+		t.Error("an error should not be nil")
+	}
+}
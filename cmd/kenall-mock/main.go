@@ -0,0 +1,98 @@
+// Command kenall-mock is a mock server of the kenall service serving the fixtures of kenalltest.Server.
+//
+// Usage:
+//
+//	kenall-mock [-addr :8080] [-fixtures dir] [-token token] [-latency 0s] [-jitter 0s]
+//	            [-error-rate 0] [-error-statuses 500,503]
+//
+// The fixtures of kenalltest.Server are served, e.g. the postal code "1008105" and the prefecture code "13",
+// the ones in the directory of -fixtures are served in addition to them.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2/kenalltest"
+)
+
+const readHeaderTimeout = 10 * time.Second
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(args []string) error {
+	fset := flag.NewFlagSet("kenall-mock", flag.ContinueOnError)
+
+	var (
+		addr     = fset.String("addr", ":8080", "address to listen on")
+		dir      = fset.String("fixtures", "", "fixture directory served in addition to the bundled fixtures")
+		token    = fset.String("token", "", "authorization token required for requests, any token is accepted if it is empty")
+		latency  = fset.Duration("latency", 0, "base latency of responses")
+		jitter   = fset.Duration("jitter", 0, "maximum random latency added to responses")
+		rate     = fset.Float64("error-rate", 0, "probability from 0 to 1 to respond with an error")
+		statuses = fset.String("error-statuses", "500", "comma separated HTTP status codes of errors")
+	)
+
+	if err := fset.Parse(args); err != nil {
+		return fmt.Errorf("failed to parse flags: %w", err)
+	}
+
+	codes, err := parseStatuses(*statuses)
+	if err != nil {
+		return err
+	}
+
+	opts := []kenalltest.ServerOption{kenalltest.WithToken(*token)}
+
+	if *dir != "" {
+		fixtures, err := loadFixtures(os.DirFS(*dir))
+		if err != nil {
+			return err
+		}
+
+		opts = append(opts, fixtures...)
+	}
+
+	next := kenalltest.NewHandler(opts...)
+	h := newHandler(next, profile{Latency: *latency, Jitter: *jitter, ErrorRate: *rate, ErrorStatuses: codes})
+	srv := &http.Server{Addr: *addr, Handler: h, ReadHeaderTimeout: readHeaderTimeout}
+
+	log.Printf("kenall-mock: listening on %s", *addr)
+
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("failed to serve: %w", err)
+	}
+
+	return nil
+}
+
+func parseStatuses(s string) ([]int, error) {
+	fields := strings.Split(s, ",")
+	codes := make([]int, 0, len(fields))
+
+	for _, f := range fields {
+		if f = strings.TrimSpace(f); f == "" {
+			continue
+		}
+
+		code, err := strconv.Atoi(f)
+		if err != nil || code < 100 || code > 599 {
+			return nil, fmt.Errorf("invalid error status: %q", f)
+		}
+
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
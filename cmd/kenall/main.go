@@ -0,0 +1,263 @@
+// Command kenall requests to the kenall service from the command line, e.g. for operations and shell scripts.
+//
+// Usage:
+//
+//	kenall address [flags] <postal code>
+//	kenall city [flags] <prefecture code>
+//	kenall corp [flags] <corporate number>
+//	kenall holidays [flags] [-year 2023]
+//	kenall normalize [flags] <address>
+//	kenall whoami [flags]
+//
+// Flags:
+//
+//	-token     authorization token, it defaults to the KENALL_TOKEN environment variable
+//	-endpoint  endpoint of the kenall service
+//	-json, -csv, -table
+//	           output format, the table is printed by default
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+// tokenEnv is the environment variable of the authorization token.
+const tokenEnv = "KENALL_TOKEN"
+
+var errUsage = errors.New("usage: kenall <address|city|corp|holidays|normalize|whoami> [flags] [argument]")
+
+type (
+	// A command requests to the kenall service with the flags and returns the output.
+	command func(ctx context.Context, cli *kenall.Client, fl *flags) (*output, error)
+
+	// flags are the parsed flags and arguments of the command.
+	flags struct {
+		name     string
+		args     []string
+		token    string
+		endpoint string
+		year     int
+		json     bool
+		csv      bool
+		table    bool
+	}
+)
+
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if err := run(ctx, os.Args[1:], os.Stdout, os.Getenv); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		stop()
+		os.Exit(1) //nolint: gocritic
+	}
+}
+
+func run(ctx context.Context, args []string, w io.Writer, getenv func(string) string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+
+	commands := map[string]command{
+		"address":   address,
+		"city":      city,
+		"corp":      corp,
+		"holidays":  holidays,
+		"normalize": normalize,
+		"whoami":    whoami,
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		return errUsage
+	}
+
+	fl := &flags{name: "kenall " + args[0]}
+
+	fset := flag.NewFlagSet(fl.name, flag.ContinueOnError)
+	fset.SetOutput(io.Discard)
+	fset.StringVar(&fl.token, "token", getenv(tokenEnv), "authorization token")
+	fset.StringVar(&fl.endpoint, "endpoint", kenall.Endpoint, "endpoint of the kenall service")
+	fset.BoolVar(&fl.json, "json", false, "print in JSON")
+	fset.BoolVar(&fl.csv, "csv", false, "print in CSV")
+	fset.BoolVar(&fl.table, "table", false, "print in a table")
+
+	if args[0] == "holidays" {
+		fset.IntVar(&fl.year, "year", 0, "year of the holidays, zero means all of the holidays")
+	}
+
+	if err := fset.Parse(args[1:]); err != nil {
+		return fmt.Errorf("%s: %w", fl.name, err)
+	}
+
+	fl.args = fset.Args()
+
+	cli, err := kenall.NewClient(fl.token, kenall.WithEndpoint(fl.endpoint))
+	if err != nil {
+		return fmt.Errorf("kenall: a token is required with -token flag or %s: %w", tokenEnv, err)
+	}
+
+	out, err := cmd(ctx, cli, fl)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case fl.json:
+		return out.writeJSON(w)
+	case fl.csv:
+		return out.writeCSV(w)
+	default:
+		return out.writeTable(w)
+	}
+}
+
+// arg returns the only argument of the command.
+func (fl *flags) arg() (string, error) {
+	if len(fl.args) != 1 {
+		return "", fmt.Errorf("%s: %w", fl.name, errUsage)
+	}
+
+	return fl.args[0], nil
+}
+
+func address(ctx context.Context, cli *kenall.Client, fl *flags) (*output, error) {
+	code, err := fl.arg()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cli.GetAddress(ctx, code)
+	if err != nil {
+		return nil, err //nolint: wrapcheck
+	}
+
+	return addressOutput(res, res.Addresses), nil
+}
+
+func normalize(ctx context.Context, cli *kenall.Client, fl *flags) (*output, error) {
+	addr, err := fl.arg()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cli.GetNormalizeAddress(ctx, addr)
+	if err != nil {
+		return nil, err //nolint: wrapcheck
+	}
+
+	return addressOutput(res, res.Addresses), nil
+}
+
+func city(ctx context.Context, cli *kenall.Client, fl *flags) (*output, error) {
+	code, err := fl.arg()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cli.GetCity(ctx, code)
+	if err != nil {
+		return nil, err //nolint: wrapcheck
+	}
+
+	out := &output{
+		value:  res,
+		header: []string{"jisx0402", "prefecture_code", "city_code", "prefecture", "city", "city_kana"},
+	}
+	for _, c := range res.Cities {
+		out.rows = append(out.rows, []string{c.JISX0402, c.PrefectureCode, c.CityCode, c.Prefecture, c.City, c.CityKana})
+	}
+
+	return out, nil
+}
+
+func corp(ctx context.Context, cli *kenall.Client, fl *flags) (*output, error) {
+	number, err := fl.arg()
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := cli.GetCorporation(ctx, number)
+	if err != nil {
+		return nil, err //nolint: wrapcheck
+	}
+
+	c := res.Corporation
+
+	return &output{
+		value:  res,
+		header: []string{"corporate_number", "name", "furigana", "prefecture", "city", "street_number", "close_date"},
+		rows: [][]string{{
+			c.CorporateNumber, c.Name, c.Furigana, c.PrefectureName, c.CityName, c.StreetNumber, c.CloseDate.String,
+		}},
+	}, nil
+}
+
+func holidays(ctx context.Context, cli *kenall.Client, fl *flags) (*output, error) {
+	if len(fl.args) != 0 {
+		return nil, fmt.Errorf("%s: %w", fl.name, errUsage)
+	}
+
+	var (
+		res *kenall.GetHolidaysResponse
+		err error
+	)
+
+	if fl.year != 0 {
+		res, err = cli.GetHolidaysByYear(ctx, fl.year)
+	} else {
+		res, err = cli.GetHolidays(ctx)
+	}
+
+	if err != nil {
+		return nil, err //nolint: wrapcheck
+	}
+
+	out := &output{value: res, header: []string{"date", "title"}}
+	for _, h := range res.Holidays {
+		out.rows = append(out.rows, []string{h.Format(kenall.RFC3339DateFormat), h.Title})
+	}
+
+	return out, nil
+}
+
+func whoami(ctx context.Context, cli *kenall.Client, fl *flags) (*output, error) {
+	if len(fl.args) != 0 {
+		return nil, fmt.Errorf("%s: %w", fl.name, errUsage)
+	}
+
+	res, err := cli.GetWhoami(ctx)
+	if err != nil {
+		return nil, err //nolint: wrapcheck
+	}
+
+	return &output{
+		value:  res,
+		header: []string{"type", "address"},
+		rows:   [][]string{{res.RemoteAddress.Type, res.RemoteAddress.Address}},
+	}, nil
+}
+
+func addressOutput(res interface{}, addrs []*kenall.Address) *output {
+	out := &output{
+		value:  res,
+		header: []string{"postal_code", "jisx0402", "prefecture", "city", "town", "building", "floor", "corporation"},
+	}
+
+	for _, a := range addrs {
+		out.rows = append(out.rows, []string{
+			a.PostalCode, a.JISX0402, a.Prefecture, a.City, a.Town, a.Building, a.Floor, a.Corporation.Name,
+		})
+	}
+
+	return out
+}
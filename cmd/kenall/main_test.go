@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2/kenalltest"
+)
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	srv := kenalltest.NewServer()
+	t.Cleanup(srv.Close)
+
+	getenv := func(key string) string {
+		if key == tokenEnv {
+			return kenalltest.DefaultToken
+		}
+
+		return ""
+	}
+
+	cases := map[string]struct {
+		args      []string
+		want      string
+		wantError bool
+	}{
+		"Address table":   {args: []string{"address", "100-8105"}, want: "POSTAL_CODE"},
+		"Address CSV":     {args: []string{"address", "-csv", "1008105"}, want: "postal_code,jisx0402,"},
+		"City":            {args: []string{"city", "13"}, want: "千代田区"},
+		"Corporation":     {args: []string{"corp", "2021001052596"}, want: "2021001052596"},
+		"Holidays":        {args: []string{"holidays", "-csv"}, want: "date,title\n"},
+		"Holidays year":   {args: []string{"holidays", "-year", "2022"}, want: "DATE"},
+		"Normalize":       {args: []string{"normalize", "東京都千代田区大手町"}, want: "POSTAL_CODE"},
+		"Whoami":          {args: []string{"whoami", "-csv"}, want: "type,address\n"},
+		"No command":      {args: nil, wantError: true},
+		"Unknown command": {args: []string{"unknown"}, wantError: true},
+		"No argument":     {args: []string{"address"}, wantError: true},
+		"Unknown flag":    {args: []string{"address", "-year", "2022", "1008105"}, wantError: true},
+		"Not found":       {args: []string{"address", "9999999"}, wantError: true},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+
+			args := c.args
+			if len(args) > 0 {
+				args = append([]string{args[0], "-endpoint", srv.URL}, args[1:]...)
+			}
+
+			err := run(context.Background(), args, &buf, getenv)
+			if (err != nil) != c.wantError {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if !strings.Contains(buf.String(), c.want) {
+				t.Errorf("give: %s, want: %s", buf.String(), c.want)
+			}
+		})
+	}
+}
+
+func TestRun_JSON(t *testing.T) {
+	t.Parallel()
+
+	srv := kenalltest.NewServer()
+	t.Cleanup(srv.Close)
+
+	var buf bytes.Buffer
+
+	args := []string{"address", "-json", "-endpoint", srv.URL, "-token", kenalltest.DefaultToken, "1008105"}
+	if err := run(context.Background(), args, &buf, func(string) string { return "" }); err != nil {
+		t.Fatal(err)
+	}
+
+	var res struct {
+		Addresses []struct {
+			PostalCode string `json:"postal_code"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Addresses) == 0 || res.Addresses[0].PostalCode != "1638001" {
+		t.Errorf("give: %s", buf.String())
+	}
+
+	if err := run(context.Background(), []string{"whoami"}, &buf, func(string) string { return "" }); err == nil {
+		t.Error("an error should not be nil without a token")
+	}
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+// An output is the result of a command printed in JSON, CSV or a table.
+type output struct {
+	// value is the response printed in JSON as is.
+	value  interface{}
+	header []string
+	rows   [][]string
+}
+
+func (o *output) writeJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(o.value); err != nil {
+		return fmt.Errorf("kenall: failed to write JSON: %w", err)
+	}
+
+	return nil
+}
+
+func (o *output) writeCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(o.header); err != nil {
+		return fmt.Errorf("kenall: failed to write CSV: %w", err)
+	}
+
+	if err := cw.WriteAll(o.rows); err != nil {
+		return fmt.Errorf("kenall: failed to write CSV: %w", err)
+	}
+
+	return nil
+}
+
+func (o *output) writeTable(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0) //nolint: gomnd
+
+	header := make([]string, 0, len(o.header))
+	for _, h := range o.header {
+		header = append(header, strings.ToUpper(h))
+	}
+
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+
+	for _, row := range o.rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+
+	if err := tw.Flush(); err != nil {
+		return fmt.Errorf("kenall: failed to write the table: %w", err)
+	}
+
+	return nil
+}
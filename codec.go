@@ -0,0 +1,56 @@
+package kenall
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// A JSONCodec marshals and unmarshals JSON, e.g. to use a faster library than encoding/json on hot paths.
+// See kenall.WithJSONCodec.
+type JSONCodec struct {
+	Marshal   func(v interface{}) ([]byte, error)
+	Unmarshal func(data []byte, v interface{}) error
+}
+
+// decodeJSON decodes the response body into the response, it streams the body with encoding/json
+// unless kenall.WithJSONCodec is given.
+func (cli *Client) decodeJSON(body io.Reader, res interface{}) error {
+	if cli.codec == nil {
+		if err := json.NewDecoder(body).Decode(res); err != nil {
+			return fmt.Errorf("kenall: failed to decode to response: %w", err)
+		}
+
+		return nil
+	}
+
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return fmt.Errorf("kenall: failed to read the response: %w", err)
+	}
+
+	return cli.unmarshalJSON(b, res)
+}
+
+func (cli *Client) unmarshalJSON(data []byte, v interface{}) error {
+	unmarshal := json.Unmarshal
+	if cli.codec != nil {
+		unmarshal = cli.codec.Unmarshal
+	}
+
+	if err := unmarshal(data, v); err != nil {
+		return fmt.Errorf("kenall: failed to decode to response: %w", err)
+	}
+
+	return nil
+}
+
+func (cli *Client) marshalJSON(v interface{}) ([]byte, error) {
+	if cli.codec == nil {
+		//nolint: wrapcheck
+		return json.Marshal(v)
+	}
+
+	//nolint: wrapcheck
+	return cli.codec.Marshal(v)
+}
@@ -0,0 +1,159 @@
+package kenall_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithJSONCodec(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	var marshaled, unmarshaled int32
+
+	codec := kenall.JSONCodec{
+		Marshal: func(v interface{}) ([]byte, error) {
+			atomic.AddInt32(&marshaled, 1)
+
+			return json.Marshal(v)
+		},
+		Unmarshal: func(data []byte, v interface{}) error {
+			atomic.AddInt32(&unmarshaled, 1)
+
+			return json.Unmarshal(data, v)
+		},
+	}
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL),
+		kenall.WithJSONCodec(codec), kenall.WithCache(kenall.NewMemoryCache(0, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		res, err := cli.GetAddress(context.Background(), "1008105")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := res.Addresses[0].Town; got != "西新宿" {
+			t.Errorf("give: %v, want: %v", got, "西新宿")
+		}
+	}
+
+	// NOTE: the response, the cached entry and the cached response are unmarshaled.
+	if m, u := atomic.LoadInt32(&marshaled), atomic.LoadInt32(&unmarshaled); m != 1 || u != 3 {
+		t.Errorf("give: %v %v, want: %v %v", m, u, 1, 3)
+	}
+
+	if _, err := kenall.NewClient("opencollector", kenall.WithJSONCodec(kenall.JSONCodec{})); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+
+	failing := kenall.JSONCodec{
+		Marshal: json.Marshal,
+		Unmarshal: func([]byte, interface{}) error {
+			return errors.New("broken")
+		},
+	}
+
+	cli, err = kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithJSONCodec(failing))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetAddress(context.Background(), "1008105"); err == nil {
+		t.Error("an error should not be nil")
+	}
+}
+
+func TestWithJSONCodec_PooledDecoding(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	var unmarshaled int32
+
+	codec := kenall.JSONCodec{
+		Marshal: json.Marshal,
+		Unmarshal: func(data []byte, v interface{}) error {
+			atomic.AddInt32(&unmarshaled, 1)
+
+			return json.Unmarshal(data, v)
+		},
+	}
+
+	plain, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pooled, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL),
+		kenall.WithJSONCodec(codec), kenall.WithPooledDecoding())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	want, err := plain.GetCity(ctx, "13")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := pooled.GetCity(ctx, "13")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Release()
+
+	if !reflect.DeepEqual(res.Cities, want.Cities) {
+		t.Errorf("give: %+v, want: %+v", res.Cities, want.Cities)
+	}
+
+	// NOTE: the response, the array of the cities and each city are unmarshaled.
+	if u, n := atomic.LoadInt32(&unmarshaled), int32(2+len(want.Cities)); u != n {
+		t.Errorf("give: %v, want: %v", u, n)
+	}
+}
+
+func BenchmarkClient_GetAddress_JSONCodec(b *testing.B) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(addressResponse)
+	}))
+	b.Cleanup(srv.Close)
+
+	cases := map[string][]kenall.ClientOption{
+		"encoding/json decoder": nil,
+		"Unmarshal codec":       {kenall.WithJSONCodec(kenall.JSONCodec{Marshal: json.Marshal, Unmarshal: json.Unmarshal})},
+	}
+
+	for name, opts := range cases {
+		cli, err := kenall.NewClient("opencollector", append([]kenall.ClientOption{kenall.WithEndpoint(srv.URL)}, opts...)...)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(name, func(b *testing.B) {
+			b.ReportAllocs()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := cli.GetAddress(context.Background(), "1008105"); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+package kenall
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const jisx0402WithCheckDigitLength = 6
+
+type (
+	// A PostalCode is a 7-digit postal code defined by JP POST, e.g. "1008105".
+	PostalCode string
+	// A PrefectureCode is a 2-digit prefecture code defined by JIS X 0401, e.g. "13".
+	PrefectureCode string
+	// A JISX0402 is a 5-digit local government code defined by JIS X 0402, e.g. "13101".
+	JISX0402 string
+	// A CorporateNumber is a 13-digit corporate number defined by National Tax Agency, e.g. "2021001052596".
+	CorporateNumber string
+)
+
+// ParsePostalCode normalizes the user input into kenall.PostalCode and validates it,
+// hyphens, spaces, the postal mark and full-width digits are accepted, e.g. "〒100-8105".
+func ParsePostalCode(s string) (PostalCode, error) {
+	c := PostalCode(normalizeCode(strings.TrimPrefix(strings.TrimSpace(s), "〒")))
+
+	return c, c.Validate()
+}
+
+// Validate returns an error wrapping kenall.ErrInvalidArgument if the postal code is not 7 digits.
+func (c PostalCode) Validate() error {
+	if !isDigits(string(c), postalCodeLength) {
+		return fmt.Errorf("kenall: postal code is not 7 digits, code = %s: %w", c, ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface.
+func (c PostalCode) String() string {
+	return string(c)
+}
+
+// ParsePrefectureCode normalizes the user input into kenall.PrefectureCode and validates it,
+// a single digit is zero-padded, e.g. "1" to "01".
+func ParsePrefectureCode(s string) (PrefectureCode, error) {
+	n := normalizeCode(s)
+	if len(n) == 1 {
+		n = "0" + n
+	}
+
+	c := PrefectureCode(n)
+
+	return c, c.Validate()
+}
+
+// Validate returns an error wrapping kenall.ErrInvalidArgument if the prefecture code is undefined.
+func (c PrefectureCode) Validate() error {
+	_, err := prefectureByCode(string(c))
+
+	return err
+}
+
+// Name returns the prefecture name, e.g. "東京都" for "13".
+func (c PrefectureCode) Name() (string, error) {
+	return PrefectureCodeToName(string(c))
+}
+
+// String implements fmt.Stringer interface.
+func (c PrefectureCode) String() string {
+	return string(c)
+}
+
+// ParseJISX0402 normalizes the user input into kenall.JISX0402 and validates it, a 4-digit code of
+// a prefecture before "10" is zero-padded, and a 6-digit code with the check digit is verified
+// and truncated to 5 digits.
+func ParseJISX0402(s string) (JISX0402, error) {
+	n := normalizeCode(s)
+
+	switch len(n) {
+	case jisx0402Length - 1:
+		n = "0" + n
+	case jisx0402WithCheckDigitLength:
+		if !isDigits(n, jisx0402WithCheckDigitLength) || jisx0402CheckDigit(n[:jisx0402Length]) != n[jisx0402Length] {
+			return JISX0402(n), fmt.Errorf("kenall: mismatched check digit of JIS X 0402, code = %s: %w", n, ErrInvalidArgument)
+		}
+
+		n = n[:jisx0402Length]
+	}
+
+	c := JISX0402(n)
+
+	return c, c.Validate()
+}
+
+// Validate returns an error wrapping kenall.ErrInvalidArgument if the code is not 5 digits
+// or the prefecture of the code is undefined.
+func (c JISX0402) Validate() error {
+	if !isDigits(string(c), jisx0402Length) {
+		return fmt.Errorf("kenall: JIS X 0402 code is not 5 digits, code = %s: %w", c, ErrInvalidArgument)
+	}
+
+	return c.PrefectureCode().Validate()
+}
+
+// PrefectureCode returns the prefecture code of the first 2 digits.
+func (c JISX0402) PrefectureCode() PrefectureCode {
+	if len(c) < prefectureCodeLength {
+		return ""
+	}
+
+	return PrefectureCode(c[:prefectureCodeLength])
+}
+
+// CheckDigit returns the check digit of the 6-digit form of the code.
+func (c JISX0402) CheckDigit() (byte, error) {
+	if err := c.Validate(); err != nil {
+		return 0, err
+	}
+
+	return jisx0402CheckDigit(string(c)), nil
+}
+
+// String implements fmt.Stringer interface.
+func (c JISX0402) String() string {
+	return string(c)
+}
+
+// ParseCorporateNumber normalizes the user input into kenall.CorporateNumber and validates it,
+// hyphens, spaces and full-width digits are accepted, e.g. "2-0210-0105-2596".
+func ParseCorporateNumber(s string) (CorporateNumber, error) {
+	c := CorporateNumber(normalizeCode(s))
+
+	return c, c.Validate()
+}
+
+// Validate returns an error wrapping kenall.ErrInvalidArgument if the corporate number is not 13 digits
+// or its check digit, the first digit, mismatches.
+func (c CorporateNumber) Validate() error {
+	if !isDigits(string(c), corporateNumberLength) {
+		return fmt.Errorf("kenall: corporate number is not 13 digits, number = %s: %w", c, ErrInvalidArgument)
+	}
+
+	if corporateNumberCheckDigit(string(c[1:])) != c[0] {
+		return fmt.Errorf("kenall: mismatched check digit of corporate number, number = %s: %w", c, ErrInvalidArgument)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface.
+func (c CorporateNumber) String() string {
+	return string(c)
+}
+
+// normalizeCode converts full-width digits to ASCII and removes hyphens and spaces of the code.
+func normalizeCode(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= '０' && r <= '９':
+			return r - '０' + '0'
+		case unicode.IsSpace(r) || strings.ContainsRune("-‐－−ー", r):
+			return -1
+		default:
+			return r
+		}
+	}, s)
+}
+
+// jisx0402CheckDigit returns the check digit of the 5-digit code by the modulus 11 with the weights 6 to 2.
+func jisx0402CheckDigit(code string) byte {
+	sum := 0
+	for i := 0; i < jisx0402Length; i++ {
+		sum += int(code[i]-'0') * (jisx0402Length + 1 - i)
+	}
+
+	return byte((11-sum%11)%10) + '0' //nolint: gomnd
+}
+
+// corporateNumberCheckDigit returns the check digit of the 12-digit base number defined by National Tax Agency,
+// the digits from the lowest are weighted by 1 and 2 alternately and the check digit is 9 minus the sum modulo 9.
+func corporateNumberCheckDigit(base string) byte {
+	sum := 0
+	for i := 0; i < len(base); i++ {
+		w := 1
+		if i%2 == 1 {
+			w = 2
+		}
+
+		sum += int(base[len(base)-1-i]-'0') * w
+	}
+
+	return byte(9-sum%9) + '0' //nolint: gomnd
+}
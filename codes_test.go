@@ -0,0 +1,162 @@
+package kenall_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestParsePostalCode(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give    string
+		want    kenall.PostalCode
+		wantErr error
+	}{
+		"Digits":      {give: "1008105", want: "1008105"},
+		"Hyphenated":  {give: "100-8105", want: "1008105"},
+		"Postal mark": {give: " 〒１００－８１０５ ", want: "1008105"},
+		"Long vowel":  {give: "100ー8105", want: "1008105"},
+		"Too short":   {give: "100-810", wantErr: kenall.ErrInvalidArgument},
+		"Letters":     {give: "abcdefg", wantErr: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := kenall.ParsePostalCode(c.give)
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("give: %v, want: %v", err, c.wantErr)
+			}
+
+			if err == nil && got != c.want {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestParsePrefectureCode(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give     string
+		want     kenall.PrefectureCode
+		wantName string
+		wantErr  error
+	}{
+		"Two digits":   {give: "13", want: "13", wantName: "東京都"},
+		"Zero padded":  {give: "1", want: "01", wantName: "北海道"},
+		"Full-width":   {give: "４７", want: "47", wantName: "沖縄県"},
+		"Undefined":    {give: "48", wantErr: kenall.ErrInvalidArgument},
+		"Zero":         {give: "00", wantErr: kenall.ErrInvalidArgument},
+		"Three digits": {give: "013", wantErr: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := kenall.ParsePrefectureCode(c.give)
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("give: %v, want: %v", err, c.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got != c.want {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+
+			if name, _ := got.Name(); name != c.wantName {
+				t.Errorf("give: %v, want: %v", name, c.wantName)
+			}
+		})
+	}
+}
+
+func TestParseJISX0402(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		want      kenall.JISX0402
+		wantCheck byte
+		wantErr   error
+	}{
+		"Five digits":          {give: "13101", want: "13101", wantCheck: '6'},
+		"With check digit":     {give: "131016", want: "13101", wantCheck: '6'},
+		"Zero padded":          {give: "1101", want: "01101", wantCheck: '1'},
+		"Mismatched check":     {give: "131017", wantErr: kenall.ErrInvalidArgument},
+		"Undefined prefecture": {give: "99101", wantErr: kenall.ErrInvalidArgument},
+		"Letters":              {give: "1310a", wantErr: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := kenall.ParseJISX0402(c.give)
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("give: %v, want: %v", err, c.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if got != c.want || got.PrefectureCode() != kenall.PrefectureCode(c.want[:2]) {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+
+			if check, err := got.CheckDigit(); err != nil || check != c.wantCheck {
+				t.Errorf("give: %c, want: %c", check, c.wantCheck)
+			}
+		})
+	}
+}
+
+func TestParseCorporateNumber(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give    string
+		want    kenall.CorporateNumber
+		wantErr error
+	}{
+		"Digits":           {give: "2021001052596", want: "2021001052596"},
+		"Hyphenated":       {give: "2-0210-0105-2596", want: "2021001052596"},
+		"Full-width":       {give: "７０１０００１０８８９６０", want: "7010001088960"},
+		"Mismatched check": {give: "3021001052596", wantErr: kenall.ErrInvalidArgument},
+		"Twelve digits":    {give: "021001052596", wantErr: kenall.ErrInvalidArgument},
+		"Letters":          {give: "202100105259a", wantErr: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := kenall.ParseCorporateNumber(c.give)
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("give: %v, want: %v", err, c.wantErr)
+			}
+
+			if err == nil && got != c.want {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
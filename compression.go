@@ -0,0 +1,80 @@
+package kenall
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// acceptEncoding is the Accept-Encoding header sent by kenall.Client unless kenall.WithCompression(false) is given.
+const acceptEncoding = "gzip, deflate"
+
+// decompressedBody closes both the decompressing reader and the original body.
+type decompressedBody struct {
+	io.Reader
+	closers []io.Closer
+}
+
+// Close implements io.Closer interface.
+func (b *decompressedBody) Close() error {
+	var first error
+	for _, c := range b.closers {
+		if err := c.Close(); err != nil && first == nil {
+			first = err
+		}
+	}
+
+	return first
+}
+
+// decompress negotiates the compression of the response explicitly instead of relying on the transport,
+// since a custom http.Client may not do it, and decompresses the gzip or deflate encoded body.
+func (cli *Client) decompress(next RoundTripFunc) RoundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		if req.Header.Get("Accept-Encoding") == "" {
+			if cli.compression {
+				req.Header.Set("Accept-Encoding", acceptEncoding)
+			} else {
+				req.Header.Set("Accept-Encoding", "identity")
+			}
+		}
+
+		resp, err := next(req)
+		if err != nil {
+			return nil, err
+		}
+
+		// NOTE: a response without a body may still be labelled with the encoding of the representation.
+		if resp.StatusCode == http.StatusNoContent || resp.StatusCode == http.StatusNotModified || resp.ContentLength == 0 {
+			return resp, nil
+		}
+
+		var r io.ReadCloser
+
+		switch strings.ToLower(strings.TrimSpace(resp.Header.Get("Content-Encoding"))) {
+		case "gzip", "x-gzip":
+			r, err = gzip.NewReader(resp.Body)
+		case "deflate":
+			r, err = zlib.NewReader(resp.Body)
+		default:
+			return resp, nil
+		}
+
+		if err != nil {
+			_ = resp.Body.Close()
+
+			return nil, fmt.Errorf("kenall: failed to decompress the response: %w", err)
+		}
+
+		resp.Body = &decompressedBody{Reader: r, closers: []io.Closer{r, resp.Body}}
+		resp.Header.Del("Content-Encoding")
+		resp.Header.Del("Content-Length")
+		resp.ContentLength = -1
+		resp.Uncompressed = true
+
+		return resp, nil
+	}
+}
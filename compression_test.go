@@ -0,0 +1,139 @@
+package kenall_test
+
+import (
+	"compress/gzip"
+	"compress/zlib"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithCompression(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ae := r.Header.Get("Accept-Encoding")
+
+		var zw io.WriteCloser
+
+		switch {
+		case r.URL.Query().Get("encoding") == "deflate" && strings.Contains(ae, "deflate"):
+			w.Header().Set("Content-Encoding", "deflate")
+			zw = zlib.NewWriter(w)
+		case strings.Contains(ae, "gzip"):
+			w.Header().Set("Content-Encoding", "gzip")
+			zw = gzip.NewWriter(w)
+		case ae == "identity":
+			_, _ = w.Write(addressResponse)
+
+			return
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		_, _ = zw.Write(addressResponse)
+		_ = zw.Close()
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		opts     []kenall.ClientOption
+		encoding string
+	}{
+		"Gzip":     {},
+		"Deflate":  {encoding: "deflate"},
+		"Disabled": {opts: []kenall.ClientOption{kenall.WithCompression(false)}},
+		"Custom transport": {opts: []kenall.ClientOption{
+			kenall.WithHTTPClient(&http.Client{Transport: &http.Transport{DisableCompression: true}}),
+		}},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", append([]kenall.ClientOption{kenall.WithEndpoint(srv.URL)}, c.opts...)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			ctx := context.Background()
+			if c.encoding != "" {
+				ctx = kenall.ContextWithRequestOptions(ctx, kenall.WithQuery("encoding", c.encoding))
+			}
+
+			res, err := cli.GetAddress(ctx, "1008105")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := res.Addresses[0].Town; got != "西新宿" {
+				t.Errorf("give: %v, want: %v", got, "西新宿")
+			}
+		})
+	}
+}
+
+func TestWithCompression_NotModified(t *testing.T) {
+	t.Parallel()
+
+	var notModified atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Encoding", "gzip")
+
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+
+			return
+		}
+
+		w.Header().Set("ETag", `"v1"`)
+
+		zw := gzip.NewWriter(w)
+		_, _ = zw.Write(holidaysResponse)
+		_ = zw.Close()
+	}))
+	t.Cleanup(srv.Close)
+
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithClock(clock),
+		kenall.WithCache(kenall.NewMemoryCache(0, 0)),
+		kenall.WithCacheRevalidation(time.Hour),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		res, err := cli.GetHolidays(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Holidays) == 0 {
+			t.Error("the holidays should be decoded")
+		}
+
+		clock.now = clock.now.Add(2 * time.Hour)
+	}
+
+	if got := notModified.Load(); got != 1 {
+		t.Errorf("give: %v, want: %v", got, 1)
+	}
+}
@@ -0,0 +1,98 @@
+package kenall
+
+import (
+	"context"
+	"fmt"
+)
+
+// maxSuccessorDepth is the maximum number of successors followed by kenall.Client.ResolveSuccessor.
+const maxSuccessorDepth = 16
+
+const (
+	// CorporationEventUnknown is the kind of an unknown process code.
+	CorporationEventUnknown CorporationEventKind = ""
+	// CorporationEventNew is the kind of a newly assigned corporate number.
+	CorporationEventNew CorporationEventKind = "new"
+	// CorporationEventChange is the kind of a change of the name or the location, or a revival of the registration.
+	CorporationEventChange CorporationEventKind = "change"
+	// CorporationEventClose is the kind of a closure, a merger, an erasure or a deletion.
+	CorporationEventClose CorporationEventKind = "close"
+)
+
+// A CorporationEventKind is the kind of the latest change of a corporation told by the process code
+// defined by National Tax Agency Japan.
+type CorporationEventKind string
+
+// corporationEventKinds maps the process codes to the kinds of the events.
+var corporationEventKinds = map[string]CorporationEventKind{ //nolint: gochecknoglobals
+	"01": CorporationEventNew,
+	"11": CorporationEventChange,
+	"12": CorporationEventChange,
+	"13": CorporationEventChange,
+	"21": CorporationEventClose,
+	"22": CorporationEventChange,
+	"71": CorporationEventClose,
+	"72": CorporationEventChange,
+	"81": CorporationEventClose,
+	"99": CorporationEventClose,
+}
+
+// EventKind returns the kind of the latest change of the corporation made on ChangeDate,
+// e.g. kenall.CorporationEventChange for a relocation.
+func (c *Corporation) EventKind() CorporationEventKind {
+	p := c.Process.String()
+	if len(p) == 1 {
+		p = "0" + p
+	}
+
+	return corporationEventKinds[p]
+}
+
+// IsCorrection reports whether the latest change of the corporation corrects an error of the previous record.
+func (c *Corporation) IsCorrection() bool {
+	return c.Correct.String() == "1"
+}
+
+// IsClosed reports whether the corporation is closed, e.g. dissolved or merged into another corporation.
+func (c *Corporation) IsClosed() bool {
+	return (c.CloseDate.Valid && c.CloseDate.String != "") || (c.CloseCause.Valid && c.CloseCause.String != "")
+}
+
+// ResolveSuccessor follows the successor corporate numbers from the corporation to find the surviving corporation,
+// e.g. after mergers. It returns the corporations traversed in order, the first is the given corporation
+// and the last is the surviving one. If the chain loops or is too deep, the corporations traversed so far
+// are returned with an error matching ErrSuccessorChain.
+func (cli *Client) ResolveSuccessor(ctx context.Context, corporateNumber string) ([]*Corporation, error) {
+	path := make([]*Corporation, 0, 2) //nolint: gomnd
+	seen := map[string]bool{}
+
+	for number := corporateNumber; ; {
+		if seen[number] {
+			return path, fmt.Errorf("%w: loop at %s", ErrSuccessorChain, number)
+		}
+
+		if len(path) > maxSuccessorDepth {
+			return path, fmt.Errorf("%w: deeper than %d", ErrSuccessorChain, maxSuccessorDepth)
+		}
+
+		seen[number] = true
+
+		res, err := cli.GetCorporation(ctx, number)
+		if err != nil {
+			return path, err
+		}
+
+		if res.Corporation == nil {
+			return path, fmt.Errorf(errFailedRequestFormat, ErrNotFound)
+		}
+
+		path = append(path, res.Corporation)
+
+		next := res.Corporation.SuccessorCorporateNumber
+		if !next.Valid || next.String == "" || next.String == number {
+			return path, nil
+		}
+
+		number = next.String
+	}
+}
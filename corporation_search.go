@@ -0,0 +1,211 @@
+package kenall
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	fullWidthASCIIFirst  = '！'
+	fullWidthASCIILast   = '～'
+	fullWidthASCIIOffset = fullWidthASCIIFirst - '!'
+)
+
+type (
+	// A SearchCorporationsResponse is a result from the kenall service of the API to search corporations.
+	SearchCorporationsResponse struct {
+		Version      Version        `json:"version"`
+		Corporations []*Corporation `json:"data"`
+		Count        int            `json:"count"`
+		// Stale is true if the response is a previous result returned by kenall.WithStaleOnTimeout.
+		Stale bool `json:"-"`
+		// DecodeErrors are the errors of the malformed elements skipped by kenall.WithTolerantDecoding.
+		DecodeErrors []error `json:"-"`
+	}
+	// A CorporationSearchOption provides a customize option for searching and looking up corporations.
+	CorporationSearchOption interface {
+		applyCorporationSearch(*corporationSearch)
+	}
+
+	corporationSearch struct {
+		prefixMatch   bool
+		excludeClosed bool
+		requests      []RequestOption
+	}
+	withFuriganaPrefixMatch   struct{}
+	withoutClosedCorporations struct{}
+)
+
+var _ kanaFielder = (*SearchCorporationsResponse)(nil)
+
+func (withFuriganaPrefixMatch) applyCorporationSearch(s *corporationSearch) {
+	s.prefixMatch = true
+}
+
+// WithFuriganaPrefixMatch matches corporations whose furigana starts with the given furigana
+// instead of matching it exactly.
+func WithFuriganaPrefixMatch() CorporationSearchOption {
+	return withFuriganaPrefixMatch{}
+}
+
+func (withoutClosedCorporations) applyCorporationSearch(s *corporationSearch) {
+	s.excludeClosed = true
+}
+
+// WithoutClosedCorporations excludes closed corporations, see kenall.Corporation.IsClosed.
+// Searches drop them from the results and lookups by corporate number return ErrClosedCorporation.
+// Without the option closed corporations are returned and can be told by kenall.Corporation.IsClosed.
+func WithoutClosedCorporations() CorporationSearchOption {
+	return withoutClosedCorporations{}
+}
+
+// SearchCorporationsByFurigana requests to the kenall service to search corporations by furigana,
+// the reading of the name. The furigana may be written in katakana, half-width katakana or hiragana,
+// only the corporations whose furigana matches exactly are returned unless kenall.WithFuriganaPrefixMatch is given.
+func (cli *Client) SearchCorporationsByFurigana(
+	ctx context.Context, furigana string, opts ...CorporationSearchOption,
+) (*SearchCorporationsResponse, error) {
+	furigana = normalizeFurigana(furigana)
+	if furigana == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	s := newCorporationSearch(opts)
+
+	return cli.searchCorporations(ctx, furigana, s, func(c *Corporation) bool {
+		f := normalizeFurigana(c.Furigana)
+		if s.prefixMatch {
+			return strings.HasPrefix(f, furigana)
+		}
+
+		return f == furigana
+	})
+}
+
+// FindCorporationByName requests to the kenall service to find the corporation whose name matches exactly.
+// The names are compared ignoring spaces, letter case and the width of alphanumerics and katakana.
+// It returns ErrNotFound if no corporation matches and *kenall.AmbiguousCorporationError listing the candidates
+// if more than one corporation matches. It returns an error wrapping ErrTooManyPages instead of deciding
+// on a truncated result if the search does not end within the pages the client requests.
+func (cli *Client) FindCorporationByName(
+	ctx context.Context, exactName string, opts ...CorporationSearchOption,
+) (*Corporation, error) {
+	name := normalizeName(exactName)
+	if name == "" {
+		return nil, ErrInvalidArgument
+	}
+
+	res, err := cli.searchCorporations(ctx, strings.TrimSpace(exactName), newCorporationSearch(opts), func(c *Corporation) bool {
+		return normalizeName(c.Name) == name
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(res.Corporations) {
+	case 0:
+		return nil, fmt.Errorf(errFailedRequestFormat, ErrNotFound)
+	case 1:
+		return res.Corporations[0], nil
+	default:
+		return nil, &AmbiguousCorporationError{Name: exactName, Candidates: res.Corporations}
+	}
+}
+
+func newCorporationSearch(opts []CorporationSearchOption) *corporationSearch {
+	s := &corporationSearch{}
+	for _, opt := range opts {
+		if ro, ok := opt.(RequestOption); ok {
+			s.requests = append(s.requests, ro)
+		}
+
+		opt.applyCorporationSearch(s)
+	}
+
+	return s
+}
+
+// searchCorporations pages through the search API and keeps the corporations satisfying the match.
+// It returns an error wrapping kenall.ErrTooManyPages if the search does not end within maxSearchPages.
+func (cli *Client) searchCorporations(
+	ctx context.Context, q string, s *corporationSearch, match func(*Corporation) bool,
+) (*SearchCorporationsResponse, error) {
+	ctx = contextWithRequestOptions(ctx, s.requests)
+	res := &SearchCorporationsResponse{}
+
+	for page, offset := 0, 0; ; page++ {
+		if page == maxSearchPages {
+			return nil, fmt.Errorf("kenall: the search exceeds %d pages, q = %s: %w", maxSearchPages, q, ErrTooManyPages)
+		}
+
+		v := url.Values{
+			"q":      []string{q},
+			"limit":  []string{strconv.Itoa(searchPageLimit)},
+			"offset": []string{strconv.Itoa(offset)},
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/houjinbangou?"+v.Encode(), nil)
+		if err != nil {
+			return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
+		}
+
+		var pageRes SearchCorporationsResponse
+		if err := cli.sendRequest("SearchCorporations", req, &pageRes); err != nil {
+			return nil, fmt.Errorf(errFailedRequestFormat, err)
+		}
+
+		res.Version = pageRes.Version
+		res.DecodeErrors = append(res.DecodeErrors, pageRes.DecodeErrors...)
+
+		for _, c := range pageRes.Corporations {
+			if match(c) && (!s.excludeClosed || !c.IsClosed()) {
+				res.Corporations = append(res.Corporations, c)
+			}
+		}
+
+		if offset += len(pageRes.Corporations); len(pageRes.Corporations) == 0 || offset >= pageRes.Count {
+			res.Count = len(res.Corporations)
+
+			return res, nil
+		}
+	}
+}
+
+// normalizeFurigana converts the furigana to full-width katakana without spaces for comparison.
+func normalizeFurigana(s string) string {
+	return strings.Join(strings.Fields(KanaScriptKatakana.convert(s)), "")
+}
+
+// normalizeName folds the width of alphanumerics and katakana, letter case and spaces of a name for comparison.
+func normalizeName(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case unicode.IsSpace(r):
+			return -1
+		case r >= fullWidthASCIIFirst && r <= fullWidthASCIILast:
+			return unicode.ToLower(r - fullWidthASCIIOffset)
+		default:
+			return unicode.ToLower(r)
+		}
+	}, s)
+
+	return halfWidthToFullWidthKana(s)
+}
+
+func (r *SearchCorporationsResponse) kanaFields() []*string {
+	fields := make([]*string, 0, len(r.Corporations))
+	for _, c := range r.Corporations {
+		if c == nil {
+			continue
+		}
+
+		fields = append(fields, c.kanaFields()...)
+	}
+
+	return fields
+}
@@ -0,0 +1,174 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func runCorporationSearchServer(t *testing.T, wantQuery string) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("q") == "オワラナイ" {
+			// NOTE: the search never ends within the pages requested by the client.
+			_, _ = fmt.Fprint(w, `{"version":"2022-02-01","count":1000000,"data":[{"corporate_number":"1010001000004","name":"オワラナイ株式会社","furigana":"オワラナイ"}]}`)
+
+			return
+		}
+
+		if r.URL.Path != "/houjinbangou" || q.Get("q") != wantQuery || q.Get("limit") != "100" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		var body string
+		switch offset, _ := strconv.Atoi(q.Get("offset")); offset {
+		case 0:
+			body = `{"version":"2022-02-01","count":3,"data":[` +
+				`{"corporate_number":"2021001052596","name":"株式会社オープンコレクター","furigana":"オープンコレクター"},` +
+				`{"corporate_number":"1010001000001","name":"オープン株式会社","furigana":"オープン"}]}`
+		case 2:
+			body = `{"version":"2022-02-01","count":3,"data":[` +
+				`{"corporate_number":"1010001000002","name":"株式会社オープン","furigana":"オープン","close_date":"2020-03-31","close_cause":"01"}]}`
+		default:
+			body = `{"version":"2022-02-01","count":3,"data":[]}`
+		}
+
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestClient_SearchCorporationsByFurigana(t *testing.T) {
+	t.Parallel()
+
+	srv := runCorporationSearchServer(t, "オープン")
+
+	cases := map[string]struct {
+		give      string
+		opts      []kenall.CorporationSearchOption
+		want      []string
+		wantError error
+	}{
+		"Exact match":    {give: "オープン", opts: nil, want: []string{"1010001000001", "1010001000002"}, wantError: nil},
+		"Hiragana":       {give: "おーぷん", opts: nil, want: []string{"1010001000001", "1010001000002"}, wantError: nil},
+		"Half width":     {give: "ｵｰﾌﾟﾝ", opts: nil, want: []string{"1010001000001", "1010001000002"}, wantError: nil},
+		"Prefix match":   {give: "オープン", opts: []kenall.CorporationSearchOption{kenall.WithFuriganaPrefixMatch()}, want: []string{"2021001052596", "1010001000001", "1010001000002"}, wantError: nil},
+		"Without closed": {give: "オープン", opts: []kenall.CorporationSearchOption{kenall.WithoutClosedCorporations()}, want: []string{"1010001000001"}, wantError: nil},
+		"Empty":          {give: " ", opts: nil, want: nil, wantError: kenall.ErrInvalidArgument},
+		"Too many pages": {give: "オワラナイ", opts: nil, want: nil, wantError: kenall.ErrTooManyPages},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := cli.SearchCorporationsByFurigana(context.Background(), c.give, c.opts...)
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if res == nil {
+				return
+			}
+
+			numbers := make([]string, 0, len(res.Corporations))
+			for _, corp := range res.Corporations {
+				numbers = append(numbers, corp.CorporateNumber)
+			}
+			if !reflect.DeepEqual(numbers, c.want) {
+				t.Errorf("give: %v, want: %v", numbers, c.want)
+			}
+			if res.Count != len(c.want) {
+				t.Errorf("give: %v, want: %v", res.Count, len(c.want))
+			}
+		})
+	}
+}
+
+func TestClient_FindCorporationByName(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		want      string
+		wantError error
+	}{
+		"Exact match":     {give: "株式会社オープンコレクター", want: "2021001052596", wantError: nil},
+		"Width and space": {give: "株式会社 ｵｰﾌﾟﾝｺﾚｸﾀｰ", want: "2021001052596", wantError: nil},
+		"Ambiguous":       {give: "株式会社オープン", want: "", wantError: kenall.ErrAmbiguousResult},
+		"Not found":       {give: "株式会社クローズ", want: "", wantError: kenall.ErrNotFound},
+		"Empty":           {give: "", want: "", wantError: kenall.ErrInvalidArgument},
+		"Too many pages":  {give: "オワラナイ株式会社", want: "", wantError: kenall.ErrTooManyPages},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if q := r.URL.Query(); q.Get("q") == "オワラナイ株式会社" {
+					// NOTE: only the first page has a match, but the search never ends within the pages requested by the client.
+					name := "オワラナイ株式会社"
+					if q.Get("offset") != "0" {
+						name = "オワラナイ商事株式会社"
+					}
+
+					_, _ = fmt.Fprintf(w, `{"version":"2022-02-01","count":1000000,"data":[{"corporate_number":"1010001000004","name":%q}]}`, name)
+
+					return
+				}
+
+				body := `{"version":"2022-02-01","count":4,"data":[` +
+					`{"corporate_number":"2021001052596","name":"株式会社オープンコレクター","furigana":"オープンコレクター"},` +
+					`{"corporate_number":"1010001000001","name":"株式会社オープン","furigana":"オープン"},` +
+					`{"corporate_number":"1010001000002","name":"株式会社　オープン","furigana":"オープン"},` +
+					`{"corporate_number":"1010001000003","name":"株式会社オープンソース","furigana":"オープンソース"}]}`
+				if _, err := fmt.Fprint(w, body); err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			corp, err := cli.FindCorporationByName(context.Background(), c.give)
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if corp != nil && corp.CorporateNumber != c.want {
+				t.Errorf("give: %v, want: %v", corp.CorporateNumber, c.want)
+			}
+
+			var ae *kenall.AmbiguousCorporationError
+			if errors.As(err, &ae) && len(ae.Candidates) != 2 {
+				t.Errorf("give: %v, want: %v", len(ae.Candidates), 2)
+			}
+		})
+	}
+}
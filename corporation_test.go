@@ -0,0 +1,175 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestCorporation_IsClosed(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give *kenall.Corporation
+		want bool
+	}{
+		"Active":      {give: &kenall.Corporation{}, want: false},
+		"Close date":  {give: &kenall.Corporation{CloseDate: kenall.NullString{String: "2020-03-31", Valid: true}}, want: true},
+		"Close cause": {give: &kenall.Corporation{CloseCause: kenall.NullString{String: "01", Valid: true}}, want: true},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := c.give.IsClosed(); got != c.want {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestCorporation_EventKind(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give           *kenall.Corporation
+		want           kenall.CorporationEventKind
+		wantCorrection bool
+	}{
+		"New":        {give: &kenall.Corporation{Process: "01", Correct: "0"}, want: kenall.CorporationEventNew},
+		"Relocation": {give: &kenall.Corporation{Process: "12", Correct: "0"}, want: kenall.CorporationEventChange},
+		"Correction": {give: &kenall.Corporation{Process: "11", Correct: "1"}, want: kenall.CorporationEventChange, wantCorrection: true},
+		"Merger":     {give: &kenall.Corporation{Process: "71"}, want: kenall.CorporationEventClose},
+		"Unpadded":   {give: &kenall.Corporation{Process: "1"}, want: kenall.CorporationEventNew},
+		"Unknown":    {give: &kenall.Corporation{Process: "50"}, want: kenall.CorporationEventUnknown},
+		"Empty":      {give: &kenall.Corporation{}, want: kenall.CorporationEventUnknown},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := c.give.EventKind(); got != c.want {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+
+			if got := c.give.IsCorrection(); got != c.wantCorrection {
+				t.Errorf("give: %v, want: %v", got, c.wantCorrection)
+			}
+		})
+	}
+}
+
+func TestClient_GetCorporation_WithoutClosedCorporations(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := `{"version":"2022-02-01","data":{"corporate_number":"5010001000002","close_date":"2020-03-31","close_cause":"01"}}`
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cli.GetCorporation(context.Background(), "5010001000002")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Corporation.IsClosed() {
+		t.Error("the corporation should be flagged as closed")
+	}
+
+	_, err = cli.GetCorporation(context.Background(), "5010001000002", kenall.WithoutClosedCorporations())
+	if !errors.Is(err, kenall.ErrClosedCorporation) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrClosedCorporation)
+	}
+}
+
+func TestClient_ResolveSuccessor(t *testing.T) {
+	t.Parallel()
+
+	successors := map[string]string{
+		"6100000000001": "5100000000002",
+		"5100000000002": "4100000000003",
+		"4100000000003": "",
+		"4200000000001": "3200000000002",
+		"3200000000002": "4200000000001",
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		number := strings.TrimPrefix(r.URL.Path, "/houjinbangou/")
+
+		successor, ok := successors[number]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		next := "null"
+		if successor != "" {
+			next = strconv.Quote(successor)
+		}
+
+		body := fmt.Sprintf(`{"version":"2022-02-01","data":{"corporate_number":%q,"successor_corporate_number":%s}}`, number, next)
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		give      string
+		want      []string
+		wantError error
+	}{
+		"Active":    {give: "4100000000003", want: []string{"4100000000003"}, wantError: nil},
+		"Merged":    {give: "6100000000001", want: []string{"6100000000001", "5100000000002", "4100000000003"}, wantError: nil},
+		"Loop":      {give: "4200000000001", want: []string{"4200000000001", "3200000000002"}, wantError: kenall.ErrSuccessorChain},
+		"Not found": {give: "2300000000001", want: []string{}, wantError: kenall.ErrNotFound},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			path, err := cli.ResolveSuccessor(context.Background(), c.give)
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+
+			numbers := make([]string, 0, len(path))
+			for _, corp := range path {
+				numbers = append(numbers, corp.CorporateNumber)
+			}
+			if !reflect.DeepEqual(numbers, c.want) {
+				t.Errorf("give: %v, want: %v", numbers, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+package kenall
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+)
+
+var csvCityHeader = []string{ //nolint: gochecknoglobals
+	"jisx0402", "prefecture_code", "city_code", "prefecture_kana", "city_kana", "prefecture", "city",
+}
+
+var csvCorporationHeader = []string{ //nolint: gochecknoglobals
+	"corporate_number", "name", "furigana", "kind", "prefecture_name", "city_name", "street_number",
+	"town", "kyoto_street", "block_lot_num", "building", "floor_room", "jisx0402", "post_code",
+	"address_outside", "update_date", "change_date", "close_date", "close_cause", "successor_corporate_number",
+	"assignment_date", "en_name",
+}
+
+// WriteCSV writes the addresses as CSV with a header row, the columns are the same as kenall.ExportFormatCSV.
+func (r *GetAddressResponse) WriteCSV(w io.Writer) error {
+	return writeAddressesCSV(w, r.Addresses)
+}
+
+// WriteCSV writes the addresses as CSV with a header row, the columns are the same as kenall.ExportFormatCSV.
+func (r *GetNormalizeAddressResponse) WriteCSV(w io.Writer) error {
+	return writeAddressesCSV(w, r.Addresses)
+}
+
+// WriteCSV writes the cities as CSV with a header row.
+func (r *GetCityResponse) WriteCSV(w io.Writer) error {
+	rows := make([][]string, 0, len(r.Cities))
+	for _, c := range r.Cities {
+		rows = append(rows, []string{
+			c.JISX0402, c.PrefectureCode, c.CityCode, c.PrefectureKana, c.CityKana, c.Prefecture, c.City,
+		})
+	}
+
+	return writeCSV(w, csvCityHeader, rows)
+}
+
+// WriteCSV writes the corporation as CSV with a header row, null values are written as empty strings.
+func (r *GetCorporationResponse) WriteCSV(w io.Writer) error {
+	var corporations []*Corporation
+	if r.Corporation != nil {
+		corporations = append(corporations, r.Corporation)
+	}
+
+	return writeCorporationsCSV(w, corporations)
+}
+
+// WriteCSV writes the corporations as CSV with a header row, null values are written as empty strings.
+func (r *SearchCorporationsResponse) WriteCSV(w io.Writer) error {
+	return writeCorporationsCSV(w, r.Corporations)
+}
+
+func writeAddressesCSV(w io.Writer, addrs []*Address) error {
+	aw := &csvAddressWriter{w: csv.NewWriter(w), header: true}
+
+	for _, a := range addrs {
+		if err := aw.Write(a); err != nil {
+			return fmt.Errorf("kenall: failed to write csv: %w", err)
+		}
+	}
+
+	return aw.Flush()
+}
+
+func writeCorporationsCSV(w io.Writer, corporations []*Corporation) error {
+	rows := make([][]string, 0, len(corporations))
+	for _, c := range corporations {
+		rows = append(rows, []string{
+			c.CorporateNumber, c.Name, c.Furigana, c.Kind, c.PrefectureName, c.CityName, c.StreetNumber,
+			c.Town.String, c.KyotoStreet.String, c.BlockLotNum.String, c.Building.String, c.FloorRoom.String,
+			c.JISX0402, c.PostCode, c.AddressOutside, c.UpdateDate, c.ChangeDate, c.CloseDate.String,
+			c.CloseCause.String, c.SuccessorCorporateNumber.String, c.AssignmentDate, c.EnName,
+		})
+	}
+
+	return writeCSV(w, csvCorporationHeader, rows)
+}
+
+func writeCSV(w io.Writer, header []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+
+	if err := cw.Write(header); err != nil {
+		return fmt.Errorf("kenall: failed to write a csv header: %w", err)
+	}
+
+	if err := cw.WriteAll(rows); err != nil {
+		return fmt.Errorf("kenall: failed to write csv: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package kenall_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestGetAddressResponse_WriteCSV(t *testing.T) {
+	t.Parallel()
+
+	var res kenall.GetAddressResponse
+	if err := json.Unmarshal(addressResponse, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	records := writeCSV(t, res.WriteCSV)
+	if len(records) != len(res.Addresses)+1 {
+		t.Fatalf("give: %v, want: %v", len(records), len(res.Addresses)+1)
+	}
+	if records[0][0] != "postal_code" || records[1][0] != res.Addresses[0].PostalCode || records[1][4] != "西新宿" {
+		t.Errorf("give: %v", records)
+	}
+}
+
+func TestGetCityResponse_WriteCSV(t *testing.T) {
+	t.Parallel()
+
+	var res kenall.GetCityResponse
+	if err := json.Unmarshal(cityResponse, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	records := writeCSV(t, res.WriteCSV)
+	if len(records) != len(res.Cities)+1 {
+		t.Fatalf("give: %v, want: %v", len(records), len(res.Cities)+1)
+	}
+
+	want := []string{"jisx0402", "prefecture_code", "city_code", "prefecture_kana", "city_kana", "prefecture", "city"}
+	for i, h := range want {
+		if records[0][i] != h {
+			t.Errorf("give: %v, want: %v", records[0][i], h)
+		}
+	}
+
+	if c := res.Cities[0]; records[1][0] != c.JISX0402 || records[1][6] != c.City {
+		t.Errorf("give: %v, want: %+v", records[1], c)
+	}
+}
+
+func TestGetCorporationResponse_WriteCSV(t *testing.T) {
+	t.Parallel()
+
+	var res kenall.GetCorporationResponse
+	if err := json.Unmarshal(corporationResponse, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	records := writeCSV(t, res.WriteCSV)
+	if len(records) != 2 {
+		t.Fatalf("give: %v, want: %v", len(records), 2)
+	}
+	if records[0][0] != "corporate_number" || records[1][0] != res.Corporation.CorporateNumber ||
+		records[1][1] != res.Corporation.Name {
+		t.Errorf("give: %v", records)
+	}
+
+	empty := writeCSV(t, (&kenall.SearchCorporationsResponse{}).WriteCSV)
+	if len(empty) != 1 || len(empty[0]) != len(records[0]) {
+		t.Errorf("give: %v, want: only the header", empty)
+	}
+}
+
+func writeCSV(t *testing.T, fn func(w io.Writer) error) [][]string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := fn(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return records
+}
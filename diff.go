@@ -0,0 +1,117 @@
+package kenall
+
+import (
+	"reflect"
+	"strings"
+)
+
+type (
+	// An AddressDiff is the difference between the addresses of two data versions, see kenall.DiffAddresses.
+	AddressDiff struct {
+		OldVersion Version
+		NewVersion Version
+		// Added are the addresses only in the new response, in order of it.
+		Added []*Address
+		// Removed are the addresses only in the old response, in order of it.
+		Removed []*Address
+		// Changed are the addresses in both responses with different values, in order of the new response.
+		Changed []*AddressChange
+	}
+	// An AddressChange is an address changed between two data versions.
+	AddressChange struct {
+		Old *Address
+		New *Address
+		// Fields are the JSON names of the changed fields, e.g. "town_kana" or "corporation.name_kana".
+		Fields []string
+	}
+)
+
+// DiffAddresses compares the addresses of two responses, e.g. of the same postal code fetched at different versions,
+// to feed the changes to a mirror of the postal data. An address is identified by the postal code, the JIS X 0402
+// code, the town, the koaza, the Kyoto street, the building, the floor and the corporation name, so that a renamed
+// city is reported as changed and a renamed town as removed and added. A nil response is regarded as empty.
+func DiffAddresses(old, new *GetAddressResponse) AddressDiff { //nolint: predeclared
+	var d AddressDiff
+
+	olds := map[string][]*Address{}
+
+	if old != nil {
+		d.OldVersion = old.Version
+
+		for _, a := range old.Addresses {
+			k := addressKey(a)
+			olds[k] = append(olds[k], a)
+		}
+	}
+
+	matched := map[*Address]bool{}
+
+	if new != nil {
+		d.NewVersion = new.Version
+
+		for _, a := range new.Addresses {
+			k := addressKey(a)
+			if len(olds[k]) == 0 {
+				d.Added = append(d.Added, a)
+
+				continue
+			}
+
+			o := olds[k][0]
+			olds[k] = olds[k][1:]
+			matched[o] = true
+
+			if fields := diffFields(reflect.ValueOf(o).Elem(), reflect.ValueOf(a).Elem(), ""); len(fields) > 0 {
+				d.Changed = append(d.Changed, &AddressChange{Old: o, New: a, Fields: fields})
+			}
+		}
+	}
+
+	if old != nil {
+		for _, a := range old.Addresses {
+			if !matched[a] {
+				d.Removed = append(d.Removed, a)
+			}
+		}
+	}
+
+	return d
+}
+
+// HasChanges reports whether any address is added, removed or changed.
+func (d AddressDiff) HasChanges() bool {
+	return len(d.Added) > 0 || len(d.Removed) > 0 || len(d.Changed) > 0
+}
+
+func addressKey(a *Address) string {
+	return strings.Join([]string{
+		a.PostalCode, a.JISX0402, a.Town, a.Koaza, a.KyotoStreet, a.Building, a.Floor, a.Corporation.Name,
+	}, "\x00")
+}
+
+// diffFields returns the JSON names of the different fields of the structs, the nested structs are compared
+// field by field with the prefix.
+func diffFields(o, n reflect.Value, prefix string) []string {
+	var fields []string
+
+	for i := 0; i < o.NumField(); i++ {
+		f := o.Type().Field(i)
+
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		if f.Type.Kind() == reflect.Struct && !f.Anonymous && f.Type.Name() == "" {
+			fields = append(fields, diffFields(o.Field(i), n.Field(i), prefix+name+".")...)
+
+			continue
+		}
+
+		if !reflect.DeepEqual(o.Field(i).Interface(), n.Field(i).Interface()) {
+			fields = append(fields, prefix+name)
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,76 @@
+package kenall_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestDiffAddresses(t *testing.T) {
+	t.Parallel()
+
+	oldVer := kenall.Version(time.Date(2022, 10, 31, 0, 0, 0, 0, time.UTC))
+	newVer := kenall.Version(time.Date(2022, 11, 30, 0, 0, 0, 0, time.UTC))
+
+	kept := &kenall.Address{PostalCode: "1600023", JISX0402: "13104", City: "新宿区", Town: "西新宿"}
+	renamedOld := &kenall.Address{PostalCode: "1600023", JISX0402: "13104", City: "新宿区", Town: "旧町", TownKana: "キュウチョウ"}
+	renamedNew := &kenall.Address{PostalCode: "1600023", JISX0402: "13104", City: "新宿区", Town: "新町", TownKana: "シンマチ"}
+	cityOld := &kenall.Address{PostalCode: "1600023", JISX0402: "13105", City: "旧区", Town: "本町"}
+	cityNew := &kenall.Address{PostalCode: "1600023", JISX0402: "13105", City: "新区", Town: "本町", TownChome: true}
+	corpOld := &kenall.Address{PostalCode: "1600023", JISX0402: "13104", Town: "西新宿"}
+	corpOld.Corporation.Name = "東京都庁"
+	corpNew := &kenall.Address{PostalCode: "1600023", JISX0402: "13104", Town: "西新宿"}
+	corpNew.Corporation.Name = "東京都庁"
+	corpNew.Corporation.NameKana = "トウキョウトチョウ"
+
+	cases := map[string]struct {
+		old, new *kenall.GetAddressResponse
+		want     kenall.AddressDiff
+	}{
+		"No changes": {
+			old:  &kenall.GetAddressResponse{Version: oldVer, Addresses: []*kenall.Address{kept}},
+			new:  &kenall.GetAddressResponse{Version: newVer, Addresses: []*kenall.Address{kept}},
+			want: kenall.AddressDiff{OldVersion: oldVer, NewVersion: newVer},
+		},
+		"Changes": {
+			old: &kenall.GetAddressResponse{Version: oldVer, Addresses: []*kenall.Address{kept, renamedOld, cityOld, corpOld}},
+			new: &kenall.GetAddressResponse{Version: newVer, Addresses: []*kenall.Address{corpNew, kept, cityNew, renamedNew}},
+			want: kenall.AddressDiff{
+				OldVersion: oldVer,
+				NewVersion: newVer,
+				Added:      []*kenall.Address{renamedNew},
+				Removed:    []*kenall.Address{renamedOld},
+				Changed: []*kenall.AddressChange{
+					{Old: corpOld, New: corpNew, Fields: []string{"corporation.name_kana"}},
+					{Old: cityOld, New: cityNew, Fields: []string{"city", "town_chome"}},
+				},
+			},
+		},
+		"Nil old": {
+			new:  &kenall.GetAddressResponse{Version: newVer, Addresses: []*kenall.Address{kept}},
+			want: kenall.AddressDiff{NewVersion: newVer, Added: []*kenall.Address{kept}},
+		},
+		"Nil new": {
+			old:  &kenall.GetAddressResponse{Version: oldVer, Addresses: []*kenall.Address{kept}},
+			want: kenall.AddressDiff{OldVersion: oldVer, Removed: []*kenall.Address{kept}},
+		},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			give := kenall.DiffAddresses(c.old, c.new)
+			if !reflect.DeepEqual(give, c.want) {
+				t.Errorf("give: %+v, want: %+v", give, c.want)
+			}
+
+			if give.HasChanges() != (name != "No changes") {
+				t.Errorf("give: %v, want: %v", give.HasChanges(), name != "No changes")
+			}
+		})
+	}
+}
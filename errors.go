@@ -1,8 +1,22 @@
 package kenall
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxErrorBodySize is the maximum size of a response body to be read for an error detail.
+	maxErrorBodySize = 64 << 10
+	// maxErrorMessageLength is the maximum number of characters of a plain text body used as an error message.
+	maxErrorMessageLength = 200
 )
 
 var (
@@ -18,8 +32,327 @@ var (
 	ErrNotFound = errors.New("kenall: 404 not found error")
 	// ErrMethodNotAllowed is an error value that will be returned when the request calls a method that is not allowed.
 	ErrMethodNotAllowed = errors.New("kenall: 405 method not allowed error")
+	// ErrTooManyRequests is an error value that will be returned when the request exceeds the rate limit
+	// of the kenall service, the error is *kenall.TooManyRequestsError carrying when to retry.
+	ErrTooManyRequests = errors.New("kenall: 429 too many requests error")
 	// ErrInternalServerError is an error value that will be returned when some error occurs in the kenall service.
 	ErrInternalServerError = errors.New("kenall: 500 internal server error")
+	// ErrBadGateway is an error value that will be returned when a gateway of the kenall service fails
+	// to get a response from the upstream.
+	ErrBadGateway = errors.New("kenall: 502 bad gateway error")
+	// ErrServiceUnavailable is an error value that will be returned when the kenall service is temporarily unavailable,
+	// e.g. under maintenance.
+	ErrServiceUnavailable = errors.New("kenall: 503 service unavailable error")
+	// ErrGatewayTimeout is an error value that will be returned when a gateway of the kenall service times out
+	// waiting for the upstream.
+	ErrGatewayTimeout = errors.New("kenall: 504 gateway timeout error")
+	// ErrAmbiguousResult is an error value that will be returned when more than one resource matches exactly.
+	ErrAmbiguousResult = errors.New("kenall: ambiguous result")
+	// ErrClosedCorporation is an error value that will be returned when the corporation is closed
+	// and kenall.WithoutClosedCorporations is given.
+	ErrClosedCorporation = errors.New("kenall: closed corporation")
+	// ErrSuccessorChain is an error value that will be returned when the successor corporations loop or are too deep.
+	ErrSuccessorChain = errors.New("kenall: unresolvable successor corporation chain")
+	// ErrNoMorePages is an error value that will be returned when a pager is requested after the last page.
+	ErrNoMorePages = errors.New("kenall: no more pages")
+	// ErrTooManyPages is an error value that will be returned when a search has more pages than the client requests,
+	// so that a truncated result is not taken for the complete one.
+	ErrTooManyPages = errors.New("kenall: too many pages")
+	// ErrNoRecording is an error value that will be returned when kenall.ReplayTransport has no recorded response.
+	ErrNoRecording = errors.New("kenall: no recorded response")
+	// errNotModified is returned for a not modified response of a conditional request, see kenall.WithCacheRevalidation.
+	errNotModified = errors.New("kenall: 304 not modified")
+	// errFlightCanceled is kept for the waiting callers when the coalesced request is canceled by its caller.
+	errFlightCanceled = errors.New("kenall: coalesced request canceled")
 	// ErrTimeout is an error value that will be returned when the request is timeout.
 	ErrTimeout = func(err error) error { return fmt.Errorf("kenall: request timeout: %w", err) } //nolint: gochecknoglobals
 )
+
+// An APIError is an error value that will be returned when the kenall service responds with an error status,
+// it carries the details of the response and matches the error of the status, e.g. ErrNotFound, with errors.Is.
+type APIError struct {
+	StatusCode int
+	// Message is the error message reported by the kenall service, empty if the body does not have it.
+	Message string
+	// URL is the URL of the request.
+	URL string
+	// RequestID is the value of the X-Request-Id header of the response, empty if it is missing.
+	RequestID string
+	// Body is the raw response body.
+	Body []byte
+	// Err is the error of the status, e.g. ErrNotFound, *kenall.PaymentRequiredError or *kenall.TooManyRequestsError.
+	Err error
+}
+
+func newAPIError(req *http.Request, resp *http.Response, now time.Time) *APIError {
+	//nolint: errcheck
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	e := &APIError{
+		StatusCode: resp.StatusCode,
+		URL:        req.URL.String(),
+		RequestID:  resp.Header.Get("X-Request-Id"),
+		Body:       body,
+	}
+
+	e.Message = errorMessageOf(body, resp.Header.Get("Content-Type"))
+
+	switch resp.StatusCode {
+	case http.StatusUnauthorized:
+		e.Err = ErrUnauthorized
+	case http.StatusPaymentRequired:
+		e.Err = newPaymentRequiredError(bytes.NewReader(body))
+	case http.StatusForbidden:
+		e.Err = ErrForbidden
+	case http.StatusNotFound:
+		e.Err = ErrNotFound
+	case http.StatusMethodNotAllowed:
+		e.Err = ErrMethodNotAllowed
+	case http.StatusTooManyRequests:
+		e.Err = newTooManyRequestsError(resp.Header, now)
+	case http.StatusInternalServerError:
+		e.Err = ErrInternalServerError
+	case http.StatusBadGateway:
+		e.Err = ErrBadGateway
+	case http.StatusServiceUnavailable:
+		e.Err = ErrServiceUnavailable
+	case http.StatusGatewayTimeout:
+		e.Err = ErrGatewayTimeout
+	default:
+		//nolint: goerr113
+		e.Err = fmt.Errorf("kenall: not registered in the error handling, http status code = %d", resp.StatusCode)
+	}
+
+	return e
+}
+
+// errorMessageOf returns the message of an error response body, the body is optional and the error is still useful
+// without it. The message is taken from "message", "error", "detail" or the first of "errors" of a JSON object,
+// or from a short plain text body.
+func errorMessageOf(body []byte, contentType string) string {
+	var tmp struct {
+		Message string            `json:"message"`
+		Error   json.RawMessage   `json:"error"`
+		Detail  string            `json:"detail"`
+		Errors  []json.RawMessage `json:"errors"`
+	}
+
+	if err := json.Unmarshal(body, &tmp); err == nil {
+		candidates := []json.RawMessage{tmp.Error}
+		if len(tmp.Errors) > 0 {
+			candidates = append(candidates, tmp.Errors[0])
+		}
+
+		if tmp.Message != "" {
+			return tmp.Message
+		}
+
+		for _, c := range candidates {
+			if m := messageOfJSON(c); m != "" {
+				return m
+			}
+		}
+
+		return tmp.Detail
+	}
+
+	if mt, _, _ := strings.Cut(contentType, ";"); strings.TrimSpace(strings.ToLower(mt)) != "text/plain" {
+		return ""
+	}
+
+	msg := []rune(strings.TrimSpace(string(body)))
+	if len(msg) > maxErrorMessageLength {
+		msg = append(msg[:maxErrorMessageLength], '…')
+	}
+
+	return string(msg)
+}
+
+// messageOfJSON returns the JSON string or "message" of the JSON object.
+func messageOfJSON(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+
+	var obj struct {
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(raw, &obj) == nil {
+		return obj.Message
+	}
+
+	return ""
+}
+
+// Error implements error interface.
+func (e *APIError) Error() string {
+	details := make([]string, 0, 3)
+	if e.Message != "" {
+		details = append(details, "message = "+e.Message)
+	}
+
+	if e.RequestID != "" {
+		details = append(details, "request id = "+e.RequestID)
+	}
+
+	details = append(details, "url = "+e.URL)
+
+	return e.Err.Error() + ", " + strings.Join(details, ", ")
+}
+
+// Unwrap returns the error of the status.
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// A PaymentRequiredError is an error value that will be returned if the payment for your kenall account is overdue,
+// it carries the remediation details reported by the kenall service and matches ErrPaymentRequired with errors.Is.
+type PaymentRequiredError struct {
+	Message    string
+	Plan       string
+	Overage    string
+	BillingURL string
+	// Body is the raw response body.
+	Body []byte
+}
+
+func newPaymentRequiredError(r io.Reader) *PaymentRequiredError {
+	//nolint: errcheck
+	body, _ := io.ReadAll(io.LimitReader(r, maxErrorBodySize))
+
+	var tmp struct {
+		Message    string          `json:"message"`
+		Plan       string          `json:"plan"`
+		Overage    json.RawMessage `json:"overage"`
+		BillingURL string          `json:"billing_url"`
+	}
+	// NOTE: the details are optional, the error is still useful without them.
+	//nolint: errcheck
+	_ = json.Unmarshal(body, &tmp)
+
+	overage := string(tmp.Overage)
+	if overage == "null" {
+		overage = ""
+	}
+
+	return &PaymentRequiredError{
+		Message:    tmp.Message,
+		Plan:       tmp.Plan,
+		Overage:    strings.Trim(overage, `"`),
+		BillingURL: tmp.BillingURL,
+		Body:       body,
+	}
+}
+
+// Error implements error interface.
+func (e *PaymentRequiredError) Error() string {
+	details := make([]string, 0, 4)
+	if e.Message != "" {
+		details = append(details, "message = "+e.Message)
+	}
+
+	if e.Plan != "" {
+		details = append(details, "plan = "+e.Plan)
+	}
+
+	if e.Overage != "" {
+		details = append(details, "overage = "+e.Overage)
+	}
+
+	if e.BillingURL != "" {
+		details = append(details, "billing url = "+e.BillingURL)
+	}
+
+	if len(details) == 0 {
+		return ErrPaymentRequired.Error()
+	}
+
+	return ErrPaymentRequired.Error() + ", " + strings.Join(details, ", ")
+}
+
+// Unwrap returns ErrPaymentRequired.
+func (e *PaymentRequiredError) Unwrap() error {
+	return ErrPaymentRequired
+}
+
+// A TooManyRequestsError is an error value that will be returned when the request exceeds the rate limit
+// of the kenall service, it carries the Retry-After and the rate limit headers and matches ErrTooManyRequests
+// with errors.Is. The request is not retried automatically, so that callers can decide their own backoff.
+type TooManyRequestsError struct {
+	// RetryAfter is the wait before retrying reported by the Retry-After header, zero if it is missing.
+	RetryAfter time.Duration
+	// Limit, Remaining and Reset are the values of the X-RateLimit-* headers, empty if they are missing.
+	Limit     string
+	Remaining string
+	Reset     string
+}
+
+func newTooManyRequestsError(h http.Header, now time.Time) *TooManyRequestsError {
+	rl := rateLimitOf(h)
+	e := &TooManyRequestsError{Limit: rl.Limit, Remaining: rl.Remaining, Reset: rl.Reset}
+
+	// NOTE: Retry-After is either delay seconds or an HTTP date.
+	if v := strings.TrimSpace(h.Get("Retry-After")); v != "" {
+		if sec, err := strconv.Atoi(v); err == nil && sec > 0 {
+			e.RetryAfter = time.Duration(sec) * time.Second
+		} else if t, err := http.ParseTime(v); err == nil && t.After(now) {
+			e.RetryAfter = t.Sub(now)
+		}
+	}
+
+	return e
+}
+
+// Error implements error interface.
+func (e *TooManyRequestsError) Error() string {
+	details := make([]string, 0, 4)
+	if e.RetryAfter > 0 {
+		details = append(details, "retry after = "+e.RetryAfter.String())
+	}
+
+	if e.Limit != "" {
+		details = append(details, "limit = "+e.Limit)
+	}
+
+	if e.Remaining != "" {
+		details = append(details, "remaining = "+e.Remaining)
+	}
+
+	if e.Reset != "" {
+		details = append(details, "reset = "+e.Reset)
+	}
+
+	if len(details) == 0 {
+		return ErrTooManyRequests.Error()
+	}
+
+	return ErrTooManyRequests.Error() + ", " + strings.Join(details, ", ")
+}
+
+// Unwrap returns ErrTooManyRequests.
+func (e *TooManyRequestsError) Unwrap() error {
+	return ErrTooManyRequests
+}
+
+// An AmbiguousCorporationError is an error value that will be returned when more than one corporation matches the name,
+// it carries the candidates and matches ErrAmbiguousResult with errors.Is.
+type AmbiguousCorporationError struct {
+	Name       string
+	Candidates []*Corporation
+}
+
+// Error implements error interface.
+func (e *AmbiguousCorporationError) Error() string {
+	numbers := make([]string, 0, len(e.Candidates))
+	for _, c := range e.Candidates {
+		numbers = append(numbers, c.CorporateNumber)
+	}
+
+	return fmt.Sprintf("%s, name = %s, candidates = %s", ErrAmbiguousResult, e.Name, strings.Join(numbers, ","))
+}
+
+// Unwrap returns ErrAmbiguousResult.
+func (e *AmbiguousCorporationError) Unwrap() error {
+	return ErrAmbiguousResult
+}
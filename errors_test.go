@@ -0,0 +1,216 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestPaymentRequiredError(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		body           string
+		wantPlan       string
+		wantOverage    string
+		wantBillingURL string
+		wantMessage    string
+	}{
+		"With details":    {body: `{"message":"overdue","plan":"standard","overage":1200,"billing_url":"https://kenall.jp/billing"}`, wantPlan: "standard", wantOverage: "1200", wantBillingURL: "https://kenall.jp/billing", wantMessage: "kenall: 402 payment required error, message = overdue, plan = standard, overage = 1200, billing url = https://kenall.jp/billing"},
+		"Without details": {body: ``, wantPlan: "", wantOverage: "", wantBillingURL: "", wantMessage: "kenall: 402 payment required error"},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusPaymentRequired)
+				if _, err := w.Write([]byte(c.body)); err != nil {
+					t.Error(err)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = cli.GetAddress(context.Background(), "4020000")
+			if !errors.Is(err, kenall.ErrPaymentRequired) {
+				t.Fatalf("give: %v, want: %v", err, kenall.ErrPaymentRequired)
+			}
+
+			var perr *kenall.PaymentRequiredError
+			if !errors.As(err, &perr) {
+				t.Fatalf("give: %T, want: %T", err, perr)
+			}
+			if perr.Plan != c.wantPlan {
+				t.Errorf("give: %v, want: %v", perr.Plan, c.wantPlan)
+			}
+			if perr.Overage != c.wantOverage {
+				t.Errorf("give: %v, want: %v", perr.Overage, c.wantOverage)
+			}
+			if perr.BillingURL != c.wantBillingURL {
+				t.Errorf("give: %v, want: %v", perr.BillingURL, c.wantBillingURL)
+			}
+			if perr.Error() != c.wantMessage {
+				t.Errorf("give: %v, want: %v", perr.Error(), c.wantMessage)
+			}
+		})
+	}
+}
+
+func TestTooManyRequestsError(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	cases := map[string]struct {
+		header         map[string]string
+		wantRetryAfter time.Duration
+		wantMessage    string
+	}{
+		"Delay seconds": {header: map[string]string{"Retry-After": "30", "X-RateLimit-Limit": "100", "X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1640995230"}, wantRetryAfter: 30 * time.Second, wantMessage: "kenall: 429 too many requests error, retry after = 30s, limit = 100, remaining = 0, reset = 1640995230"},
+		"HTTP date":     {header: map[string]string{"Retry-After": now.Add(2 * time.Minute).Format(http.TimeFormat)}, wantRetryAfter: 2 * time.Minute, wantMessage: "kenall: 429 too many requests error, retry after = 2m0s"},
+		"Past date":     {header: map[string]string{"Retry-After": now.Add(-time.Minute).Format(http.TimeFormat)}, wantRetryAfter: 0, wantMessage: "kenall: 429 too many requests error"},
+		"Malformed":     {header: map[string]string{"Retry-After": "soon"}, wantRetryAfter: 0, wantMessage: "kenall: 429 too many requests error"},
+		"No headers":    {header: nil, wantRetryAfter: 0, wantMessage: "kenall: 429 too many requests error"},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				for k, v := range c.header {
+					w.Header().Set(k, v)
+				}
+				w.WriteHeader(http.StatusTooManyRequests)
+			}))
+			t.Cleanup(srv.Close)
+
+			cli, err := kenall.NewClient("opencollector",
+				kenall.WithEndpoint(srv.URL),
+				kenall.WithClock(&fakeClock{now: now}),
+				kenall.WithRetryPolicy(kenall.RetryPolicy{MaxRetries: 3}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = cli.GetAddress(context.Background(), "1008105")
+			if !errors.Is(err, kenall.ErrTooManyRequests) {
+				t.Fatalf("give: %v, want: %v", err, kenall.ErrTooManyRequests)
+			}
+
+			var terr *kenall.TooManyRequestsError
+			if !errors.As(err, &terr) {
+				t.Fatalf("give: %T, want: %T", err, terr)
+			}
+			if terr.RetryAfter != c.wantRetryAfter {
+				t.Errorf("give: %v, want: %v", terr.RetryAfter, c.wantRetryAfter)
+			}
+			if terr.Error() != c.wantMessage {
+				t.Errorf("give: %v, want: %v", terr.Error(), c.wantMessage)
+			}
+		})
+	}
+}
+
+func TestAPIError(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-1")
+
+		switch r.URL.Path {
+		case "/postalcode/1000001":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"the plan does not include the API"}`))
+		case "/postalcode/1000003":
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":"the token is revoked"}`))
+		case "/postalcode/1000004":
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"errors":[{"message":"the postal code is malformed"}]}`))
+		case "/postalcode/1000005":
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"detail":"the IP address is not allowed"}`))
+		case "/postalcode/1000006":
+			w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte("the IP address is not allowed\n"))
+		case "/postalcode/1000007":
+			w.WriteHeader(http.StatusTeapot)
+			_, _ = w.Write([]byte("short and stout"))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			_, _ = w.Write([]byte(`<html>bad gateway</html>`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		postalCode     string
+		wantError      error
+		wantStatusCode int
+		wantMessage    string
+	}{
+		"Known status":   {postalCode: "1000001", wantError: kenall.ErrForbidden, wantStatusCode: http.StatusForbidden, wantMessage: "the plan does not include the API"},
+		"Bad gateway":    {postalCode: "1000002", wantError: kenall.ErrBadGateway, wantStatusCode: http.StatusBadGateway, wantMessage: ""},
+		"Unknown status": {postalCode: "1000007", wantError: nil, wantStatusCode: http.StatusTeapot, wantMessage: "short and stout"},
+		"Error field":    {postalCode: "1000003", wantError: kenall.ErrUnauthorized, wantStatusCode: http.StatusUnauthorized, wantMessage: "the token is revoked"},
+		"Errors field":   {postalCode: "1000004", wantError: nil, wantStatusCode: http.StatusBadRequest, wantMessage: "the postal code is malformed"},
+		"Detail field":   {postalCode: "1000005", wantError: kenall.ErrForbidden, wantStatusCode: http.StatusForbidden, wantMessage: "the IP address is not allowed"},
+		"Plain text":     {postalCode: "1000006", wantError: kenall.ErrForbidden, wantStatusCode: http.StatusForbidden, wantMessage: "the IP address is not allowed"},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := cli.GetAddress(context.Background(), c.postalCode)
+
+			var aerr *kenall.APIError
+			if !errors.As(err, &aerr) {
+				t.Fatalf("give: %T, want: %T", err, aerr)
+			}
+			if c.wantError != nil && !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if aerr.StatusCode != c.wantStatusCode {
+				t.Errorf("give: %v, want: %v", aerr.StatusCode, c.wantStatusCode)
+			}
+			if aerr.Message != c.wantMessage {
+				t.Errorf("give: %v, want: %v", aerr.Message, c.wantMessage)
+			}
+			if aerr.RequestID != "req-1" {
+				t.Errorf("give: %v, want: %v", aerr.RequestID, "req-1")
+			}
+			if want := srv.URL + "/postalcode/" + c.postalCode; aerr.URL != want {
+				t.Errorf("give: %v, want: %v", aerr.URL, want)
+			}
+			if len(aerr.Body) == 0 {
+				t.Error("the body should be kept")
+			}
+		})
+	}
+}
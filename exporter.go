@@ -0,0 +1,243 @@
+package kenall
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ExportFormat values.
+const (
+	// ExportFormatCSV writes addresses as CSV with a header row.
+	ExportFormatCSV ExportFormat = iota
+	// ExportFormatNDJSON writes addresses as newline delimited JSON.
+	ExportFormatNDJSON
+)
+
+type (
+	// An ExportFormat is an output format of kenall.Exporter.
+	ExportFormat int
+	// An Exporter pulls all addresses of a prefecture for building local search indexes.
+	Exporter struct {
+		// OnProgress is called for each city if it is not nil.
+		OnProgress ProgressFunc
+		// Checkpoints persists the last exported city if it is not nil, an interrupted export is resumed
+		// from the next city. The writer should append to the output of the interrupted export.
+		Checkpoints CheckpointStore
+
+		// Config configures the concurrency and the request budget of the export.
+		Config BulkConfig
+
+		cli    *Client
+		format ExportFormat
+	}
+	addressWriter interface {
+		Write(*Address) error
+		Flush() error
+	}
+	csvAddressWriter struct {
+		w *csv.Writer
+		// header is true if the header is not written yet.
+		header bool
+	}
+	ndjsonAddressWriter struct {
+		enc *json.Encoder
+	}
+)
+
+var csvAddressHeader = []string{ //nolint: gochecknoglobals
+	"postal_code", "jisx0402", "prefecture", "city", "town", "koaza", "kyoto_street", "building", "floor",
+	"prefecture_kana", "city_kana", "town_kana",
+}
+
+// NewExporter creates kenall.Exporter with the client, the output format and the QPS budget of the export.
+// All requests of the export wait for the budget in addition to the rate limits of the client,
+// though retries by kenall.WithRetryPolicy are not counted. Zero qps means the rate limit of the client,
+// see kenall.BulkConfig.
+func NewExporter(cli *Client, format ExportFormat, qps float64) *Exporter {
+	return &Exporter{Config: BulkConfig{QPS: qps}, cli: cli, format: format}
+}
+
+// ExportPrefecture enumerates all cities of the prefecture and writes every address of them to the writer.
+// The addresses of each city are collected through the search API by the name of the city.
+// It returns the number of addresses written.
+func (e *Exporter) ExportPrefecture(ctx context.Context, prefectureCode string, w io.Writer) (int, error) {
+	cfg, err := e.Config.resolve(e.cli, EndpointFamilyCities, EndpointFamilyPostalCode)
+	if err != nil {
+		return 0, err
+	}
+
+	limiter := cfg.limiter()
+	if err := limiter.Wait(ctx, e.cli.clock); err != nil {
+		return 0, fmt.Errorf("kenall: failed to wait for the rate limit: %w", err)
+	}
+
+	cities, err := e.cli.GetCity(ctx, prefectureCode)
+	if err != nil {
+		return 0, err
+	}
+
+	job := "export/" + prefectureCode
+
+	checkpoint, err := loadCheckpoint(ctx, e.Checkpoints, job)
+	if err != nil {
+		return 0, fmt.Errorf("kenall: failed to load a checkpoint: %w", err)
+	}
+
+	remaining, err := citiesAfter(cities.Cities, checkpoint)
+	if err != nil {
+		return 0, err
+	}
+
+	aw := e.newAddressWriter(w, checkpoint == "")
+	n := 0
+	progress := newProgressTracker(e.OnProgress, e.cli.clock, len(remaining))
+
+	// NOTE: the cities are fetched concurrently by chunks and written in order to keep the checkpoint consistent.
+	for len(remaining) > 0 {
+		chunk := remaining
+		if len(chunk) > cfg.Workers {
+			chunk = chunk[:cfg.Workers]
+		}
+
+		remaining = remaining[len(chunk):]
+
+		addresses := make([][]*Address, len(chunk))
+
+		err := runWorkers(ctx, cfg.Workers, len(chunk), func(ctx context.Context, i int) error {
+			c := chunk[i]
+
+			pager := e.cli.SearchAddressesPager(ctx, c.Prefecture+c.City, searchPageLimit)
+
+			return pager.walk(ctx, limiter, func(page *SearchAddressesResponse) error {
+				for _, a := range page.Addresses {
+					if a.JISX0402 == c.JISX0402 {
+						addresses[i] = append(addresses[i], a)
+					}
+				}
+
+				return nil
+			})
+		})
+		if err != nil {
+			progress.done(true)
+
+			return n, err
+		}
+
+		for i, c := range chunk {
+			for _, a := range addresses[i] {
+				if err := aw.Write(a); err != nil {
+					return n, fmt.Errorf("kenall: failed to write an address: %w", err)
+				}
+
+				n++
+			}
+
+			err := e.commit(ctx, aw, job, c.JISX0402)
+			progress.done(err != nil)
+
+			if err != nil {
+				return n, err
+			}
+		}
+	}
+
+	if err := aw.Flush(); err != nil {
+		return n, fmt.Errorf("kenall: failed to write an address: %w", err)
+	}
+
+	if err := saveCheckpoint(ctx, e.Checkpoints, job, ""); err != nil {
+		return n, fmt.Errorf("kenall: failed to save a checkpoint: %w", err)
+	}
+
+	return n, nil
+}
+
+// commit flushes the addresses of the city and saves it as the checkpoint.
+func (e *Exporter) commit(ctx context.Context, aw addressWriter, job, jisx0402 string) error {
+	if e.Checkpoints == nil {
+		return nil
+	}
+
+	if err := aw.Flush(); err != nil {
+		return fmt.Errorf("kenall: failed to write an address: %w", err)
+	}
+
+	if err := e.Checkpoints.SaveCheckpoint(ctx, job, jisx0402); err != nil {
+		return fmt.Errorf("kenall: failed to save a checkpoint: %w", err)
+	}
+
+	return nil
+}
+
+func (e *Exporter) newAddressWriter(w io.Writer, header bool) addressWriter {
+	if e.format == ExportFormatNDJSON {
+		return &ndjsonAddressWriter{enc: json.NewEncoder(w)}
+	}
+
+	return &csvAddressWriter{w: csv.NewWriter(w), header: header}
+}
+
+// citiesAfter returns the cities after the city of the checkpoint, all cities if the checkpoint is empty.
+func citiesAfter(cities []*City, checkpoint string) ([]*City, error) {
+	if checkpoint == "" {
+		return cities, nil
+	}
+
+	for i, c := range cities {
+		if c.JISX0402 == checkpoint {
+			return cities[i+1:], nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: unknown checkpoint %s", ErrInvalidArgument, checkpoint)
+}
+
+func (w *csvAddressWriter) Write(a *Address) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	//nolint: wrapcheck
+	return w.w.Write([]string{
+		a.PostalCode, a.JISX0402, a.Prefecture, a.City, a.Town, a.Koaza, a.KyotoStreet, a.Building, a.Floor,
+		a.PrefectureKana, a.CityKana, a.TownKana,
+	})
+}
+
+func (w *csvAddressWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	w.w.Flush()
+
+	//nolint: wrapcheck
+	return w.w.Error()
+}
+
+func (w *csvAddressWriter) writeHeader() error {
+	if !w.header {
+		return nil
+	}
+
+	w.header = false
+
+	if err := w.w.Write(csvAddressHeader); err != nil {
+		return fmt.Errorf("kenall: failed to write a csv header: %w", err)
+	}
+
+	return nil
+}
+
+func (w *ndjsonAddressWriter) Write(a *Address) error {
+	//nolint: wrapcheck
+	return w.enc.Encode(a)
+}
+
+func (w *ndjsonAddressWriter) Flush() error {
+	return nil
+}
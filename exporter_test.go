@@ -0,0 +1,102 @@
+package kenall_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func runExporterServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body string
+		switch {
+		case r.URL.Path == "/cities/13":
+			body = `{"version":"2021-04-30","data":[` +
+				`{"jisx0402":"13101","prefecture_code":"13","prefecture":"東京都","city":"千代田区"},` +
+				`{"jisx0402":"13102","prefecture_code":"13","prefecture":"東京都","city":"中央区"}]}`
+		case r.URL.Path == "/postalcode/" && r.URL.Query().Get("q") == "東京都千代田区":
+			body = `{"version":"2021-06-30","count":2,"data":[` +
+				`{"jisx0402":"13101","postal_code":"1000000","prefecture":"東京都","city":"千代田区","town":""},` +
+				`{"jisx0402":"13102","postal_code":"1040061","prefecture":"東京都","city":"中央区","town":"銀座"}]}`
+		case r.URL.Path == "/postalcode/" && r.URL.Query().Get("q") == "東京都中央区":
+			body = `{"version":"2021-06-30","count":1,"data":[` +
+				`{"jisx0402":"13102","postal_code":"1040061","prefecture":"東京都","city":"中央区","town":"銀座"}]}`
+		default:
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func TestExporter_ExportPrefecture(t *testing.T) {
+	t.Parallel()
+
+	srv := runExporterServer(t)
+
+	cases := map[string]struct {
+		format kenall.ExportFormat
+		want   []string
+	}{
+		"CSV": {format: kenall.ExportFormatCSV, want: []string{
+			"postal_code,jisx0402,prefecture,city,town,koaza,kyoto_street,building,floor,prefecture_kana,city_kana,town_kana",
+			"1000000,13101,東京都,千代田区,,,,,,,,",
+			"1040061,13102,東京都,中央区,銀座,,,,,,,",
+		}},
+		"NDJSON": {format: kenall.ExportFormatNDJSON, want: nil},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithClock(clock))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var buf bytes.Buffer
+			n, err := kenall.NewExporter(cli, c.format, 2).ExportPrefecture(context.Background(), "13", &buf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != 2 {
+				t.Errorf("give: %v, want: %v", n, 2)
+			}
+
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			if c.want != nil && !reflect.DeepEqual(lines, c.want) {
+				t.Errorf("give: %v, want: %v", lines, c.want)
+			}
+			if c.want == nil && len(lines) != n {
+				t.Errorf("give: %v, want: %v", len(lines), n)
+			}
+
+			// NOTE: 3 requests within the budget of 2 QPS without burst.
+			want := []time.Duration{500 * time.Millisecond, 500 * time.Millisecond}
+			if got := clock.Slept(); !reflect.DeepEqual(got, want) {
+				t.Errorf("give: %v, want: %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package kenall
+
+import (
+	"fmt"
+	"time"
+)
+
+// FiscalYearStartMonth is the first month of the Japanese fiscal year.
+const FiscalYearStartMonth = time.April
+
+// A FiscalPeriod is a period of the Japanese fiscal year, Start and End are inclusive dates at midnight in Japan.
+type FiscalPeriod struct {
+	Start time.Time
+	End   time.Time
+}
+
+// FiscalYearOf returns the Japanese fiscal year including the date of t in Japan, e.g. 2022 for 2023-03-31.
+func FiscalYearOf(t time.Time) int {
+	d := t.In(jst)
+	if d.Month() < FiscalYearStartMonth {
+		return d.Year() - 1
+	}
+
+	return d.Year()
+}
+
+// FiscalYearPeriod returns the period of the fiscal year, from April 1 to March 31 of the next year.
+func FiscalYearPeriod(fiscalYear int) FiscalPeriod {
+	return fiscalMonths(fiscalYear, 1, 12) //nolint: gomnd
+}
+
+// FiscalQuarterPeriod returns the period of the quarter from 1 to 4 of the fiscal year.
+func FiscalQuarterPeriod(fiscalYear, quarter int) (FiscalPeriod, error) {
+	if quarter < 1 || quarter > 4 {
+		return FiscalPeriod{}, ErrInvalidArgument
+	}
+
+	return fiscalMonths(fiscalYear, quarter*3-2, 3), nil //nolint: gomnd
+}
+
+// FiscalMonthPeriod returns the period of the month from 1 to 12 of the fiscal year, 1 is April.
+func FiscalMonthPeriod(fiscalYear, month int) (FiscalPeriod, error) {
+	if month < 1 || month > 12 {
+		return FiscalPeriod{}, ErrInvalidArgument
+	}
+
+	return fiscalMonths(fiscalYear, month, 1), nil
+}
+
+func fiscalMonths(fiscalYear, first, months int) FiscalPeriod {
+	start := time.Date(fiscalYear, FiscalYearStartMonth+time.Month(first-1), 1, 0, 0, 0, 0, jst)
+
+	return FiscalPeriod{Start: start, End: start.AddDate(0, months, -1)}
+}
+
+// Contains reports whether the date of t in Japan is in the period.
+func (p FiscalPeriod) Contains(t time.Time) bool {
+	d := truncateDate(t)
+
+	return !d.Before(p.Start) && !d.After(p.End)
+}
+
+// NthBusinessDayOfPeriod returns the nth business day of the period counted from the start,
+// or counted from the end if n is negative, e.g. -1 is the last business day.
+func (c *Calendar) NthBusinessDayOfPeriod(p FiscalPeriod, n int) (time.Time, error) {
+	return nthBusinessDay(p.Start, p.End, n, c.IsBusinessDay)
+}
+
+func nthBusinessDay(start, end time.Time, n int, isBusinessDay func(time.Time) bool) (time.Time, error) {
+	if n == 0 {
+		return time.Time{}, ErrInvalidArgument
+	}
+
+	p := FiscalPeriod{Start: truncateDate(start), End: truncateDate(end)}
+
+	d, step := p.Start, 1
+	if n < 0 {
+		d, step, n = p.End, -1, -n
+	}
+
+	for ; p.Contains(d); d = d.AddDate(0, 0, step) {
+		if isBusinessDay(d) {
+			if n--; n == 0 {
+				return d, nil
+			}
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("kenall: the period has fewer business days than requested: %w", ErrInvalidArgument)
+}
+
+func truncateDate(t time.Time) time.Time {
+	y, m, d := t.In(jst).Date()
+
+	return time.Date(y, m, d, 0, 0, 0, 0, jst)
+}
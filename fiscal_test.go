@@ -0,0 +1,111 @@
+package kenall_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestFiscalYearOf(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give time.Time
+		want int
+	}{
+		"End of fiscal year":   {give: time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC), want: 2022},
+		"Start of fiscal year": {give: time.Date(2023, 3, 31, 15, 0, 0, 0, time.UTC), want: 2023},
+		"Middle of year":       {give: time.Date(2022, 12, 31, 0, 0, 0, 0, time.UTC), want: 2022},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := kenall.FiscalYearOf(c.give); got != c.want {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestFiscalPeriod(t *testing.T) {
+	t.Parallel()
+
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+
+	y := kenall.FiscalYearPeriod(2022)
+	if !y.Start.Equal(time.Date(2022, 4, 1, 0, 0, 0, 0, jst)) || !y.End.Equal(time.Date(2023, 3, 31, 0, 0, 0, 0, jst)) {
+		t.Errorf("give: %v - %v", y.Start, y.End)
+	}
+
+	q, err := kenall.FiscalQuarterPeriod(2022, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !q.Start.Equal(time.Date(2023, 1, 1, 0, 0, 0, 0, jst)) || !q.End.Equal(time.Date(2023, 3, 31, 0, 0, 0, 0, jst)) {
+		t.Errorf("give: %v - %v", q.Start, q.End)
+	}
+	if !q.Contains(time.Date(2023, 3, 31, 14, 59, 0, 0, time.UTC)) || q.Contains(time.Date(2023, 3, 31, 15, 0, 0, 0, time.UTC)) {
+		t.Error("the period should contain dates in Japan")
+	}
+
+	m, err := kenall.FiscalMonthPeriod(2022, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.Start.Equal(time.Date(2023, 1, 1, 0, 0, 0, 0, jst)) || !m.End.Equal(time.Date(2023, 1, 31, 0, 0, 0, 0, jst)) {
+		t.Errorf("give: %v - %v", m.Start, m.End)
+	}
+
+	if _, err := kenall.FiscalQuarterPeriod(2022, 5); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+	if _, err := kenall.FiscalMonthPeriod(2022, 0); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+}
+
+func TestCalendar_NthBusinessDayOfPeriod(t *testing.T) {
+	t.Parallel()
+
+	cal := newTestingCalendar(t)
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+
+	p, err := kenall.FiscalMonthPeriod(2021, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		n         int
+		want      time.Time
+		wantError error
+	}{
+		"First business day":  {n: 1, want: time.Date(2022, 1, 4, 0, 0, 0, 0, jst), wantError: nil},
+		"Fifth business day":  {n: 5, want: time.Date(2022, 1, 11, 0, 0, 0, 0, jst), wantError: nil},
+		"Last business day":   {n: -1, want: time.Date(2022, 1, 31, 0, 0, 0, 0, jst), wantError: nil},
+		"Zero":                {n: 0, want: time.Time{}, wantError: kenall.ErrInvalidArgument},
+		"Beyond business day": {n: 30, want: time.Time{}, wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := cal.NthBusinessDayOfPeriod(p, c.n)
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if !got.Equal(c.want) {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
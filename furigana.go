@@ -0,0 +1,48 @@
+package kenall
+
+import "strings"
+
+// furiganaFiller is a response whose query furigana is filled after the response is decoded.
+type furiganaFiller interface {
+	fillFurigana()
+}
+
+// Furigana returns the furigana of the normalized prefecture, city and town joined in order.
+func (q *Query) Furigana() string {
+	var b strings.Builder
+	for _, ns := range []NullString{q.PrefectureKana, q.CityKana, q.TownKana} {
+		if ns.Valid {
+			b.WriteString(ns.String)
+		}
+	}
+
+	return b.String()
+}
+
+// fillFurigana fills the furigana of the query from the kana fields of the matched addresses,
+// an address of a building is not used for the town since its kana includes the building name.
+func (r *GetNormalizeAddressResponse) fillFurigana() {
+	q := &r.Query
+
+	for _, a := range r.Addresses {
+		if !q.Prefecture.Valid || a.Prefecture != q.Prefecture.String {
+			continue
+		}
+
+		if !q.PrefectureKana.Valid && a.PrefectureKana != "" {
+			q.PrefectureKana = NullString{String: a.PrefectureKana, Valid: true}
+		}
+
+		if !q.City.Valid || a.City != q.City.String {
+			continue
+		}
+
+		if !q.CityKana.Valid && a.CityKana != "" {
+			q.CityKana = NullString{String: a.CityKana, Valid: true}
+		}
+
+		if q.Town.Valid && a.Town == q.Town.String && a.Building == "" && !q.TownKana.Valid && a.TownKana != "" {
+			q.TownKana = NullString{String: a.TownKana, Valid: true}
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package kenall_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestQuery_Furigana(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithKanaScript(kenall.KanaScriptHiragana))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cli.GetNormalizeAddress(context.Background(), "東京都港区六本木六丁目10番1号六本木ヒルズ森タワー18F")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Addresses) != 100 {
+		t.Errorf("give: %v, want: %v", len(res.Addresses), 100)
+	}
+	if got, want := res.Query.TownKana.String, "ろっぽんぎ"; got != want {
+		t.Errorf("give: %v, want: %v", got, want)
+	}
+	if got, want := res.Query.Furigana(), "とうきょうとみなとくろっぽんぎ"; got != want {
+		t.Errorf("give: %v, want: %v", got, want)
+	}
+
+	empty := &kenall.Query{}
+	if got := empty.Furigana(); got != "" {
+		t.Errorf("give: %v, want: %v", got, "")
+	}
+}
@@ -0,0 +1,88 @@
+package kenall_test
+
+import (
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func FuzzVersion_UnmarshalJSON(f *testing.F) {
+	for _, seed := range []string{`"2021-06-30"`, `null`, `""`, `"2021-13-01"`, `2021`} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var v kenall.Version
+		_ = v.UnmarshalJSON(data)
+	})
+}
+
+func FuzzNullString_UnmarshalJSON(f *testing.F) {
+	for _, seed := range []string{`"foo"`, `null`, `""`, `1`, `"\u0000"`} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var ns kenall.NullString
+		if err := ns.UnmarshalJSON(data); err != nil && ns.Valid {
+			t.Error("a NullString should not be valid on an error")
+		}
+	})
+}
+
+func FuzzRemoteAddress_UnmarshalJSON(f *testing.F) {
+	for _, seed := range []string{
+		`{"type":"v4","address":"127.0.0.1"}`,
+		`{"type":"v6","address":"fe80::1%eth0"}`,
+		`{"type":"v4","address":"localhost"}`,
+		`{"type":"v6","address":"127.0.0.1"}`,
+		`{}`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var ra kenall.RemoteAddress
+		if err := ra.UnmarshalJSON(data); err == nil {
+			_ = ra.Network()
+			_ = ra.String()
+		}
+	})
+}
+
+func FuzzHoliday_UnmarshalJSON(f *testing.F) {
+	for _, seed := range []string{
+		`{"title":"元日","date":"2022-01-01","day_of_week":6,"day_of_week_text":"saturday"}`,
+		`{"date":""}`,
+		`null`,
+		`[]`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var h kenall.Holiday
+		if err := h.UnmarshalJSON(data); err == nil {
+			if _, err := h.MarshalJSON(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+}
+
+func FuzzBusinessDay_UnmarshalJSON(f *testing.F) {
+	for _, seed := range []string{
+		`"2022-01-01"`,
+		`"2022-01-01T00:00:00+09:00"`,
+		`{"date":"2022-01-01","is_legal_holiday":true}`,
+		`null`,
+		`{}`,
+	} {
+		f.Add([]byte(seed))
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var bd kenall.BusinessDay
+		_ = bd.UnmarshalJSON(data)
+	})
+}
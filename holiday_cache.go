@@ -0,0 +1,90 @@
+package kenall
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type (
+	// A HolidayCache lazily fetches holidays for each year from the kenall service and caches them.
+	// A cached year is fetched again after the refresh interval,
+	// since cabinet decisions occasionally add or move holidays.
+	HolidayCache struct {
+		cli             *Client
+		refreshInterval time.Duration
+
+		mu      sync.Mutex
+		entries map[int]*holidayCacheEntry
+	}
+
+	holidayCacheEntry struct {
+		holidays  []*Holiday
+		fetchedAt time.Time
+	}
+)
+
+// NewHolidayCache creates kenall.HolidayCache, a zero or negative refresh interval never refreshes cached years.
+func NewHolidayCache(cli *Client, refreshInterval time.Duration) *HolidayCache {
+	return &HolidayCache{
+		cli:             cli,
+		refreshInterval: refreshInterval,
+		entries:         map[int]*holidayCacheEntry{},
+	}
+}
+
+// Holidays returns holidays for the year, it requests to the kenall service only if the year is not cached yet
+// or the cached one is older than the refresh interval. The returned holidays are copies of the cached ones.
+func (hc *HolidayCache) Holidays(ctx context.Context, year int) ([]*Holiday, error) {
+	now := hc.cli.clock.Now()
+
+	hc.mu.Lock()
+	e, ok := hc.entries[year]
+	hc.mu.Unlock()
+
+	if ok && (hc.refreshInterval <= 0 || now.Sub(e.fetchedAt) < hc.refreshInterval) {
+		return cloneHolidays(e.holidays), nil
+	}
+
+	res, err := hc.cli.GetHolidaysByYear(ctx, year)
+	if err != nil {
+		return nil, err
+	}
+
+	hc.mu.Lock()
+	hc.entries[year] = &holidayCacheEntry{holidays: res.Holidays, fetchedAt: now}
+	hc.mu.Unlock()
+
+	return cloneHolidays(res.Holidays), nil
+}
+
+// refresh fetches the cached years again, the years failed to fetch keep the cached holidays
+// and the first error is returned.
+func (hc *HolidayCache) refresh(ctx context.Context) error {
+	hc.mu.Lock()
+	years := make([]int, 0, len(hc.entries))
+	for year := range hc.entries {
+		years = append(years, year)
+	}
+	hc.mu.Unlock()
+
+	var first error
+	for _, year := range years {
+		now := hc.cli.clock.Now()
+
+		res, err := hc.cli.GetHolidaysByYear(ctx, year)
+		if err != nil {
+			if first == nil {
+				first = err
+			}
+
+			continue
+		}
+
+		hc.mu.Lock()
+		hc.entries[year] = &holidayCacheEntry{holidays: res.Holidays, fetchedAt: now}
+		hc.mu.Unlock()
+	}
+
+	return first
+}
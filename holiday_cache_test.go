@@ -0,0 +1,67 @@
+package kenall_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestHolidayCache_Holidays(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Query().Get("year") != "2022" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		if _, err := w.Write(holidaysResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithClock(clock))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hc := kenall.NewHolidayCache(cli, 24*time.Hour)
+
+	for _, want := range []int32{1, 1} {
+		holidays, err := hc.Holidays(ctx, 2022)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(holidays) != 16 {
+			t.Errorf("give: %v, want: %v", len(holidays), 16)
+		}
+		if n := atomic.LoadInt32(&requests); n != want {
+			t.Errorf("give: %v, want: %v", n, want)
+		}
+	}
+
+	if err := clock.Sleep(ctx, 24*time.Hour); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := hc.Holidays(ctx, 2022); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Errorf("give: %v, want: %v", n, 2)
+	}
+
+	if _, err := hc.Holidays(ctx, 2021); err == nil {
+		t.Error("an error should not be nil")
+	}
+}
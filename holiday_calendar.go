@@ -0,0 +1,108 @@
+package kenall
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// DefaultHolidayRefreshInterval is the default interval to refresh the cached holidays in the background.
+const DefaultHolidayRefreshInterval = 24 * time.Hour
+
+type (
+	// A CachedHolidayCalendar is a kenall.BusinessCalendar closing on Saturdays, Sundays and national holidays,
+	// the holidays are fetched lazily for each year, cached in memory and refreshed in the background,
+	// so that checking business days does not request to the kenall service every time.
+	// Use kenall.BusinessCalendar.Calendar to check national holidays of the cached years.
+	CachedHolidayCalendar struct {
+		*BusinessCalendar
+
+		refreshInterval time.Duration
+		onRefreshError  func(error)
+		clock           Clock
+
+		once   sync.Once
+		cancel context.CancelFunc
+		done   chan struct{}
+	}
+	// A CachedHolidayCalendarOption provides a customize option for kenall.CachedHolidayCalendar.
+	CachedHolidayCalendarOption interface {
+		applyCachedHolidayCalendar(*CachedHolidayCalendar)
+	}
+
+	withRefreshInterval struct {
+		d time.Duration
+	}
+	withRefreshErrorHandler struct {
+		fn func(error)
+	}
+)
+
+// NewCachedHolidayCalendar creates kenall.CachedHolidayCalendar and starts refreshing the cached holidays
+// in the background, kenall.CachedHolidayCalendar.Close must be called to stop it.
+// The interval is waited by the clock of the client, see kenall.WithClock.
+func NewCachedHolidayCalendar(cli *Client, opts ...CachedHolidayCalendarOption) *CachedHolidayCalendar {
+	hc := &CachedHolidayCalendar{
+		BusinessCalendar: NewBusinessCalendar(NewHolidayCache(cli, 0), SaturdayAndSunday()),
+		refreshInterval:  DefaultHolidayRefreshInterval,
+		clock:            cli.clock,
+		done:             make(chan struct{}),
+	}
+
+	for _, opt := range opts {
+		opt.applyCachedHolidayCalendar(hc)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	hc.cancel = cancel
+
+	go hc.run(ctx)
+
+	return hc
+}
+
+// Close stops refreshing the cached holidays in the background and waits for it.
+func (hc *CachedHolidayCalendar) Close() error {
+	hc.once.Do(hc.cancel)
+	<-hc.done
+
+	return nil
+}
+
+func (hc *CachedHolidayCalendar) run(ctx context.Context) {
+	defer close(hc.done)
+
+	if hc.refreshInterval <= 0 {
+		return
+	}
+
+	for {
+		if err := hc.clock.Sleep(ctx, hc.refreshInterval); err != nil || ctx.Err() != nil {
+			return
+		}
+
+		if err := hc.Holidays.refresh(ctx); err != nil && hc.onRefreshError != nil && ctx.Err() == nil {
+			hc.onRefreshError(err)
+		}
+	}
+}
+
+func (w withRefreshInterval) applyCachedHolidayCalendar(hc *CachedHolidayCalendar) {
+	hc.refreshInterval = w.d
+}
+
+// WithRefreshInterval sets the interval to refresh the cached holidays in the background,
+// a zero or negative interval never refreshes them.
+func WithRefreshInterval(d time.Duration) CachedHolidayCalendarOption {
+	return withRefreshInterval{d: d}
+}
+
+func (w withRefreshErrorHandler) applyCachedHolidayCalendar(hc *CachedHolidayCalendar) {
+	hc.onRefreshError = w.fn
+}
+
+// WithRefreshErrorHandler sets the handler called with the error of refreshing in the background,
+// the cached holidays are kept as they are on errors.
+func WithRefreshErrorHandler(fn func(error)) CachedHolidayCalendarOption {
+	return withRefreshErrorHandler{fn: fn}
+}
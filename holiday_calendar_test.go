@@ -0,0 +1,208 @@
+package kenall_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestCachedHolidayCalendar(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if r.URL.Query().Get("year") != "2022" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		if _, err := w.Write(holidaysResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	hc := kenall.NewCachedHolidayCalendar(cli, kenall.WithRefreshInterval(10*time.Millisecond))
+	t.Cleanup(func() {
+		if err := hc.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	cases := map[string]struct {
+		give        time.Time
+		wantHoliday bool
+		wantBiz     bool
+	}{
+		"Holiday":  {give: time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC), wantHoliday: true, wantBiz: false},
+		"Saturday": {give: time.Date(2022, 1, 8, 0, 0, 0, 0, time.UTC), wantHoliday: false, wantBiz: false},
+		"Weekday":  {give: time.Date(2022, 1, 11, 0, 0, 0, 0, time.UTC), wantHoliday: false, wantBiz: true},
+	}
+
+	cal, err := hc.Calendar(ctx, "", 2022)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, c := range cases {
+		if holiday := cal.IsHoliday(c.give); holiday != c.wantHoliday {
+			t.Errorf("%s: give: %v, want: %v", name, holiday, c.wantHoliday)
+		}
+
+		biz, err := hc.IsBusinessDay(ctx, c.give)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if biz != c.wantBiz {
+			t.Errorf("%s: give: %v, want: %v", name, biz, c.wantBiz)
+		}
+	}
+
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("give: %v, want: %v", n, 1)
+	}
+
+	for deadline := time.Now().Add(time.Second); atomic.LoadInt32(&requests) < 2; {
+		if time.Now().After(deadline) {
+			t.Fatal("the cached holidays should be refreshed in the background")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if _, err := hc.Calendar(ctx, "", 2021); err == nil {
+		t.Error("an error should not be nil")
+	}
+}
+
+func TestWithRefreshErrorHandler(t *testing.T) {
+	t.Parallel()
+
+	var fail int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&fail) == 1 {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+		if _, err := w.Write(holidaysResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	errs := make(chan error, 1)
+	hc := kenall.NewCachedHolidayCalendar(cli,
+		kenall.WithRefreshInterval(10*time.Millisecond),
+		kenall.WithRefreshErrorHandler(func(err error) {
+			select {
+			case errs <- err:
+			default:
+			}
+		}),
+	)
+	t.Cleanup(func() {
+		if err := hc.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	ctx := context.Background()
+	if _, err := hc.Calendar(ctx, "", 2022); err != nil {
+		t.Fatal(err)
+	}
+
+	atomic.StoreInt32(&fail, 1)
+
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Error("an error should not be nil")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("the handler should be called")
+	}
+
+	cal, err := hc.Calendar(ctx, "", 2022)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cal.IsHoliday(time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Error("the cached holidays should be kept on errors")
+	}
+}
+
+// tickClock is kenall.Clock whose Sleep waits for a tick instead of the duration.
+type tickClock struct {
+	fakeClock
+	ticks chan struct{}
+}
+
+func (c *tickClock) Sleep(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-c.ticks:
+		return c.fakeClock.Sleep(ctx, d)
+	}
+}
+
+func TestCachedHolidayCalendar_Clock(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		if _, err := w.Write(holidaysResponse); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	clock := &tickClock{ticks: make(chan struct{})}
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithClock(clock))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hc := kenall.NewCachedHolidayCalendar(cli, kenall.WithRefreshInterval(time.Hour))
+	t.Cleanup(func() {
+		if err := hc.Close(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	if _, err := hc.Calendar(context.Background(), "", 2022); err != nil {
+		t.Fatal(err)
+	}
+
+	clock.ticks <- struct{}{}
+
+	for deadline := time.Now().Add(time.Second); atomic.LoadInt32(&requests) < 2; {
+		if time.Now().After(deadline) {
+			t.Fatal("the cached holidays should be refreshed by the clock")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	if give := clock.Slept(); len(give) != 1 || give[0] != time.Hour {
+		t.Errorf("give: %v, want: %v", give, []time.Duration{time.Hour})
+	}
+}
@@ -0,0 +1,68 @@
+package kenall
+
+type (
+	// An AddressHook is called for each decoded address, see kenall.WithAddressHook.
+	AddressHook func(*Address)
+	// A CityHook is called for each decoded city, see kenall.WithCityHook.
+	CityHook func(*City)
+	// A CorporationHook is called for each decoded corporation, see kenall.WithCorporationHook.
+	CorporationHook func(*Corporation)
+
+	decodeHooks struct {
+		addresses    []AddressHook
+		cities       []CityHook
+		corporations []CorporationHook
+	}
+)
+
+// apply calls the hooks for each record of the response in order of registration, null elements are skipped.
+func (h *decodeHooks) apply(res interface{}) {
+	switch r := res.(type) {
+	case *GetAddressResponse:
+		h.applyAddresses(r.Addresses)
+	case *GetNormalizeAddressResponse:
+		h.applyAddresses(r.Addresses)
+	case *SearchAddressesResponse:
+		h.applyAddresses(r.Addresses)
+	case *GetCityResponse:
+		for _, c := range r.Cities {
+			if c == nil {
+				continue
+			}
+
+			for _, hook := range h.cities {
+				hook(c)
+			}
+		}
+	case *GetCorporationResponse:
+		if r.Corporation != nil {
+			h.applyCorporations([]*Corporation{r.Corporation})
+		}
+	case *SearchCorporationsResponse:
+		h.applyCorporations(r.Corporations)
+	}
+}
+
+func (h *decodeHooks) applyAddresses(addresses []*Address) {
+	for _, a := range addresses {
+		if a == nil {
+			continue
+		}
+
+		for _, hook := range h.addresses {
+			hook(a)
+		}
+	}
+}
+
+func (h *decodeHooks) applyCorporations(corporations []*Corporation) {
+	for _, c := range corporations {
+		if c == nil {
+			continue
+		}
+
+		for _, hook := range h.corporations {
+			hook(c)
+		}
+	}
+}
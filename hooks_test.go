@@ -0,0 +1,110 @@
+package kenall_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_DecodeHooks(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	var order []string
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithKanaScript(kenall.KanaScriptHiragana),
+		kenall.WithAddressHook(func(a *kenall.Address) {
+			order = append(order, "first")
+			a.Town = "[" + a.Town + "]"
+		}),
+		kenall.WithAddressHook(func(a *kenall.Address) {
+			order = append(order, "second")
+			a.Town = strings.ToUpper(a.Town)
+		}),
+		kenall.WithCityHook(func(c *kenall.City) {
+			c.City = strings.TrimSuffix(c.City, "区")
+		}),
+		kenall.WithCorporationHook(func(c *kenall.Corporation) {
+			c.Name = strings.TrimPrefix(c.Name, "株式会社")
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	addr, err := cli.GetAddress(ctx, "1008105")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := addr.Addresses[0].Town; got != "[西新宿]" {
+		t.Errorf("give: %v, want: %v", got, "[西新宿]")
+	}
+	if strings.Join(order, ",") != "first,second" {
+		t.Errorf("give: %v, want: first,second", order)
+	}
+	if got := addr.Addresses[0].Corporation.NameKana; got != "とうきようとちよう" {
+		t.Errorf("give: %v, want: the kana script applied before the hooks", got)
+	}
+
+	city, err := cli.GetCity(ctx, "13")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := city.Cities[0].City; got != "千代田" {
+		t.Errorf("give: %v, want: %v", got, "千代田")
+	}
+
+	corp, err := cli.GetCorporation(ctx, "2021001052596")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := corp.Corporation.Name; got != "オープンコレクター" {
+		t.Errorf("give: %v, want: %v", got, "オープンコレクター")
+	}
+}
+
+func TestClient_DecodeHooks_NullElements(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{"version":"2021-06-30","data":[null]}`)); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	var calls int
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithAddressHook(func(a *kenall.Address) {
+			calls++
+			a.Town = strings.TrimSpace(a.Town)
+		}),
+		kenall.WithCityHook(func(c *kenall.City) {
+			calls++
+			c.City = strings.TrimSpace(c.City)
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetAddress(context.Background(), "1000001"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.GetCity(context.Background(), "13"); err != nil {
+		t.Fatal(err)
+	}
+	if calls != 0 {
+		t.Errorf("give: %v, want: the hooks are not called for null elements", calls)
+	}
+}
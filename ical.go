@@ -0,0 +1,148 @@
+package kenall
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// DefaultICalProductID is the default PRODID of the iCalendar written by kenall.GetHolidaysResponse.WriteICal.
+	DefaultICalProductID = "-//osamingo//go-kenall//JA"
+
+	// icalMaxLineOctets is the maximum octets of a content line excluding the line break, see RFC 5545 3.1.
+	icalMaxLineOctets = 75
+	icalDateFormat    = "20060102"
+	icalStampFormat   = "20060102T150405Z"
+)
+
+type (
+	// An ICalOption provides a customize option for writing an iCalendar.
+	ICalOption interface {
+		applyICal(*icalConfig)
+	}
+
+	icalConfig struct {
+		productID string
+		name      string
+		stamp     time.Time
+	}
+	withICalName struct {
+		name string
+	}
+	withICalProductID struct {
+		id string
+	}
+	withICalTimestamp struct {
+		t time.Time
+	}
+)
+
+// WriteICal writes the holidays as an iCalendar (RFC 5545) with an all-day VEVENT for each holiday,
+// which can be imported into calendar applications such as Google Calendar and Outlook.
+func (r *GetHolidaysResponse) WriteICal(w io.Writer, opts ...ICalOption) error {
+	cfg := &icalConfig{
+		productID: DefaultICalProductID,
+		stamp:     r.Version.Time(),
+	}
+
+	for _, opt := range opts {
+		opt.applyICal(cfg)
+	}
+
+	if cfg.stamp.IsZero() {
+		cfg.stamp = time.Now()
+	}
+
+	bw := bufio.NewWriter(w)
+	stamp := cfg.stamp.UTC().Format(icalStampFormat)
+
+	writeICalLine(bw, "BEGIN:VCALENDAR")
+	writeICalLine(bw, "VERSION:2.0")
+	writeICalLine(bw, "PRODID:"+escapeICalText(cfg.productID))
+	writeICalLine(bw, "CALSCALE:GREGORIAN")
+	writeICalLine(bw, "METHOD:PUBLISH")
+
+	if cfg.name != "" {
+		writeICalLine(bw, "X-WR-CALNAME:"+escapeICalText(cfg.name))
+	}
+
+	writeICalLine(bw, "X-WR-TIMEZONE:Asia/Tokyo")
+
+	for _, h := range r.Holidays {
+		d := h.In(jst)
+
+		writeICalLine(bw, "BEGIN:VEVENT")
+		writeICalLine(bw, "UID:"+d.Format(icalDateFormat)+"-holiday@kenall.jp")
+		writeICalLine(bw, "DTSTAMP:"+stamp)
+		writeICalLine(bw, "DTSTART;VALUE=DATE:"+d.Format(icalDateFormat))
+		writeICalLine(bw, "DTEND;VALUE=DATE:"+d.AddDate(0, 0, 1).Format(icalDateFormat))
+		writeICalLine(bw, "SUMMARY:"+escapeICalText(h.Title))
+		writeICalLine(bw, "TRANSP:TRANSPARENT")
+		writeICalLine(bw, "END:VEVENT")
+	}
+
+	writeICalLine(bw, "END:VCALENDAR")
+
+	if err := bw.Flush(); err != nil {
+		return fmt.Errorf("kenall: failed to write iCalendar: %w", err)
+	}
+
+	return nil
+}
+
+// writeICalLine writes the content line terminated by CRLF and folds it at 75 octets
+// without splitting a multi-byte character.
+func writeICalLine(w *bufio.Writer, line string) {
+	for n := icalMaxLineOctets; len(line) > n; n = icalMaxLineOctets - 1 {
+		i := n
+		for i > 0 && !utf8.RuneStart(line[i]) {
+			i--
+		}
+
+		_, _ = w.WriteString(line[:i])
+		_, _ = w.WriteString("\r\n ")
+		line = line[i:]
+	}
+
+	_, _ = w.WriteString(line)
+	_, _ = w.WriteString("\r\n")
+}
+
+var icalTextEscaper = strings.NewReplacer( //nolint: gochecknoglobals
+	`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`,
+)
+
+func escapeICalText(s string) string {
+	return icalTextEscaper.Replace(s)
+}
+
+func (w withICalName) applyICal(c *icalConfig) {
+	c.name = w.name
+}
+
+// WithICalName sets the calendar name shown by calendar applications, e.g. "日本の祝日".
+func WithICalName(name string) ICalOption {
+	return withICalName{name: name}
+}
+
+func (w withICalProductID) applyICal(c *icalConfig) {
+	c.productID = w.id
+}
+
+// WithICalProductID sets the PRODID of the iCalendar instead of kenall.DefaultICalProductID.
+func WithICalProductID(id string) ICalOption {
+	return withICalProductID{id: id}
+}
+
+func (w withICalTimestamp) applyICal(c *icalConfig) {
+	c.stamp = w.t
+}
+
+// WithICalTimestamp sets the DTSTAMP of the events, the version of the response is used by default.
+func WithICalTimestamp(t time.Time) ICalOption {
+	return withICalTimestamp{t: t}
+}
@@ -0,0 +1,96 @@
+package kenall_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestGetHolidaysResponse_WriteICal(t *testing.T) {
+	t.Parallel()
+
+	var res kenall.GetHolidaysResponse
+	if err := json.Unmarshal(holidaysResponse, &res); err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err := res.WriteICal(&buf,
+		kenall.WithICalName("日本の祝日"),
+		kenall.WithICalTimestamp(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := buf.String()
+	if !strings.HasPrefix(got, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//osamingo//go-kenall//JA\r\n") {
+		t.Errorf("give: %q", got)
+	}
+	if !strings.HasSuffix(got, "END:VCALENDAR\r\n") {
+		t.Errorf("give: %q", got)
+	}
+	if n := strings.Count(got, "BEGIN:VEVENT\r\n"); n != len(res.Holidays) {
+		t.Errorf("give: %v, want: %v", n, len(res.Holidays))
+	}
+
+	for _, want := range []string{
+		"X-WR-CALNAME:日本の祝日\r\n",
+		"BEGIN:VEVENT\r\nUID:20220110-holiday@kenall.jp\r\nDTSTAMP:20220101T000000Z\r\n" +
+			"DTSTART;VALUE=DATE:20220110\r\nDTEND;VALUE=DATE:20220111\r\nSUMMARY:成人の日\r\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("give: %q, want: %q", got, want)
+		}
+	}
+}
+
+func TestGetHolidaysResponse_WriteICal_Folding(t *testing.T) {
+	t.Parallel()
+
+	res := &kenall.GetHolidaysResponse{
+		Holidays: []*kenall.Holiday{
+			{Title: strings.Repeat("祝日", 30) + ",;", Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	var buf bytes.Buffer
+	if err := res.WriteICal(&buf, kenall.WithICalProductID("-//example//test//EN")); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n")
+	for _, l := range lines {
+		if len(l) > 75 || !utf8.ValidString(l) {
+			t.Errorf("give: %q", l)
+		}
+	}
+
+	unfolded := strings.ReplaceAll(buf.String(), "\r\n ", "")
+	if want := "SUMMARY:" + strings.Repeat("祝日", 30) + `\,\;` + "\r\n"; !strings.Contains(unfolded, want) {
+		t.Errorf("give: %q, want: %q", unfolded, want)
+	}
+	if !strings.Contains(unfolded, "PRODID:-//example//test//EN\r\n") {
+		t.Errorf("give: %q", unfolded)
+	}
+}
+
+type errWriter struct{}
+
+func (errWriter) Write([]byte) (int, error) {
+	return 0, errors.New("closed")
+}
+
+func TestGetHolidaysResponse_WriteICal_Error(t *testing.T) {
+	t.Parallel()
+
+	if err := (&kenall.GetHolidaysResponse{}).WriteICal(errWriter{}); err == nil {
+		t.Error("an error should not be nil")
+	}
+}
@@ -0,0 +1,100 @@
+// Command citytablegen generates the city table embedded in the kenall package from the city API.
+// The responses of older versions in the file are kept, so that kenall.CityByJISX0402AsOf can resolve
+// codes abolished by mergers of municipalities.
+//
+// Usage:
+//
+//	KENALL_AUTHORIZATION_TOKEN=... go run ./internal/cmd/citytablegen -out city_table_data.json
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+const prefectures = 47
+
+func main() {
+	out := flag.String("out", "city_table_data.json", "path to the generated file")
+
+	flag.Parse()
+
+	cli, err := kenall.NewClient(os.Getenv("KENALL_AUTHORIZATION_TOKEN"))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	responses := make([]*kenall.GetCityResponse, 0, prefectures)
+
+	for i := 1; i <= prefectures; i++ {
+		res, err := cli.GetCity(context.Background(), fmt.Sprintf("%02d", i))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		responses = append(responses, res)
+	}
+
+	previous, err := load(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	for _, res := range previous {
+		// The responses of the fetched version are replaced with the fetched ones.
+		if res.Version.Equal(responses[0].Version) {
+			continue
+		}
+
+		if err := enc.Encode(res); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	for _, res := range responses {
+		if err := enc.Encode(res); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if err := os.WriteFile(*out, buf.Bytes(), 0o644); err != nil { //nolint: gosec
+		log.Fatal(err)
+	}
+}
+
+func load(path string) ([]*kenall.GetCityResponse, error) {
+	f, err := os.Open(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("citytablegen: failed to open: %w", err)
+	}
+	defer f.Close()
+
+	var ret []*kenall.GetCityResponse
+
+	for dec := json.NewDecoder(f); ; {
+		var res kenall.GetCityResponse
+		if err := dec.Decode(&res); errors.Is(err, io.EOF) {
+			return ret, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("citytablegen: failed to decode: %w", err)
+		}
+
+		ret = append(ret, &res)
+	}
+}
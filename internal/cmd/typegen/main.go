@@ -0,0 +1,38 @@
+// Command typegen generates Go structs of the kenall responses from the JSON schema.
+//
+// Usage:
+//
+//	go run ./internal/cmd/typegen -schema schema/kenall.json -out types_gen.go
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+
+	"github.com/osamingo/go-kenall/v2/internal/typegen"
+)
+
+func main() {
+	var (
+		schema = flag.String("schema", "schema/kenall.json", "path to the JSON schema")
+		out    = flag.String("out", "types_gen.go", "path to the generated file")
+		pkg    = flag.String("package", "kenall", "package name of the generated file")
+	)
+
+	flag.Parse()
+
+	src, err := os.ReadFile(*schema)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	b, err := typegen.Generate(src, *pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile(*out, b, 0o644); err != nil { //nolint: gosec
+		log.Fatal(err)
+	}
+}
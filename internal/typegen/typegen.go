@@ -0,0 +1,206 @@
+// Package typegen generates Go structs of the kenall responses from the JSON schema in schema/kenall.json.
+//
+// The schema supports a subset of JSON Schema: object definitions with the description and the properties
+// typed "string", "integer", "number" or "boolean", optionally nullable by ["string", "null"].
+// The description is split into the comment lines by newlines.
+// The extension "x-go-type" overrides the Go type of a property, e.g. "json.Number".
+// The properties are generated in the order of the schema.
+package typegen
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+)
+
+// Header is the first line of generated files.
+const Header = "// Code generated by typegen from schema/kenall.json. DO NOT EDIT."
+
+type (
+	schema struct {
+		Definitions map[string]*definition `json:"definitions"`
+	}
+	definition struct {
+		Description string          `json:"description"`
+		Type        string          `json:"type"`
+		Properties  json.RawMessage `json:"properties"`
+	}
+	property struct {
+		Type   json.RawMessage `json:"type"`
+		GoType string          `json:"x-go-type"`
+	}
+)
+
+// ErrUnsupportedSchema is returned when the schema uses a feature not supported by the generator.
+var ErrUnsupportedSchema = errors.New("typegen: unsupported schema")
+
+var initialisms = map[string]string{"id": "ID", "jisx0402": "JISX0402", "url": "URL", "ip": "IP"} //nolint: gochecknoglobals
+
+// Generate generates the Go source of the package from the schema.
+func Generate(src []byte, pkg string) ([]byte, error) {
+	var s schema
+	if err := json.Unmarshal(src, &s); err != nil {
+		return nil, fmt.Errorf("typegen: failed to parse the schema: %w", err)
+	}
+
+	names := make([]string, 0, len(s.Definitions))
+	for name := range s.Definitions {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var (
+		body       bytes.Buffer
+		usesNumber bool
+	)
+
+	for _, name := range names {
+		def := s.Definitions[name]
+		if def.Type != "object" {
+			return nil, fmt.Errorf("%w: %s is not an object", ErrUnsupportedSchema, name)
+		}
+
+		keys, err := orderedKeys(def.Properties)
+		if err != nil {
+			return nil, fmt.Errorf("typegen: failed to parse the properties of %s: %w", name, err)
+		}
+
+		var props map[string]*property
+		if err := json.Unmarshal(def.Properties, &props); err != nil {
+			return nil, fmt.Errorf("typegen: failed to parse the properties of %s: %w", name, err)
+		}
+
+		if def.Description != "" {
+			for _, line := range strings.Split(def.Description, "\n") {
+				fmt.Fprintf(&body, "// %s\n", line)
+			}
+		}
+
+		fmt.Fprintf(&body, "type %s struct {\n", name)
+
+		for _, key := range keys {
+			typ, err := goType(props[key])
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %w", name, key, err)
+			}
+
+			usesNumber = usesNumber || strings.HasPrefix(typ, "json.")
+
+			fmt.Fprintf(&body, "\t%s %s `json:%q`\n", goName(key), typ, key)
+		}
+
+		body.WriteString("}\n\n")
+	}
+
+	var out bytes.Buffer
+
+	fmt.Fprintf(&out, "%s\n\npackage %s\n\n", Header, pkg)
+
+	if usesNumber {
+		out.WriteString("import \"encoding/json\"\n\n")
+	}
+
+	out.Write(body.Bytes())
+
+	b, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("typegen: failed to format the source: %w", err)
+	}
+
+	return b, nil
+}
+
+func goType(p *property) (string, error) {
+	if p.GoType != "" {
+		return p.GoType, nil
+	}
+
+	var types []string
+	if err := json.Unmarshal(p.Type, &types); err != nil {
+		var t string
+		if err := json.Unmarshal(p.Type, &t); err != nil {
+			return "", fmt.Errorf("%w: type %s", ErrUnsupportedSchema, p.Type)
+		}
+
+		types = []string{t}
+	}
+
+	nullable := false
+	base := ""
+
+	for _, t := range types {
+		if t == "null" {
+			nullable = true
+		} else {
+			base = t
+		}
+	}
+
+	switch {
+	case base == "string" && nullable:
+		return "NullString", nil
+	case nullable:
+		return "", fmt.Errorf("%w: nullable %s", ErrUnsupportedSchema, base)
+	case base == "string":
+		return "string", nil
+	case base == "integer":
+		return "int", nil
+	case base == "number":
+		return "float64", nil
+	case base == "boolean":
+		return "bool", nil
+	default:
+		return "", fmt.Errorf("%w: type %s", ErrUnsupportedSchema, p.Type)
+	}
+}
+
+func goName(key string) string {
+	var b strings.Builder
+
+	for _, part := range strings.Split(key, "_") {
+		if v, ok := initialisms[part]; ok {
+			b.WriteString(v)
+
+			continue
+		}
+
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+
+	return b.String()
+}
+
+// orderedKeys returns the keys of the JSON object in order of appearance.
+func orderedKeys(raw json.RawMessage) ([]string, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, fmt.Errorf("%w: properties must be an object", ErrUnsupportedSchema)
+	}
+
+	var keys []string
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, fmt.Errorf("typegen: failed to read a key: %w", err)
+		}
+
+		key, _ := tok.(string)
+		keys = append(keys, key)
+
+		var skip json.RawMessage
+		if err := dec.Decode(&skip); err != nil {
+			return nil, fmt.Errorf("typegen: failed to read a value: %w", err)
+		}
+	}
+
+	return keys, nil
+}
@@ -0,0 +1,77 @@
+package typegen_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2/internal/typegen"
+)
+
+// TestGenerate_Drift fails if types_gen.go is not regenerated after schema/kenall.json is changed.
+func TestGenerate_Drift(t *testing.T) {
+	t.Parallel()
+
+	src, err := os.ReadFile("../../schema/kenall.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := os.ReadFile("../../types_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := typegen.Generate(src, "kenall")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(got, want) {
+		t.Error("types_gen.go is out of date, run go generate")
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		want      string
+		wantError error
+	}{
+		"Types": {
+			give: `{"definitions":{"Foo":{"description":"A Foo is foo.","type":"object","properties":{"user_id":{"type":"string"},"count":{"type":"integer"},"note":{"type":["string","null"]},"ok":{"type":"boolean"}}}}}`,
+			want: "// Code generated by typegen from schema/kenall.json. DO NOT EDIT.\n\npackage foo\n\n// A Foo is foo.\ntype Foo struct {\n\tUserID string     `json:\"user_id\"`\n\tCount  int        `json:\"count\"`\n\tNote   NullString `json:\"note\"`\n\tOk     bool       `json:\"ok\"`\n}\n",
+		},
+		"Multiline description": {
+			give: `{"definitions":{"Foo":{"description":"A Foo is foo\nand bar.","type":"object","properties":{"id":{"type":"string"}}}}}`,
+			want: "// Code generated by typegen from schema/kenall.json. DO NOT EDIT.\n\npackage foo\n\n// A Foo is foo\n// and bar.\ntype Foo struct {\n\tID string `json:\"id\"`\n}\n",
+		},
+		"Unsupported type": {
+			give:      `{"definitions":{"Foo":{"type":"object","properties":{"bar":{"type":"array"}}}}}`,
+			wantError: typegen.ErrUnsupportedSchema,
+		},
+		"Not an object": {
+			give:      `{"definitions":{"Foo":{"type":"string"}}}`,
+			wantError: typegen.ErrUnsupportedSchema,
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := typegen.Generate([]byte(c.give), "foo")
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if err == nil && string(got) != c.want {
+				t.Errorf("give: %q, want: %q", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package kenall
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// invoiceRegistrationPrefix is the prefix of the registration number of qualified invoice issuers,
+// which is followed by 13 digits, the corporate number for corporations.
+const invoiceRegistrationPrefix = "T"
+
+// A GetInvoiceIssuerResponse is a result from the kenall service of the API to get the qualified invoice issuer
+// from the registration number.
+type GetInvoiceIssuerResponse struct {
+	ResponseMeta `json:"-"`
+
+	Version       Version        `json:"version"`
+	InvoiceIssuer *InvoiceIssuer `json:"data"`
+}
+
+// IsCanceled reports whether the registration of the issuer is canceled or expired.
+func (ii *InvoiceIssuer) IsCanceled() bool {
+	return (ii.DisposalDate.Valid && ii.DisposalDate.String != "") || (ii.ExpireDate.Valid && ii.ExpireDate.String != "")
+}
+
+// GetInvoiceIssuer requests to the kenall service to get the qualified invoice issuer by the registration number,
+// "T" followed by 13 digits.
+func (cli *Client) GetInvoiceIssuer(
+	ctx context.Context, registrationNumber string, opts ...RequestOption,
+) (*GetInvoiceIssuerResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
+	if len(registrationNumber) != len(invoiceRegistrationPrefix)+corporateNumberLength ||
+		registrationNumber[:len(invoiceRegistrationPrefix)] != invoiceRegistrationPrefix ||
+		!isDigits(registrationNumber[len(invoiceRegistrationPrefix):], corporateNumberLength) {
+		return nil, ErrInvalidArgument
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/invoice/"+registrationNumber, nil)
+	if err != nil {
+		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
+	}
+
+	var res GetInvoiceIssuerResponse
+	if err := cli.sendRequest("GetInvoiceIssuer", req, &res); err != nil {
+		return nil, fmt.Errorf(errFailedRequestFormat, err)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,56 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_GetInvoiceIssuer(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		registrationNumber string
+		wantError          error
+		wantName           string
+	}{
+		"Normal case":          {registrationNumber: "T2021001052596", wantError: nil, wantName: "株式会社オープンコレクター"},
+		"Without the prefix":   {registrationNumber: "2021001052596", wantError: kenall.ErrInvalidArgument},
+		"Lowercase prefix":     {registrationNumber: "t2021001052596", wantError: kenall.ErrInvalidArgument},
+		"Short number":         {registrationNumber: "T202100105259", wantError: kenall.ErrInvalidArgument},
+		"Non-digit characters": {registrationNumber: "T20210010525AB", wantError: kenall.ErrInvalidArgument},
+		"Not found":            {registrationNumber: "T0000000000000", wantError: kenall.ErrNotFound},
+	}
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := cli.GetInvoiceIssuer(context.Background(), c.registrationNumber)
+			if !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if res == nil {
+				return
+			}
+			if res.InvoiceIssuer.Name != c.wantName {
+				t.Errorf("give: %v, want: %v", res.InvoiceIssuer.Name, c.wantName)
+			}
+			if res.InvoiceIssuer.IsCanceled() {
+				t.Error("the issuer should not be canceled")
+			}
+		})
+	}
+}
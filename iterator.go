@@ -0,0 +1,255 @@
+package kenall
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+var errUnexpectedToken = errors.New("kenall: unexpected token of the response") //nolint: gochecknoglobals
+
+// A CityIterator iterates the cities of a prefecture while decoding the response incrementally,
+// so the whole response is not buffered into memory.
+//
+//	it := cli.Cities(ctx, "13")
+//	defer it.Close()
+//	for it.Next() {
+//		city := it.City()
+//	}
+//	if err := it.Err(); err != nil {
+//		...
+//	}
+type CityIterator struct {
+	body    io.ReadCloser
+	dec     *json.Decoder
+	hooks   func(*City)
+	version Version
+	city    *City
+	inData  bool
+	done    bool
+	err     error
+}
+
+// Cities requests to the kenall service to get the cities by prefecture code and returns the iterator of them.
+// The responses of the iterator are neither cached nor observed, but the rate limit and the retry policy
+// are applied until the response starts.
+func (cli *Client) Cities(ctx context.Context, prefectureCode string) *CityIterator {
+	if _, err := strconv.Atoi(prefectureCode); err != nil || len(prefectureCode) != 2 {
+		return &CityIterator{done: true, err: ErrInvalidArgument}
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, cli.Endpoint+"/cities/"+prefectureCode, nil)
+	if err != nil {
+		return &CityIterator{done: true, err: fmt.Errorf(errFailedGenerateRequestFormat, err)}
+	}
+
+	if err := cli.authorize(req); err != nil {
+		return &CityIterator{done: true, err: fmt.Errorf(errFailedRequestFormat, err)}
+	}
+
+	applyRequestOptions(req)
+
+	resp, err := cli.openStream(req)
+	if err != nil {
+		return &CityIterator{done: true, err: fmt.Errorf(errFailedRequestFormat, err)}
+	}
+
+	return &CityIterator{
+		body: resp.Body,
+		dec:  json.NewDecoder(resp.Body),
+		hooks: func(c *City) {
+			res := &GetCityResponse{Cities: []*City{c}}
+			cli.kanaScript.apply(res)
+			cli.hooks.apply(res)
+		},
+	}
+}
+
+// Next decodes the next city, it returns false at the end of the cities or on an error.
+func (it *CityIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	if err := it.seekData(); err != nil {
+		return it.fail(err)
+	}
+
+	if !it.dec.More() {
+		if err := it.finish(); err != nil {
+			return it.fail(err)
+		}
+
+		return false
+	}
+
+	var c City
+	if err := it.dec.Decode(&c); err != nil {
+		return it.fail(fmt.Errorf("kenall: failed to decode to response: %w", err))
+	}
+
+	it.hooks(&c)
+	it.city = &c
+
+	return true
+}
+
+// City returns the city decoded by the last call of Next.
+func (it *CityIterator) City() *City {
+	return it.city
+}
+
+// Version returns the version of the response. It is the zero value until the version is decoded,
+// which is usually before the first city.
+func (it *CityIterator) Version() Version {
+	return it.version
+}
+
+// Err returns the error stopped the iteration.
+func (it *CityIterator) Err() error {
+	return it.err
+}
+
+// Close closes the response, it is safe to be called multiple times.
+func (it *CityIterator) Close() error {
+	it.done = true
+	if it.body == nil {
+		return nil
+	}
+
+	_, _ = io.Copy(io.Discard, it.body)
+	err := it.body.Close()
+	it.body = nil
+
+	if err != nil {
+		return fmt.Errorf("kenall: failed to close the response: %w", err)
+	}
+
+	return nil
+}
+
+func (it *CityIterator) fail(err error) bool {
+	it.err = err
+	it.city = nil
+	_ = it.Close()
+
+	return false
+}
+
+// seekData reads the tokens until the beginning of the data array.
+func (it *CityIterator) seekData() error {
+	if it.inData {
+		return nil
+	}
+
+	if err := expectDelim(it.dec, '{'); err != nil {
+		return err
+	}
+
+	found, err := it.readFields()
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%w: no data", errUnexpectedToken)
+	}
+
+	it.inData = true
+
+	return nil
+}
+
+// finish reads the tokens after the data array to the end of the response.
+func (it *CityIterator) finish() error {
+	if err := expectDelim(it.dec, ']'); err != nil {
+		return err
+	}
+
+	if _, err := it.readFields(); err != nil {
+		return err
+	}
+
+	it.city = nil
+
+	return it.Close()
+}
+
+// readFields reads the fields of the response object until the data array begins or the object ends,
+// it returns true if the data array begins.
+func (it *CityIterator) readFields() (bool, error) {
+	for it.dec.More() {
+		tok, err := it.dec.Token()
+		if err != nil {
+			return false, fmt.Errorf("kenall: failed to decode to response: %w", err)
+		}
+
+		switch tok {
+		case "data":
+			return true, expectDelim(it.dec, '[')
+		case "version":
+			if err := it.dec.Decode(&it.version); err != nil {
+				return false, fmt.Errorf("kenall: failed to decode to response: %w", err)
+			}
+		default:
+			var skip json.RawMessage
+			if err := it.dec.Decode(&skip); err != nil {
+				return false, fmt.Errorf("kenall: failed to decode to response: %w", err)
+			}
+		}
+	}
+
+	return false, expectDelim(it.dec, '}')
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return fmt.Errorf("kenall: failed to decode to response: %w", err)
+	}
+
+	if d, ok := tok.(json.Delim); !ok || d != want {
+		return fmt.Errorf("%w: %v", errUnexpectedToken, tok)
+	}
+
+	return nil
+}
+
+// openStream sends the request with the rate limit and the retry policy and returns the successful response
+// without reading the body.
+func (cli *Client) openStream(req *http.Request) (*http.Response, error) {
+	family := endpointFamilyOf(cli.Endpoint, req.URL)
+
+	for attempt := 0; ; attempt++ {
+		if err := cli.waitRateLimit(req.Context(), family); err != nil {
+			return nil, fmt.Errorf("kenall: failed to wait for the rate limit: %w", err)
+		}
+
+		resp, err := cli.roundTrip(req)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) || isTimeoutError(err) {
+				err = ErrTimeout(err)
+			} else {
+				err = fmt.Errorf("kenall: failed to do http client with a request for kenall service: %w", err)
+			}
+		} else if resp.StatusCode != http.StatusOK {
+			err = newAPIError(req, resp, cli.clock.Now())
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		} else {
+			return resp, nil
+		}
+
+		if attempt >= cli.retryPolicy.MaxRetries || !isRetryableError(req.Context(), err) {
+			return nil, err
+		}
+
+		if werr := cli.clock.Sleep(req.Context(), cli.retryPolicy.backoff(attempt)); werr != nil {
+			return nil, err
+		}
+	}
+}
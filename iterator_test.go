@@ -0,0 +1,72 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_Cities(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	want, err := cli.GetCity(ctx, "13")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		prefectureCode string
+		want           []*kenall.City
+		wantErr        error
+	}{
+		"Normal case":       {prefectureCode: "13", want: want.Cities},
+		"Invalid case":      {prefectureCode: "alphabet", wantErr: kenall.ErrInvalidArgument},
+		"Not found case":    {prefectureCode: "48", wantErr: kenall.ErrNotFound},
+		"Wrong length case": {prefectureCode: "013", wantErr: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			it := cli.Cities(ctx, c.prefectureCode)
+			t.Cleanup(func() { _ = it.Close() })
+
+			var give []*kenall.City
+			for it.Next() {
+				give = append(give, it.City())
+			}
+
+			if !errors.Is(it.Err(), c.wantErr) {
+				t.Fatalf("give: %v, want: %v", it.Err(), c.wantErr)
+			}
+
+			if !reflect.DeepEqual(give, c.want) {
+				t.Errorf("give: %d cities, want: %d cities", len(give), len(c.want))
+			}
+
+			if c.wantErr == nil && time.Time(it.Version()) != time.Time(want.Version) {
+				t.Errorf("give: %v, want: %v", it.Version(), want.Version)
+			}
+
+			if it.Next() {
+				t.Error("Next should return false after the end")
+			}
+		})
+	}
+}
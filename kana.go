@@ -0,0 +1,262 @@
+package kenall
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// KanaScript values.
+const (
+	// KanaScriptAsIs keeps kana fields as the kenall service returns.
+	KanaScriptAsIs KanaScript = iota
+	// KanaScriptKatakana converts kana fields to full-width katakana.
+	KanaScriptKatakana
+	// KanaScriptHiragana converts kana fields to hiragana.
+	KanaScriptHiragana
+	// KanaScriptNormalized normalizes kana fields with kenall.NormalizeKana.
+	KanaScriptNormalized
+)
+
+const (
+	halfWidthKanaFirst = '｡'
+	halfWidthKanaLast  = 'ﾟ'
+	halfWidthDakuten   = 'ﾞ'
+	halfWidthHandaku   = 'ﾟ'
+	katakanaFirst      = 'ァ'
+	katakanaLast       = 'ヶ'
+	hiraganaFirst      = 'ぁ'
+	hiraganaLast       = 'ゖ'
+	kanaOffset         = katakanaFirst - hiraganaFirst
+	ideographicSpace   = '　'
+	dakutenKana        = "カキクケコサシスセソタチツテトハヒフヘホ"
+	handakuKana        = "ハヒフヘホ"
+)
+
+type (
+	// A KanaScript is a script of kana fields in responses, see kenall.WithKanaScript.
+	KanaScript int
+
+	kanaFielder interface {
+		kanaFields() []*string
+	}
+)
+
+var (
+	// fullWidthKana is full-width characters for the half-width katakana block from U+FF61 to U+FF9F.
+	//nolint: gochecknoglobals
+	fullWidthKana = []rune("。「」、・ヲァィゥェォャュョッーアイウエオカキクケコサシスセソタチツテトナニヌネノハヒフヘホマミムメモヤユヨラリルレロワン゛゜")
+	// halfWidthKana is the reverse of fullWidthKana.
+	halfWidthKana = func() map[rune]rune { //nolint: gochecknoglobals
+		m := make(map[rune]rune, len(fullWidthKana))
+		for i, r := range fullWidthKana {
+			m[r] = halfWidthKanaFirst + rune(i)
+		}
+
+		return m
+	}()
+
+	_ kanaFielder = (*GetAddressResponse)(nil)
+	_ kanaFielder = (*GetCityResponse)(nil)
+	_ kanaFielder = (*GetCorporationResponse)(nil)
+	_ kanaFielder = (*GetNormalizeAddressResponse)(nil)
+)
+
+// convert converts the string to the script.
+func (ks KanaScript) convert(s string) string {
+	switch ks {
+	case KanaScriptKatakana:
+		return hiraganaToKatakana(halfWidthToFullWidthKana(s))
+	case KanaScriptHiragana:
+		return katakanaToHiragana(halfWidthToFullWidthKana(s))
+	case KanaScriptNormalized:
+		return NormalizeKana(s)
+	case KanaScriptAsIs:
+		return s
+	default:
+		return s
+	}
+}
+
+func (ks KanaScript) apply(res interface{}) {
+	kf, ok := res.(kanaFielder)
+	if !ok || ks == KanaScriptAsIs {
+		return
+	}
+
+	for _, f := range kf.kanaFields() {
+		*f = ks.convert(*f)
+	}
+}
+
+// NormalizeKana normalizes the width of the string as NFKC does for Japanese text, e.g. in the kana fields:
+// half-width katakana to full-width, full-width alphanumerics and symbols to ASCII, and whitespace
+// to a single space without leading and trailing ones.
+//
+//	kenall.NormalizeKana("ﾁﾂｿ ｶﾌﾞｼｷｶﾞｲｼﾔ") // "チツソ カブシキガイシヤ"
+func NormalizeKana(s string) string {
+	s = strings.Map(func(r rune) rune {
+		switch {
+		case r >= fullWidthASCIIFirst && r <= fullWidthASCIILast:
+			return r - fullWidthASCIIOffset
+		case r == ideographicSpace:
+			return ' '
+		default:
+			return r
+		}
+	}, halfWidthToFullWidthKana(s))
+
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// ToFullWidth converts half-width katakana, ASCII characters and spaces in the string to full-width.
+func ToFullWidth(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r > ' ' && r <= '~':
+			return r + fullWidthASCIIOffset
+		case r == ' ':
+			return ideographicSpace
+		default:
+			return r
+		}
+	}, halfWidthToFullWidthKana(s))
+}
+
+// ToHalfWidth converts full-width katakana, alphanumerics, symbols and spaces in the string to half-width,
+// a voiced katakana is decomposed into the half-width katakana and the sound mark, e.g. "ガ" to "ｶﾞ".
+// Characters without half-width forms, e.g. hiragana, are kept.
+func ToHalfWidth(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for _, r := range s {
+		switch {
+		case r >= fullWidthASCIIFirst && r <= fullWidthASCIILast:
+			b.WriteRune(r - fullWidthASCIIOffset)
+		case r == ideographicSpace:
+			b.WriteByte(' ')
+		case r == 'ヴ':
+			b.WriteString("ｳﾞ")
+		case strings.ContainsRune(dakutenKana, r-1):
+			b.WriteRune(halfWidthKana[r-1])
+			b.WriteRune(halfWidthDakuten)
+		case strings.ContainsRune(handakuKana, r-2):
+			b.WriteRune(halfWidthKana[r-2])
+			b.WriteRune(halfWidthHandaku)
+		default:
+			if h, ok := halfWidthKana[r]; ok {
+				r = h
+			}
+
+			b.WriteRune(r)
+		}
+	}
+
+	return b.String()
+}
+
+func halfWidthToFullWidthKana(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+
+	for i, w := 0, 0; i < len(s); i += w {
+		var r rune
+		r, w = utf8.DecodeRuneInString(s[i:])
+
+		if r >= halfWidthKanaFirst && r <= halfWidthKanaLast {
+			r = fullWidthKana[r-halfWidthKanaFirst]
+		}
+
+		switch next, nw := utf8.DecodeRuneInString(s[i+w:]); {
+		case next == halfWidthDakuten && r == 'ウ':
+			r, w = 'ヴ', w+nw
+		case next == halfWidthDakuten && strings.ContainsRune(dakutenKana, r):
+			r, w = r+1, w+nw
+		case next == halfWidthHandaku && strings.ContainsRune(handakuKana, r):
+			r, w = r+2, w+nw
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+func katakanaToHiragana(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= katakanaFirst && r <= katakanaLast {
+			return r - kanaOffset
+		}
+
+		return r
+	}, s)
+}
+
+func hiraganaToKatakana(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= hiraganaFirst && r <= hiraganaLast {
+			return r + kanaOffset
+		}
+
+		return r
+	}, s)
+}
+
+func (a *Address) kanaFields() []*string {
+	return []*string{&a.PrefectureKana, &a.CityKana, &a.TownKana, &a.TownKanaRaw, &a.Corporation.NameKana}
+}
+
+func (c *City) kanaFields() []*string {
+	return []*string{&c.PrefectureKana, &c.CityKana}
+}
+
+func (c *Corporation) kanaFields() []*string {
+	return []*string{&c.Furigana}
+}
+
+func (r *GetAddressResponse) kanaFields() []*string {
+	fields := make([]*string, 0, len(r.Addresses)*5) //nolint: gomnd
+	for _, a := range r.Addresses {
+		if a == nil {
+			continue
+		}
+
+		fields = append(fields, a.kanaFields()...)
+	}
+
+	return fields
+}
+
+func (r *GetCityResponse) kanaFields() []*string {
+	fields := make([]*string, 0, len(r.Cities)*2) //nolint: gomnd
+	for _, c := range r.Cities {
+		if c == nil {
+			continue
+		}
+
+		fields = append(fields, c.kanaFields()...)
+	}
+
+	return fields
+}
+
+func (r *GetCorporationResponse) kanaFields() []*string {
+	if r.Corporation == nil {
+		return nil
+	}
+
+	return r.Corporation.kanaFields()
+}
+
+func (r *GetNormalizeAddressResponse) kanaFields() []*string {
+	fields := []*string{&r.Query.PrefectureKana.String, &r.Query.CityKana.String, &r.Query.TownKana.String}
+	for _, a := range r.Addresses {
+		if a == nil {
+			continue
+		}
+
+		fields = append(fields, a.kanaFields()...)
+	}
+
+	return fields
+}
@@ -0,0 +1,161 @@
+package kenall_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithKanaScript_Apply(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := `{"version":"2021-06-30","data":[{"prefecture_kana":"ﾄｳｷｮｳﾄ","city_kana":"ちよだく","town_kana":"ﾊﾟﾚｽｶﾞｰﾃﾞﾝ","town_kana_raw":"ｳﾞｨﾗ(ﾆｶｲ)","corporation":{"name_kana":"ｶﾌﾞｼｷｶﾞｲｼｬ"}}]}`
+		if _, err := w.Write([]byte(body)); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		script kenall.KanaScript
+		want   []string
+	}{
+		"As is":      {script: kenall.KanaScriptAsIs, want: []string{"ﾄｳｷｮｳﾄ", "ちよだく", "ﾊﾟﾚｽｶﾞｰﾃﾞﾝ", "ｳﾞｨﾗ(ﾆｶｲ)", "ｶﾌﾞｼｷｶﾞｲｼｬ"}},
+		"Katakana":   {script: kenall.KanaScriptKatakana, want: []string{"トウキョウト", "チヨダク", "パレスガーデン", "ヴィラ(ニカイ)", "カブシキガイシャ"}},
+		"Hiragana":   {script: kenall.KanaScriptHiragana, want: []string{"とうきょうと", "ちよだく", "ぱれすがーでん", "ゔぃら(にかい)", "かぶしきがいしゃ"}},
+		"Normalized": {script: kenall.KanaScriptNormalized, want: []string{"トウキョウト", "ちよだく", "パレスガーデン", "ヴィラ(ニカイ)", "カブシキガイシャ"}},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithKanaScript(c.script))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := cli.GetAddress(context.Background(), "1000001")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			addr := res.Addresses[0]
+			for i, got := range []string{addr.PrefectureKana, addr.CityKana, addr.TownKana, addr.TownKanaRaw, addr.Corporation.NameKana} {
+				if got != c.want[i] {
+					t.Errorf("give: %v, want: %v", got, c.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestWithKanaScript_NullElements(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := w.Write([]byte(`{"version":"2021-06-30","data":[null]}`)); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL),
+		kenall.WithKanaScript(kenall.KanaScriptKatakana))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetAddress(context.Background(), "1000001"); err != nil {
+		t.Error(err)
+	}
+	if _, err := cli.GetCity(context.Background(), "13"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestNormalizeKana(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give string
+		want string
+	}{
+		"Half-width katakana": {give: "ﾁﾂｿ ｶﾌﾞｼｷｶﾞｲｼﾔ", want: "チツソ カブシキガイシヤ"},
+		"Full-width ASCII":    {give: "ＮＴＴ　ﾃﾞｰﾀ（ｶ）", want: "NTT データ(カ)"},
+		"Spaces":              {give: "  ｱｲ 　 ｳｴ ", want: "アイ ウエ"},
+		"Hiragana":            {give: "ひらがな", want: "ひらがな"},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := kenall.NormalizeKana(c.give); got != c.want {
+				t.Errorf("give: %q, want: %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestToFullWidth(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give string
+		want string
+	}{
+		"Katakana":    {give: "ﾊﾟﾚｽｶﾞｰﾃﾞﾝ", want: "パレスガーデン"},
+		"ASCII":       {give: "ABC 123", want: "ＡＢＣ　１２３"},
+		"Punctuation": {give: "｢ｳﾞｨﾗ｣･", want: "「ヴィラ」・"},
+		"Kanji":       {give: "東京都", want: "東京都"},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := kenall.ToFullWidth(c.give); got != c.want {
+				t.Errorf("give: %q, want: %q", got, c.want)
+			}
+		})
+	}
+}
+
+func TestToHalfWidth(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give string
+		want string
+	}{
+		"Katakana":   {give: "パレスガーデン", want: "ﾊﾟﾚｽｶﾞｰﾃﾞﾝ"},
+		"Vu":         {give: "ヴィラ", want: "ｳﾞｨﾗ"},
+		"ASCII":      {give: "ＡＢＣ　１２３", want: "ABC 123"},
+		"Hiragana":   {give: "がっこう", want: "がっこう"},
+		"No half":    {give: "ヵヶヮ", want: "ヵヶヮ"},
+		"Round trip": {give: kenall.ToFullWidth("ｼﾞｬﾊﾟﾝ ﾎﾟｽﾄ"), want: "ｼﾞｬﾊﾟﾝ ﾎﾟｽﾄ"},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := kenall.ToHalfWidth(c.give); got != c.want {
+				t.Errorf("give: %q, want: %q", got, c.want)
+			}
+		})
+	}
+}
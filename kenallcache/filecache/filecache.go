@@ -0,0 +1,195 @@
+// Package filecache provides kenall.Cache storing the responses on disk, so that CLI tools and batch jobs
+// reuse them across restarts without requesting to the kenall service again.
+package filecache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+const (
+	// ext is the extension of the cache files.
+	ext = ".gz"
+	// headerSize is the size of the expiration time in Unix nanoseconds written before the value.
+	headerSize = 8
+	// maxValueSize limits the decompressed size of a cache file.
+	maxValueSize = 64 << 20
+)
+
+// A Cache is kenall.Cache storing each value as a gzip compressed file in the directory with the TTL.
+// It is safe for concurrent use by multiple processes, a value is replaced atomically by renaming the file.
+type Cache struct {
+	dir string
+	ttl time.Duration
+	now func() time.Time
+}
+
+var _ kenall.Cache = (*Cache)(nil)
+
+// New creates filecache.Cache in the directory, the directory is created if it does not exist.
+// A zero ttl means no expiration.
+func New(dir string, ttl time.Duration) (*Cache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("filecache: failed to create the directory: %w", err)
+	}
+
+	return &Cache{dir: dir, ttl: ttl, now: time.Now}, nil
+}
+
+// Get implements kenall.Cache interface, an expired or broken file is removed.
+func (c *Cache) Get(_ context.Context, key string) ([]byte, bool) {
+	name := c.path(key)
+
+	value, expiresAt, err := readFile(name)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			_ = os.Remove(name)
+		}
+
+		return nil, false
+	}
+
+	if !expiresAt.IsZero() && !c.now().Before(expiresAt) {
+		_ = os.Remove(name)
+
+		return nil, false
+	}
+
+	return value, true
+}
+
+// Set implements kenall.Cache interface, the value is dropped silently if it cannot be written.
+func (c *Cache) Set(_ context.Context, key string, value []byte) {
+	var expiresAt time.Time
+	if c.ttl > 0 {
+		expiresAt = c.now().Add(c.ttl)
+	}
+
+	_ = writeFile(c.dir, c.path(key), value, expiresAt)
+}
+
+// Prune removes the expired and broken files in the directory.
+func (c *Cache) Prune() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return fmt.Errorf("filecache: failed to read the directory: %w", err)
+	}
+
+	now := c.now()
+
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ext) {
+			continue
+		}
+
+		name := filepath.Join(c.dir, e.Name())
+		if _, expiresAt, err := readFile(name); err == nil && (expiresAt.IsZero() || now.Before(expiresAt)) {
+			continue
+		}
+
+		if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("filecache: failed to remove the file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// path returns the file of the key, the key is hashed since it is a URL.
+func (c *Cache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+ext)
+}
+
+func readFile(name string) ([]byte, time.Time, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("filecache: failed to open the file: %w", err)
+	}
+	defer f.Close()
+
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("filecache: failed to decompress the file: %w", err)
+	}
+	defer zr.Close()
+
+	b, err := io.ReadAll(io.LimitReader(zr, maxValueSize))
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("filecache: failed to decompress the file: %w", err)
+	}
+
+	if len(b) < headerSize {
+		return nil, time.Time{}, fmt.Errorf("filecache: the file is too short: %w", io.ErrUnexpectedEOF)
+	}
+
+	var expiresAt time.Time
+	if n := int64(binary.BigEndian.Uint64(b[:headerSize])); n != 0 {
+		expiresAt = time.Unix(0, n)
+	}
+
+	return b[headerSize:], expiresAt, nil
+}
+
+func writeFile(dir, name string, value []byte, expiresAt time.Time) error {
+	var header [headerSize]byte
+	if !expiresAt.IsZero() {
+		binary.BigEndian.PutUint64(header[:], uint64(expiresAt.UnixNano()))
+	}
+
+	var buf bytes.Buffer
+
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(header[:]); err != nil {
+		return fmt.Errorf("filecache: failed to compress the value: %w", err)
+	}
+
+	if _, err := zw.Write(value); err != nil {
+		return fmt.Errorf("filecache: failed to compress the value: %w", err)
+	}
+
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("filecache: failed to compress the value: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, ".tmp-*")
+	if err != nil {
+		return fmt.Errorf("filecache: failed to create the file: %w", err)
+	}
+
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+
+		return fmt.Errorf("filecache: failed to write the file: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return fmt.Errorf("filecache: failed to write the file: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), name); err != nil {
+		_ = os.Remove(tmp.Name())
+
+		return fmt.Errorf("filecache: failed to write the file: %w", err)
+	}
+
+	return nil
+}
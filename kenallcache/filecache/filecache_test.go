@@ -0,0 +1,142 @@
+package filecache_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+	"github.com/osamingo/go-kenall/v2/kenallcache/filecache"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := filepath.Join(t.TempDir(), "kenall")
+
+	c, err := filecache.New(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := c.Get(ctx, "https://api.kenall.jp/v1/postalcode/1008105"); ok {
+		t.Error("an unknown key should not be found")
+	}
+
+	c.Set(ctx, "https://api.kenall.jp/v1/postalcode/1008105", []byte(`{"body":{}}`))
+
+	// NOTE: another cache on the same directory emulates a restarted process.
+	restarted, err := filecache.New(dir, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, ok := restarted.Get(ctx, "https://api.kenall.jp/v1/postalcode/1008105")
+	if !ok || string(got) != `{"body":{}}` {
+		t.Errorf("give: %s, %v, want: %s", got, ok, `{"body":{}}`)
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.gz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Fatalf("give: %v, want: 1 file", files)
+	}
+
+	if err := os.WriteFile(files[0], []byte("broken"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := c.Get(ctx, "https://api.kenall.jp/v1/postalcode/1008105"); ok {
+		t.Error("a broken file should not be found")
+	}
+	if _, err := os.Stat(files[0]); !os.IsNotExist(err) {
+		t.Errorf("a broken file should be removed: %v", err)
+	}
+}
+
+func TestCache_TTL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	c, err := filecache.New(dir, 20*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c.Set(ctx, "a", []byte("1"))
+	c.Set(ctx, "b", []byte("2"))
+
+	if got, ok := c.Get(ctx, "a"); !ok || string(got) != "1" {
+		t.Errorf("give: %s, %v, want: 1", got, ok)
+	}
+
+	time.Sleep(30 * time.Millisecond)
+
+	if _, ok := c.Get(ctx, "a"); ok {
+		t.Error("an expired value should not be found")
+	}
+
+	if err := c.Prune(); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("give: %v, want: no files", files)
+	}
+}
+
+func TestCache_Client(t *testing.T) {
+	t.Parallel()
+
+	body, err := os.ReadFile("../../kenalltest/testdata/addresses.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		_, _ = w.Write(body)
+	}))
+	t.Cleanup(srv.Close)
+
+	dir := t.TempDir()
+
+	for i := 0; i < 2; i++ {
+		c, err := filecache.New(dir, time.Hour)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithCache(c))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		res, err := cli.GetAddress(context.Background(), "1008105")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Addresses) == 0 {
+			t.Error("addresses should not be empty")
+		}
+	}
+
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("give: %v, want: %v", n, 1)
+	}
+}
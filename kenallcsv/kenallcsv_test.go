@@ -0,0 +1,168 @@
+package kenallcsv_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+	"github.com/osamingo/go-kenall/v2/kenallcsv"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	r, err := kenallcsv.Open("testdata/utf_ken_all.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := r.GetAddress(context.Background(), "066-0005")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Addresses) != 1 {
+		t.Fatalf("give: %v, want: %v", len(res.Addresses), 1)
+	}
+
+	a := res.Addresses[0]
+	if a.Town != "協和" || a.TownKana != "キョウワ" || a.PrefectureKana != "ホッカイドウ" || !a.TownPartial {
+		t.Errorf("give: %+v", a)
+	}
+	if want := "協和（８８－２、２７１－１０、３４３－２、４０４－１、４２７－３、４３１－１２、４４３－６、６０８－２、" +
+		"６４１－８、８１４、８４２－５、１１３７－３、１３９２、１６５７、１７５２番地）"; a.TownRaw != want {
+		t.Errorf("give: %v, want: %v", a.TownRaw, want)
+	}
+
+	cases := map[string]struct {
+		give     string
+		wantTown string
+	}{
+		"Area note":  {give: "1000000", wantTown: ""},
+		"Excluded":   {give: "1000004", wantTown: "大手町"},
+		"Other city": {give: "1600023", wantTown: "西新宿"},
+	}
+
+	for name, c := range cases {
+		res, err := r.GetAddress(context.Background(), c.give)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(res.Addresses) != 1 || res.Addresses[0].Town != c.wantTown {
+			t.Errorf("%s: give: %+v, want: %v", name, res.Addresses, c.wantTown)
+		}
+	}
+}
+
+func TestParse_Error(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give string
+		want error
+	}{
+		"Shift_JIS":     {give: "13101,\"100  \",\"1000000\",a,b,c,\"\x93\x8c\x8b\x9e\x93s\",d,e,0,0,0,0,0,0\n", want: kenallcsv.ErrNotUTF8},
+		"Wrong columns": {give: "13101,\"100  \",\"1000000\"\n"},
+	}
+
+	for name, c := range cases {
+		_, err := kenallcsv.Parse(strings.NewReader(c.give))
+		if err == nil || (c.want != nil && !errors.Is(err, c.want)) {
+			t.Errorf("%s: give: %v, want: %v", name, err, c.want)
+		}
+	}
+}
+
+func TestResolver(t *testing.T) {
+	t.Parallel()
+
+	r, err := kenallcsv.Open("testdata/utf_ken_all.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r.Version = kenall.Version(time.Date(2023, 5, 31, 0, 0, 0, 0, time.UTC))
+
+	ctx := context.Background()
+
+	res, err := r.GetAddress(ctx, "9999999")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Addresses) != 0 || res.Version.String() != "2023-05-31" {
+		t.Errorf("give: %+v", res)
+	}
+
+	if _, err := r.GetAddress(ctx, "abc"); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+
+	old, err := r.GetAddressesByOldCode(ctx, "100")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(old.Addresses) != 3 {
+		t.Errorf("give: %v, want: %v", len(old.Addresses), 3)
+	}
+
+	city, err := r.GetAddressesByCityCode(ctx, "131016")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(city.Addresses) != 3 || city.City.City != "千代田区" {
+		t.Errorf("give: %+v", city)
+	}
+
+	if _, err := r.GetAddressesByCityCode(ctx, "13999"); !errors.Is(err, kenall.ErrNotFound) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrNotFound)
+	}
+
+	search, err := r.SearchAddresses(ctx, "ﾁﾖﾀﾞ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if search.Count != 3 {
+		t.Errorf("give: %v, want: %v", search.Count, 3)
+	}
+
+	if _, err := r.SearchAddresses(ctx, "千代田", kenall.WithLimit(1)); !errors.Is(err, kenallcsv.ErrNotSupported) {
+		t.Errorf("give: %v, want: %v", err, kenallcsv.ErrNotSupported)
+	}
+
+	cities, err := r.GetCity(ctx, "13")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cities.Cities) != 2 || cities.Cities[0].City != "千代田区" || cities.Cities[1].CityCode != "104" {
+		t.Errorf("give: %+v", cities.Cities)
+	}
+
+	all, err := r.GetAllCities(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all.Cities) != 3 || all.Cities[0].JISX0402 != "01224" || all.Cities[2].JISX0402 != "13104" {
+		t.Errorf("give: %+v", all.Cities)
+	}
+
+	addrs, err := r.GetAddresses(ctx, []string{"1000004", "abc"})
+	if len(addrs) != 1 || err == nil {
+		t.Errorf("give: %v, %v", addrs, err)
+	}
+
+	got, err := r.GetAddress(ctx, "1000004")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got.Addresses[0].Town = "modified"
+	if again, _ := r.GetAddress(ctx, "1000004"); again.Addresses[0].Town != "大手町" {
+		t.Error("the addresses of the resolver should not be shared")
+	}
+
+	if _, err := r.GetHolidays(ctx); !errors.Is(err, kenallcsv.ErrNotSupported) {
+		t.Errorf("give: %v, want: %v", err, kenallcsv.ErrNotSupported)
+	}
+}
@@ -0,0 +1,149 @@
+// Package kenallcsv resolves postal codes locally from the postal code data of Japan Post, KEN_ALL.CSV,
+// so that tests and air-gapped systems can use kenall.API without the kenall service.
+//
+// The data must be UTF-8 encoded like utf_ken_all.csv. KEN_ALL.CSV is encoded in Shift_JIS,
+// decode it beforehand, e.g. with transform.NewReader(f, japanese.ShiftJIS.NewDecoder()) of golang.org/x/text.
+package kenallcsv
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+// columns is the number of the columns of KEN_ALL.CSV.
+const columns = 15
+
+// The columns of KEN_ALL.CSV, see https://www.post.japanpost.jp/zipcode/dl/readme.html.
+const (
+	colJISX0402 = iota
+	colOldCode
+	colPostalCode
+	colPrefectureKana
+	colCityKana
+	colTownKana
+	colPrefecture
+	colCity
+	colTown
+	colTownPartial
+	colTownAddressedKoaza
+	colTownChome
+	colTownMulti
+)
+
+var (
+	// ErrNotUTF8 is returned when the data is not UTF-8 encoded, e.g. KEN_ALL.CSV not decoded from Shift_JIS.
+	ErrNotUTF8 = errors.New("kenallcsv: the data is not UTF-8 encoded")
+
+	// areaNotes are the town names of KEN_ALL.CSV which are not a part of the address.
+	areaNotes = []string{"以下に掲載がない場合"} //nolint: gochecknoglobals
+)
+
+// Parse parses the rows of KEN_ALL.CSV into addresses in order of the rows, the town names split across
+// the rows are joined into one address.
+func Parse(r io.Reader) ([]*kenall.Address, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = columns
+	cr.ReuseRecord = true
+
+	var (
+		addrs []*kenall.Address
+		open  *kenall.Address
+	)
+
+	for {
+		rec, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("kenallcsv: failed to read the row: %w", err)
+		}
+
+		for _, f := range rec {
+			if !utf8.ValidString(f) {
+				line, _ := cr.FieldPos(0)
+
+				return nil, fmt.Errorf("kenallcsv: line %d: %w", line, ErrNotUTF8)
+			}
+		}
+
+		// NOTE: a long town name is split across the consecutive rows until the parenthesis is closed.
+		if open != nil && open.PostalCode == rec[colPostalCode] {
+			open.TownRaw += rec[colTown]
+			open.TownKanaRaw += rec[colTownKana]
+
+			if isClosed(open.TownRaw) {
+				fillTown(open)
+				open = nil
+			}
+
+			continue
+		}
+
+		a := newAddress(rec)
+		addrs = append(addrs, a)
+
+		if isClosed(a.TownRaw) {
+			fillTown(a)
+			open = nil
+		} else {
+			open = a
+		}
+	}
+
+	if open != nil {
+		fillTown(open)
+	}
+
+	return addrs, nil
+}
+
+func newAddress(rec []string) *kenall.Address {
+	return &kenall.Address{
+		JISX0402:           rec[colJISX0402],
+		OldCode:            strings.TrimSpace(rec[colOldCode]),
+		PostalCode:         rec[colPostalCode],
+		PrefectureKana:     kenall.ToFullWidth(rec[colPrefectureKana]),
+		CityKana:           kenall.ToFullWidth(rec[colCityKana]),
+		TownKanaRaw:        rec[colTownKana],
+		Prefecture:         rec[colPrefecture],
+		City:               rec[colCity],
+		TownRaw:            rec[colTown],
+		TownPartial:        rec[colTownPartial] == "1",
+		TownAddressedKoaza: rec[colTownAddressedKoaza] == "1",
+		TownChome:          rec[colTownChome] == "1",
+		TownMulti:          rec[colTownMulti] == "1",
+	}
+}
+
+// fillTown sets the town names without the notes in parentheses, e.g. "大手町" of "大手町（次のビルを除く）".
+func fillTown(a *kenall.Address) {
+	a.TownKanaRaw = kenall.ToFullWidth(a.TownKanaRaw)
+	a.Town = trimNote(a.TownRaw, "（")
+	a.TownKana = trimNote(a.TownKanaRaw, "（")
+
+	for _, note := range areaNotes {
+		if a.Town == note {
+			a.Town, a.TownKana = "", ""
+		}
+	}
+}
+
+func trimNote(s, open string) string {
+	if i := strings.Index(s, open); i >= 0 {
+		return s[:i]
+	}
+
+	return s
+}
+
+func isClosed(town string) bool {
+	return strings.Count(town, "（") <= strings.Count(town, "）")
+}
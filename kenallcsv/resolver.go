@@ -0,0 +1,392 @@
+package kenallcsv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+// ErrNotSupported is returned by kenallcsv.Resolver for the methods which cannot be resolved from KEN_ALL.CSV.
+var ErrNotSupported = errors.New("kenallcsv: the method is not supported offline")
+
+// A Resolver is kenall.API resolving the addresses and the cities from KEN_ALL.CSV in memory,
+// the other methods return kenallcsv.ErrNotSupported. kenall.RequestOption is ignored since no requests are sent.
+// It is safe for concurrent use.
+type Resolver struct {
+	// Version is the version of the responses, e.g. the date of the data published by Japan Post.
+	Version kenall.Version
+
+	addresses []*kenall.Address
+	byCode    map[string][]*kenall.Address
+	byOldCode map[string][]*kenall.Address
+	byCity    map[string][]*kenall.Address
+	byPref    map[string][]*kenall.City
+}
+
+var _ kenall.API = (*Resolver)(nil)
+
+// NewResolver creates kenallcsv.Resolver from the addresses, e.g. the ones returned by kenallcsv.Parse.
+func NewResolver(addresses []*kenall.Address, version kenall.Version) *Resolver {
+	r := &Resolver{
+		Version:   version,
+		addresses: addresses,
+		byCode:    map[string][]*kenall.Address{},
+		byOldCode: map[string][]*kenall.Address{},
+		byCity:    map[string][]*kenall.Address{},
+		byPref:    map[string][]*kenall.City{},
+	}
+
+	seen := map[string]bool{}
+
+	for _, a := range addresses {
+		r.byCode[a.PostalCode] = append(r.byCode[a.PostalCode], a)
+		r.byOldCode[a.OldCode] = append(r.byOldCode[a.OldCode], a)
+		r.byCity[a.JISX0402] = append(r.byCity[a.JISX0402], a)
+
+		if len(a.JISX0402) < 2 || seen[a.JISX0402] {
+			continue
+		}
+
+		seen[a.JISX0402] = true
+		r.byPref[a.JISX0402[:2]] = append(r.byPref[a.JISX0402[:2]], &kenall.City{
+			JISX0402:       a.JISX0402,
+			PrefectureCode: a.JISX0402[:2],
+			CityCode:       a.JISX0402[2:],
+			PrefectureKana: a.PrefectureKana,
+			CityKana:       a.CityKana,
+			Prefecture:     a.Prefecture,
+			City:           a.City,
+		})
+	}
+
+	for _, cities := range r.byPref {
+		sort.Slice(cities, func(i, j int) bool { return cities[i].JISX0402 < cities[j].JISX0402 })
+	}
+
+	return r
+}
+
+// Open parses the UTF-8 encoded KEN_ALL.CSV file, e.g. utf_ken_all.csv, and creates kenallcsv.Resolver
+// versioned by the modification time of the file.
+func Open(name string) (*Resolver, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("kenallcsv: failed to open the file: %w", err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("kenallcsv: failed to stat the file: %w", err)
+	}
+
+	addrs, err := Parse(f)
+	if err != nil {
+		return nil, err
+	}
+
+	y, m, d := fi.ModTime().Date()
+
+	return NewResolver(addrs, kenall.Version(time.Date(y, m, d, 0, 0, 0, 0, time.UTC))), nil
+}
+
+// GetAddress implements kenall.API interface, it returns no addresses for an unknown postal code
+// like the kenall service.
+func (r *Resolver) GetAddress(
+	ctx context.Context, postalCode string, _ ...kenall.RequestOption,
+) (*kenall.GetAddressResponse, error) {
+	var res kenall.GetAddressResponse
+	if err := r.GetAddressInto(ctx, postalCode, &res); err != nil {
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// GetAddressInto implements kenall.API interface.
+func (r *Resolver) GetAddressInto(
+	ctx context.Context, postalCode string, res *kenall.GetAddressResponse, _ ...kenall.RequestOption,
+) error {
+	if res == nil {
+		return kenall.ErrInvalidArgument
+	}
+
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("kenallcsv: %w", err)
+	}
+
+	code, err := kenall.ParsePostalCode(postalCode)
+	if err != nil {
+		return fmt.Errorf("kenallcsv: %w", err)
+	}
+
+	*res = kenall.GetAddressResponse{Version: r.Version, Addresses: cloneAddresses(r.byCode[code.String()])}
+
+	return nil
+}
+
+// GetAddresses implements kenall.API interface.
+func (r *Resolver) GetAddresses(
+	ctx context.Context, postalCodes []string, _ ...kenall.BatchOption,
+) (map[string]*kenall.GetAddressResponse, error) {
+	results := make(map[string]*kenall.GetAddressResponse, len(postalCodes))
+	errs := map[string]error{}
+
+	for _, code := range postalCodes {
+		res, err := r.GetAddress(ctx, code)
+		if err != nil {
+			errs[code] = err
+
+			continue
+		}
+
+		results[code] = res
+	}
+
+	if len(errs) > 0 {
+		return results, &kenall.BatchError{Errors: errs}
+	}
+
+	return results, nil
+}
+
+// GetAddressesByOldCode implements kenall.API interface.
+func (r *Resolver) GetAddressesByOldCode(
+	ctx context.Context, oldCode string, _ ...kenall.RequestOption,
+) (*kenall.GetAddressesByOldCodeResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("kenallcsv: %w", err)
+	}
+
+	oldCode = strings.ReplaceAll(strings.TrimSpace(oldCode), "-", "")
+	if _, err := strconv.Atoi(oldCode); err != nil || (len(oldCode) != 3 && len(oldCode) != 5) {
+		return nil, kenall.ErrInvalidArgument
+	}
+
+	return &kenall.GetAddressesByOldCodeResponse{
+		Version:   r.Version,
+		Addresses: cloneAddresses(r.byOldCode[oldCode]),
+	}, nil
+}
+
+// GetAddressesByCityCode implements kenall.API interface.
+func (r *Resolver) GetAddressesByCityCode(
+	ctx context.Context, jisx0402 string, _ ...kenall.RequestOption,
+) (*kenall.GetAddressesByCityCodeResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("kenallcsv: %w", err)
+	}
+
+	code, err := kenall.ParseJISX0402(jisx0402)
+	if err != nil {
+		return nil, fmt.Errorf("kenallcsv: %w", err)
+	}
+
+	addrs := r.byCity[code.String()]
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("kenallcsv: %w", kenall.ErrNotFound)
+	}
+
+	var city *kenall.City
+
+	for _, c := range r.byPref[code.PrefectureCode().String()] {
+		if c.JISX0402 == code.String() {
+			city = c.Clone()
+		}
+	}
+
+	return &kenall.GetAddressesByCityCodeResponse{
+		Version:   r.Version,
+		City:      city,
+		Addresses: cloneAddresses(addrs),
+	}, nil
+}
+
+// SearchAddresses implements kenall.API interface, it returns the addresses of which the postal code,
+// the address or the furigana contains the query. The options are not supported.
+func (r *Resolver) SearchAddresses(
+	ctx context.Context, query string, opts ...kenall.SearchOption,
+) (*kenall.SearchAddressesResponse, error) {
+	if len(opts) > 0 {
+		return nil, ErrNotSupported
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("kenallcsv: %w", err)
+	}
+
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, kenall.ErrInvalidArgument
+	}
+
+	kana := kenall.ToFullWidth(query)
+	res := &kenall.SearchAddressesResponse{Version: r.Version}
+
+	for _, a := range r.addresses {
+		if strings.Contains(a.PostalCode, query) ||
+			strings.Contains(a.Prefecture+a.City+a.Town, query) ||
+			strings.Contains(a.PrefectureKana+a.CityKana+a.TownKana, kana) {
+			res.Addresses = append(res.Addresses, a.Clone())
+		}
+	}
+
+	res.Count = len(res.Addresses)
+	res.Limit = len(res.Addresses)
+
+	return res, nil
+}
+
+// GetCity implements kenall.API interface, the cities are derived from the addresses.
+func (r *Resolver) GetCity(
+	ctx context.Context, prefectureCode string, _ ...kenall.RequestOption,
+) (*kenall.GetCityResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("kenallcsv: %w", err)
+	}
+
+	code, err := kenall.ParsePrefectureCode(prefectureCode)
+	if err != nil {
+		return nil, fmt.Errorf("kenallcsv: %w", err)
+	}
+
+	cities := r.byPref[code.String()]
+
+	res := &kenall.GetCityResponse{Version: r.Version, Cities: make([]*kenall.City, 0, len(cities))}
+	for _, c := range cities {
+		res.Cities = append(res.Cities, c.Clone())
+	}
+
+	return res, nil
+}
+
+// GetAllCities implements kenall.API interface, the cities are derived from the addresses.
+func (r *Resolver) GetAllCities(ctx context.Context, _ ...kenall.RequestOption) (*kenall.GetAllCitiesResponse, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("kenallcsv: %w", err)
+	}
+
+	res := &kenall.GetAllCitiesResponse{Version: r.Version}
+	for _, cities := range r.byPref {
+		for _, c := range cities {
+			res.Cities = append(res.Cities, c.Clone())
+		}
+	}
+
+	sort.Slice(res.Cities, func(i, j int) bool { return res.Cities[i].JISX0402 < res.Cities[j].JISX0402 })
+
+	return res, nil
+}
+
+// GetOfficeAddress implements kenall.API interface, it is not supported since KEN_ALL.CSV has no business offices.
+func (r *Resolver) GetOfficeAddress(
+	context.Context, string, ...kenall.RequestOption,
+) (*kenall.GetOfficeAddressResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetNormalizeAddress implements kenall.API interface, it is not supported.
+func (r *Resolver) GetNormalizeAddress(
+	context.Context, string, ...kenall.RequestOption,
+) (*kenall.GetNormalizeAddressResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetCorporation implements kenall.API interface, it is not supported.
+func (r *Resolver) GetCorporation(
+	context.Context, string, ...kenall.CorporationSearchOption,
+) (*kenall.GetCorporationResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// SearchCorporationsByFurigana implements kenall.API interface, it is not supported.
+func (r *Resolver) SearchCorporationsByFurigana(
+	context.Context, string, ...kenall.CorporationSearchOption,
+) (*kenall.SearchCorporationsResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetWhoami implements kenall.API interface, it is not supported.
+func (r *Resolver) GetWhoami(context.Context, ...kenall.RequestOption) (*kenall.GetWhoamiResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetHolidays implements kenall.API interface, it is not supported.
+func (r *Resolver) GetHolidays(context.Context, ...kenall.RequestOption) (*kenall.GetHolidaysResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetHolidaysByYear implements kenall.API interface, it is not supported.
+func (r *Resolver) GetHolidaysByYear(
+	context.Context, int, ...kenall.RequestOption,
+) (*kenall.GetHolidaysResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetHolidaysByPeriod implements kenall.API interface, it is not supported.
+func (r *Resolver) GetHolidaysByPeriod(
+	context.Context, time.Time, time.Time, ...kenall.RequestOption,
+) (*kenall.GetHolidaysResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetBusinessDays implements kenall.API interface, it is not supported.
+func (r *Resolver) GetBusinessDays(
+	context.Context, time.Time, ...kenall.RequestOption,
+) (*kenall.GetBusinessDaysResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetBusinessDaysByPeriod implements kenall.API interface, it is not supported.
+func (r *Resolver) GetBusinessDaysByPeriod(
+	context.Context, time.Time, time.Time, ...kenall.RequestOption,
+) (*kenall.GetBusinessDaysByPeriodResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetBanks implements kenall.API interface, it is not supported.
+func (r *Resolver) GetBanks(context.Context, ...kenall.RequestOption) (*kenall.GetBanksResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetBank implements kenall.API interface, it is not supported.
+func (r *Resolver) GetBank(context.Context, string, ...kenall.RequestOption) (*kenall.GetBankResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetBankBranches implements kenall.API interface, it is not supported.
+func (r *Resolver) GetBankBranches(
+	context.Context, string, ...kenall.RequestOption,
+) (*kenall.GetBankBranchesResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetBankBranch implements kenall.API interface, it is not supported.
+func (r *Resolver) GetBankBranch(
+	context.Context, string, string, ...kenall.RequestOption,
+) (*kenall.GetBankBranchResponse, error) {
+	return nil, ErrNotSupported
+}
+
+// GetInvoiceIssuer implements kenall.API interface, it is not supported.
+func (r *Resolver) GetInvoiceIssuer(
+	context.Context, string, ...kenall.RequestOption,
+) (*kenall.GetInvoiceIssuerResponse, error) {
+	return nil, ErrNotSupported
+}
+
+func cloneAddresses(addrs []*kenall.Address) []*kenall.Address {
+	ret := make([]*kenall.Address, 0, len(addrs))
+	for _, a := range addrs {
+		ret = append(ret, a.Clone())
+	}
+
+	return ret
+}
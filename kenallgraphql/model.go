@@ -0,0 +1,193 @@
+package kenallgraphql
+
+import (
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60) //nolint: gochecknoglobals
+
+type (
+	// An Address is the GraphQL model of kenall.Address.
+	Address struct {
+		JISX0402           string
+		OldCode            string
+		PostalCode         string
+		PrefectureKana     string
+		CityKana           string
+		TownKana           string
+		TownKanaRaw        string
+		Prefecture         string
+		City               string
+		Town               string
+		Koaza              string
+		KyotoStreet        string
+		Building           string
+		Floor              string
+		TownPartial        bool
+		TownAddressedKoaza bool
+		TownChome          bool
+		TownMulti          bool
+		TownRaw            string
+		Corporation        *AddressCorporation
+	}
+	// An AddressCorporation is the GraphQL model of the corporation of kenall.Address.
+	AddressCorporation struct {
+		Name        string
+		NameKana    string
+		BlockLot    string
+		BlockLotNum *string
+		PostOffice  string
+		CodeType    string
+	}
+	// A City is the GraphQL model of kenall.City.
+	City struct {
+		JISX0402       string
+		PrefectureCode string
+		CityCode       string
+		PrefectureKana string
+		CityKana       string
+		Prefecture     string
+		City           string
+	}
+	// A Corporation is the GraphQL model of kenall.Corporation.
+	Corporation struct {
+		PublishedDate            string
+		SequenceNumber           string
+		CorporateNumber          string
+		Process                  string
+		Correct                  string
+		UpdateDate               string
+		ChangeDate               string
+		Name                     string
+		NameImageID              *string
+		Kind                     string
+		PrefectureName           string
+		CityName                 string
+		StreetNumber             string
+		Town                     *string
+		KyotoStreet              *string
+		BlockLotNum              *string
+		Building                 *string
+		FloorRoom                *string
+		AddressImageID           *string
+		JISX0402                 string
+		PostCode                 string
+		AddressOutside           string
+		AddressOutsideImageID    *string
+		CloseDate                *string
+		CloseCause               *string
+		SuccessorCorporateNumber *string
+		ChangeCause              string
+		AssignmentDate           string
+		EnName                   string
+		EnPrefectureName         string
+		EnAddressLine            *string
+		EnAddressOutside         *string
+		Furigana                 string
+		Hihyoji                  string
+	}
+	// A Holiday is the GraphQL model of kenall.Holiday, the date is formatted in Japan Standard Time.
+	Holiday struct {
+		Title string
+		Date  string
+	}
+)
+
+func newAddress(a *kenall.Address) *Address {
+	return &Address{
+		JISX0402:           a.JISX0402,
+		OldCode:            a.OldCode,
+		PostalCode:         a.PostalCode,
+		PrefectureKana:     a.PrefectureKana,
+		CityKana:           a.CityKana,
+		TownKana:           a.TownKana,
+		TownKanaRaw:        a.TownKanaRaw,
+		Prefecture:         a.Prefecture,
+		City:               a.City,
+		Town:               a.Town,
+		Koaza:              a.Koaza,
+		KyotoStreet:        a.KyotoStreet,
+		Building:           a.Building,
+		Floor:              a.Floor,
+		TownPartial:        a.TownPartial,
+		TownAddressedKoaza: a.TownAddressedKoaza,
+		TownChome:          a.TownChome,
+		TownMulti:          a.TownMulti,
+		TownRaw:            a.TownRaw,
+		Corporation: &AddressCorporation{
+			Name:        a.Corporation.Name,
+			NameKana:    a.Corporation.NameKana,
+			BlockLot:    a.Corporation.BlockLot,
+			BlockLotNum: nullable(a.Corporation.BlockLotNum),
+			PostOffice:  a.Corporation.PostOffice,
+			CodeType:    a.Corporation.CodeType.String(),
+		},
+	}
+}
+
+func newCity(c *kenall.City) *City {
+	return &City{
+		JISX0402:       c.JISX0402,
+		PrefectureCode: c.PrefectureCode,
+		CityCode:       c.CityCode,
+		PrefectureKana: c.PrefectureKana,
+		CityKana:       c.CityKana,
+		Prefecture:     c.Prefecture,
+		City:           c.City,
+	}
+}
+
+func newCorporation(c *kenall.Corporation) *Corporation {
+	return &Corporation{
+		PublishedDate:            c.PublishedDate,
+		SequenceNumber:           c.SequenceNumber.String(),
+		CorporateNumber:          c.CorporateNumber,
+		Process:                  c.Process.String(),
+		Correct:                  c.Correct.String(),
+		UpdateDate:               c.UpdateDate,
+		ChangeDate:               c.ChangeDate,
+		Name:                     c.Name,
+		NameImageID:              nullable(c.NameImageID),
+		Kind:                     c.Kind,
+		PrefectureName:           c.PrefectureName,
+		CityName:                 c.CityName,
+		StreetNumber:             c.StreetNumber,
+		Town:                     nullable(c.Town),
+		KyotoStreet:              nullable(c.KyotoStreet),
+		BlockLotNum:              nullable(c.BlockLotNum),
+		Building:                 nullable(c.Building),
+		FloorRoom:                nullable(c.FloorRoom),
+		AddressImageID:           nullable(c.AddressImageID),
+		JISX0402:                 c.JISX0402,
+		PostCode:                 c.PostCode,
+		AddressOutside:           c.AddressOutside,
+		AddressOutsideImageID:    nullable(c.AddressOutsideImageID),
+		CloseDate:                nullable(c.CloseDate),
+		CloseCause:               nullable(c.CloseCause),
+		SuccessorCorporateNumber: nullable(c.SuccessorCorporateNumber),
+		ChangeCause:              c.ChangeCause,
+		AssignmentDate:           c.AssignmentDate,
+		EnName:                   c.EnName,
+		EnPrefectureName:         c.EnPrefectureName,
+		EnAddressLine:            nullable(c.EnAddressLine),
+		EnAddressOutside:         nullable(c.EnAddressOutside),
+		Furigana:                 c.Furigana,
+		Hihyoji:                  c.Hihyoji,
+	}
+}
+
+func newHoliday(h *kenall.Holiday) *Holiday {
+	return &Holiday{Title: h.Title, Date: h.In(jst).Format(kenall.RFC3339DateFormat)}
+}
+
+func nullable(ns kenall.NullString) *string {
+	if !ns.Valid {
+		return nil
+	}
+
+	s := ns.String
+
+	return &s
+}
@@ -0,0 +1,131 @@
+// Package kenallgraphql provides the GraphQL schema of the addresses, the cities, the corporations and the holidays
+// with the resolvers delegating to kenall.API. It does not depend on a GraphQL library, the models and the resolver
+// methods follow the conventions of gqlgen, so a gqlgen gateway can mount them with the schema and the autobind:
+//
+//	# gqlgen.yml
+//	schema:
+//	  - graph/*.graphqls # with a copy of kenallgraphql.Schema
+//	autobind:
+//	  - github.com/osamingo/go-kenall/v2/kenallgraphql
+//
+// and the generated query resolver embedding kenallgraphql.Resolver:
+//
+//	type queryResolver struct{ *kenallgraphql.Resolver }
+package kenallgraphql
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+// Schema is the GraphQL schema of the package.
+//
+//go:embed schema.graphql
+var Schema string
+
+// A Resolver resolves the queries of kenallgraphql.Schema with kenall.API.
+type Resolver struct {
+	api kenall.API
+}
+
+// NewResolver creates kenallgraphql.Resolver delegating to the api, e.g. kenall.Client.
+func NewResolver(api kenall.API) *Resolver {
+	return &Resolver{api: api}
+}
+
+// Addresses resolves the addresses query, it returns no addresses if the postal code is not found.
+func (r *Resolver) Addresses(ctx context.Context, postalCode string) ([]*Address, error) {
+	res, err := r.api.GetAddress(ctx, postalCode)
+	if errors.Is(err, kenall.ErrNotFound) {
+		return []*Address{}, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("kenallgraphql: failed to resolve addresses: %w", err)
+	}
+
+	ret := make([]*Address, 0, len(res.Addresses))
+	for _, a := range res.Addresses {
+		ret = append(ret, newAddress(a))
+	}
+
+	return ret, nil
+}
+
+// Cities resolves the cities query.
+func (r *Resolver) Cities(ctx context.Context, prefectureCode string) ([]*City, error) {
+	res, err := r.api.GetCity(ctx, prefectureCode)
+	if err != nil {
+		return nil, fmt.Errorf("kenallgraphql: failed to resolve cities: %w", err)
+	}
+
+	ret := make([]*City, 0, len(res.Cities))
+	for _, c := range res.Cities {
+		ret = append(ret, newCity(c))
+	}
+
+	return ret, nil
+}
+
+// Corporation resolves the corporation query, it returns nil if the corporate number is not found.
+func (r *Resolver) Corporation(ctx context.Context, corporateNumber string) (*Corporation, error) {
+	res, err := r.api.GetCorporation(ctx, corporateNumber)
+	if errors.Is(err, kenall.ErrNotFound) {
+		return nil, nil //nolint: nilnil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("kenallgraphql: failed to resolve corporation: %w", err)
+	}
+
+	if res.Corporation == nil {
+		return nil, nil //nolint: nilnil
+	}
+
+	return newCorporation(res.Corporation), nil
+}
+
+// Holidays resolves the holidays query. The year and the period of from and to are exclusive,
+// both of from and to are required for the period.
+func (r *Resolver) Holidays(ctx context.Context, year *int, from, to *string) ([]*Holiday, error) {
+	var (
+		res *kenall.GetHolidaysResponse
+		err error
+	)
+
+	switch {
+	case year != nil && (from != nil || to != nil), (from == nil) != (to == nil):
+		return nil, fmt.Errorf("kenallgraphql: failed to resolve holidays: %w", kenall.ErrInvalidArgument)
+	case year != nil:
+		res, err = r.api.GetHolidaysByYear(ctx, *year)
+	case from != nil:
+		var f, t time.Time
+		if f, err = time.ParseInLocation(kenall.RFC3339DateFormat, *from, jst); err != nil {
+			return nil, fmt.Errorf("kenallgraphql: failed to resolve holidays: %w", kenall.ErrInvalidArgument)
+		}
+
+		if t, err = time.ParseInLocation(kenall.RFC3339DateFormat, *to, jst); err != nil {
+			return nil, fmt.Errorf("kenallgraphql: failed to resolve holidays: %w", kenall.ErrInvalidArgument)
+		}
+
+		res, err = r.api.GetHolidaysByPeriod(ctx, f, t)
+	default:
+		res, err = r.api.GetHolidays(ctx)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("kenallgraphql: failed to resolve holidays: %w", err)
+	}
+
+	ret := make([]*Holiday, 0, len(res.Holidays))
+	for _, h := range res.Holidays {
+		ret = append(ret, newHoliday(h))
+	}
+
+	return ret, nil
+}
@@ -0,0 +1,206 @@
+package kenallgraphql_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+	"github.com/osamingo/go-kenall/v2/kenallgraphql"
+	"github.com/osamingo/go-kenall/v2/kenalltest"
+)
+
+func TestSchema(t *testing.T) {
+	t.Parallel()
+
+	for _, want := range []string{"type Query", "addresses(postalCode: String!)", "holidays(year: Int"} {
+		if !strings.Contains(kenallgraphql.Schema, want) {
+			t.Errorf("give: %v, want: %v", kenallgraphql.Schema, want)
+		}
+	}
+}
+
+func TestResolver_Addresses(t *testing.T) {
+	t.Parallel()
+
+	r := kenallgraphql.NewResolver(&kenalltest.FakeClient{
+		GetAddressFunc: func(
+			ctx context.Context, postalCode string, _ ...kenall.RequestOption,
+		) (*kenall.GetAddressResponse, error) {
+			switch postalCode {
+			case "1008105":
+				a := &kenall.Address{PostalCode: "1008105", Prefecture: "東京都", City: "千代田区", Town: "大手町"}
+				a.Corporation.Name = "日本郵便株式会社"
+				a.Corporation.BlockLotNum = kenall.NullString{String: "2-3-1", Valid: true}
+				a.Corporation.CodeType = "0"
+
+				return &kenall.GetAddressResponse{Addresses: []*kenall.Address{a}}, nil
+			case "0000000":
+				return nil, kenall.ErrNotFound
+			default:
+				return nil, kenall.ErrInternalServerError
+			}
+		},
+	})
+
+	blockLotNum := "2-3-1"
+	cases := map[string]struct {
+		give    string
+		want    []*kenallgraphql.Address
+		wantErr error
+	}{
+		"Found": {give: "1008105", want: []*kenallgraphql.Address{{
+			PostalCode: "1008105", Prefecture: "東京都", City: "千代田区", Town: "大手町",
+			Corporation: &kenallgraphql.AddressCorporation{Name: "日本郵便株式会社", BlockLotNum: &blockLotNum, CodeType: "0"},
+		}}},
+		"Not found": {give: "0000000", want: []*kenallgraphql.Address{}},
+		"Error":     {give: "1000000", wantErr: kenall.ErrInternalServerError},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			give, err := r.Addresses(context.Background(), c.give)
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("give: %v, want: %v", err, c.wantErr)
+			}
+
+			if !reflect.DeepEqual(give, c.want) {
+				t.Errorf("give: %+v, want: %+v", give, c.want)
+			}
+		})
+	}
+}
+
+func TestResolver_Cities(t *testing.T) {
+	t.Parallel()
+
+	r := kenallgraphql.NewResolver(&kenalltest.FakeClient{
+		GetCityFunc: func(
+			ctx context.Context, prefectureCode string, _ ...kenall.RequestOption,
+		) (*kenall.GetCityResponse, error) {
+			return &kenall.GetCityResponse{Cities: []*kenall.City{{PrefectureCode: prefectureCode, City: "千代田区"}}}, nil
+		},
+	})
+
+	give, err := r.Cities(context.Background(), "13")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []*kenallgraphql.City{{PrefectureCode: "13", City: "千代田区"}}; !reflect.DeepEqual(give, want) {
+		t.Errorf("give: %+v, want: %+v", give, want)
+	}
+}
+
+func TestResolver_Corporation(t *testing.T) {
+	t.Parallel()
+
+	r := kenallgraphql.NewResolver(&kenalltest.FakeClient{
+		GetCorporationFunc: func(
+			ctx context.Context, corporateNumber string, opts ...kenall.CorporationSearchOption,
+		) (*kenall.GetCorporationResponse, error) {
+			if corporateNumber != "2021001052596" {
+				return nil, kenall.ErrNotFound
+			}
+
+			return &kenall.GetCorporationResponse{Corporation: &kenall.Corporation{
+				CorporateNumber: corporateNumber,
+				SequenceNumber:  "1",
+				Town:            kenall.NullString{String: "麹町", Valid: true},
+			}}, nil
+		},
+	})
+
+	town := "麹町"
+	cases := map[string]struct {
+		give string
+		want *kenallgraphql.Corporation
+	}{
+		"Found":     {give: "2021001052596", want: &kenallgraphql.Corporation{CorporateNumber: "2021001052596", SequenceNumber: "1", Town: &town}},
+		"Not found": {give: "0000000000000", want: nil},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			give, err := r.Corporation(context.Background(), c.give)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(give, c.want) {
+				t.Errorf("give: %+v, want: %+v", give, c.want)
+			}
+		})
+	}
+}
+
+func TestResolver_Holidays(t *testing.T) {
+	t.Parallel()
+
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	res := &kenall.GetHolidaysResponse{Holidays: []*kenall.Holiday{{Title: "元日", Time: time.Date(2022, 1, 1, 0, 0, 0, 0, jst)}}}
+	r := kenallgraphql.NewResolver(&kenalltest.FakeClient{
+		GetHolidaysFunc: func(ctx context.Context, _ ...kenall.RequestOption) (*kenall.GetHolidaysResponse, error) {
+			return res, nil
+		},
+		GetHolidaysByYearFunc: func(
+			ctx context.Context, year int, _ ...kenall.RequestOption,
+		) (*kenall.GetHolidaysResponse, error) {
+			if year != 2022 {
+				return nil, kenall.ErrInvalidArgument
+			}
+
+			return res, nil
+		},
+		GetHolidaysByPeriodFunc: func(
+			ctx context.Context, from, to time.Time, _ ...kenall.RequestOption,
+		) (*kenall.GetHolidaysResponse, error) {
+			if !from.Equal(time.Date(2022, 1, 1, 0, 0, 0, 0, jst)) || !to.Equal(time.Date(2022, 1, 31, 0, 0, 0, 0, jst)) {
+				return nil, kenall.ErrInvalidArgument
+			}
+
+			return res, nil
+		},
+	})
+
+	year, from, to, invalid := 2022, "2022-01-01", "2022-01-31", "2022/01/31"
+	want := []*kenallgraphql.Holiday{{Title: "元日", Date: "2022-01-01"}}
+	cases := map[string]struct {
+		year     *int
+		from, to *string
+		want     []*kenallgraphql.Holiday
+		wantErr  error
+	}{
+		"All":           {want: want},
+		"Year":          {year: &year, want: want},
+		"Period":        {from: &from, to: &to, want: want},
+		"Year and from": {year: &year, from: &from, wantErr: kenall.ErrInvalidArgument},
+		"Only from":     {from: &from, wantErr: kenall.ErrInvalidArgument},
+		"Invalid to":    {from: &from, to: &invalid, wantErr: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			give, err := r.Holidays(context.Background(), c.year, c.from, c.to)
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("give: %v, want: %v", err, c.wantErr)
+			}
+
+			if !reflect.DeepEqual(give, c.want) {
+				t.Errorf("give: %+v, want: %+v", give, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+// Package kenallotel provides OpenTelemetry tracing for kenall.Client.
+//
+//	cli, err := kenall.NewClient(token, kenall.WithCallObserver(kenallotel.Observer()))
+package kenallotel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"github.com/osamingo/go-kenall/v2"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScopeName is the instrumentation scope name of the tracer.
+const ScopeName = "github.com/osamingo/go-kenall/v2/kenallotel"
+
+// Attribute keys of the span.
+const (
+	OperationKey  = attribute.Key("kenall.operation")
+	FamilyKey     = attribute.Key("kenall.endpoint_family")
+	IdentifierKey = attribute.Key("kenall.identifier")
+	RetryCountKey = attribute.Key("kenall.retry_count")
+	CacheHitKey   = attribute.Key("kenall.cache_hit")
+	methodKey     = attribute.Key("http.request.method")
+	pathKey       = attribute.Key("url.path")
+	statusCodeKey = attribute.Key("http.response.status_code")
+)
+
+type (
+	// An Option configures kenallotel.Observer.
+	Option interface {
+		apply(*config)
+	}
+
+	optionFunc func(*config)
+
+	config struct {
+		provider trace.TracerProvider
+		hash     bool
+	}
+)
+
+func (f optionFunc) apply(c *config) {
+	f(c)
+}
+
+// WithTracerProvider specifies the tracer provider, the global one is used by default.
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return optionFunc(func(c *config) {
+		c.provider = tp
+	})
+}
+
+// WithHashedIdentifier records the identifier of the call, e.g. a postal code or a corporate number,
+// and the path including it as a SHA-256 hex digest instead of the raw value.
+func WithHashedIdentifier() Option {
+	return optionFunc(func(c *config) {
+		c.hash = true
+	})
+}
+
+// Observer returns kenall.CallObserver which starts a client span per API call.
+func Observer(opts ...Option) kenall.CallObserver {
+	c := &config{}
+	for _, o := range opts {
+		o.apply(c)
+	}
+
+	if c.provider == nil {
+		c.provider = otel.GetTracerProvider()
+	}
+
+	tracer := c.provider.Tracer(ScopeName)
+
+	return func(ctx context.Context, call *kenall.Call) (context.Context, func(*kenall.CallResult)) {
+		path, id := call.Path, identifier(call.Path)
+		if c.hash && id != "" {
+			id = digest(id)
+			path = path[:len(path)-len(identifier(path))] + id
+		}
+
+		attrs := []attribute.KeyValue{
+			OperationKey.String(call.Operation),
+			FamilyKey.String(string(call.Family)),
+			methodKey.String(call.Method),
+			pathKey.String(path),
+		}
+		if id != "" {
+			attrs = append(attrs, IdentifierKey.String(id))
+		}
+
+		ctx, span := tracer.Start(ctx, "kenall."+call.Operation,
+			trace.WithSpanKind(trace.SpanKindClient),
+			trace.WithAttributes(attrs...),
+		)
+
+		return ctx, func(res *kenall.CallResult) {
+			defer span.End()
+
+			retries := 0
+			if res.Attempts > 1 {
+				retries = res.Attempts - 1
+			}
+
+			span.SetAttributes(RetryCountKey.Int(retries), CacheHitKey.Bool(res.CacheHit))
+			if res.StatusCode != 0 {
+				span.SetAttributes(statusCodeKey.Int(res.StatusCode))
+			}
+
+			if res.Err != nil {
+				span.RecordError(res.Err)
+				span.SetStatus(codes.Error, res.Err.Error())
+			}
+		}
+	}
+}
+
+// identifier returns the last segment of the path if it is not a fixed resource name.
+func identifier(path string) string {
+	seg := path[strings.LastIndex(path, "/")+1:]
+	for _, r := range seg {
+		if r >= '0' && r <= '9' {
+			return seg
+		}
+	}
+
+	return ""
+}
+
+func digest(s string) string {
+	sum := sha256.Sum256([]byte(s))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,111 @@
+package kenallotel_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+	"github.com/osamingo/go-kenall/v2/kenallotel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const addressResponse = `{"version":"2022-11-30","data":[{"jisx0402":"13104","old_code":"160","postal_code":"1600023","prefecture":"東京都","city":"新宿区","town":"西新宿"}]}`
+
+func TestObserver(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/postalcode/1600023" {
+			_, _ = w.Write([]byte(addressResponse))
+
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		opts     []kenallotel.Option
+		code     string
+		wantPath string
+		wantID   string
+		wantCode int
+		wantErr  bool
+	}{
+		"Found": {
+			code: "1600023", wantPath: "/postalcode/1600023", wantID: "1600023", wantCode: http.StatusOK,
+		},
+		"Not found": {
+			code: "1000000", wantPath: "/postalcode/1000000", wantID: "1000000", wantCode: http.StatusNotFound, wantErr: true,
+		},
+		"Hashed": {
+			opts:     []kenallotel.Option{kenallotel.WithHashedIdentifier()},
+			code:     "1600023",
+			wantPath: "/postalcode/99d7108acdb4ccb2d7f62e8ae0ecc94981c57caf10e7ef78f9894365948159ff",
+			wantID:   "99d7108acdb4ccb2d7f62e8ae0ecc94981c57caf10e7ef78f9894365948159ff",
+			wantCode: http.StatusOK,
+		},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := tracetest.NewSpanRecorder()
+			tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+
+			cli, err := kenall.NewClient("opencollector",
+				kenall.WithEndpoint(srv.URL),
+				kenall.WithCallObserver(kenallotel.Observer(append(c.opts, kenallotel.WithTracerProvider(tp))...)),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = cli.GetAddress(context.Background(), c.code)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("give: %v, want error: %v", err, c.wantErr)
+			}
+
+			spans := rec.Ended()
+			if len(spans) != 1 {
+				t.Fatalf("give: %d spans, want: 1", len(spans))
+			}
+
+			span := spans[0]
+			if span.Name() != "kenall.GetAddress" || span.SpanKind() != trace.SpanKindClient {
+				t.Errorf("give: %s %s, want: a client span of kenall.GetAddress", span.Name(), span.SpanKind())
+			}
+
+			if (span.Status().Code == codes.Error) != c.wantErr {
+				t.Errorf("give: %v, want error: %v", span.Status(), c.wantErr)
+			}
+
+			attrs := map[attribute.Key]attribute.Value{}
+			for _, kv := range span.Attributes() {
+				attrs[kv.Key] = kv.Value
+			}
+
+			for k, want := range map[attribute.Key]interface{}{
+				kenallotel.OperationKey:     "GetAddress",
+				kenallotel.FamilyKey:        "postalcode",
+				kenallotel.IdentifierKey:    c.wantID,
+				kenallotel.RetryCountKey:    int64(0),
+				kenallotel.CacheHitKey:      false,
+				"url.path":                  c.wantPath,
+				"http.response.status_code": int64(c.wantCode),
+			} {
+				if give := attrs[k].AsInterface(); give != want {
+					t.Errorf("%s give: %v, want: %v", k, give, want)
+				}
+			}
+		})
+	}
+}
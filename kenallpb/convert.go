@@ -0,0 +1,261 @@
+// Package kenallpb provides the protocol buffers definitions of kenall's core types
+// and the converters between them and the kenall package.
+//
+//	msg := kenallpb.ToProtoAddress(res.Addresses[0])
+package kenallpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative kenall.proto
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+var jst = time.FixedZone("Asia/Tokyo", 9*60*60) //nolint: gochecknoglobals
+
+// ToProtoAddress converts kenall.Address to Address, it returns nil if a is nil.
+func ToProtoAddress(a *kenall.Address) *Address {
+	if a == nil {
+		return nil
+	}
+
+	return &Address{
+		Jisx0402:           a.JISX0402,
+		OldCode:            a.OldCode,
+		PostalCode:         a.PostalCode,
+		PrefectureKana:     a.PrefectureKana,
+		CityKana:           a.CityKana,
+		TownKana:           a.TownKana,
+		TownKanaRaw:        a.TownKanaRaw,
+		Prefecture:         a.Prefecture,
+		City:               a.City,
+		Town:               a.Town,
+		Koaza:              a.Koaza,
+		KyotoStreet:        a.KyotoStreet,
+		Building:           a.Building,
+		Floor:              a.Floor,
+		TownPartial:        a.TownPartial,
+		TownAddressedKoaza: a.TownAddressedKoaza,
+		TownChome:          a.TownChome,
+		TownMulti:          a.TownMulti,
+		TownRaw:            a.TownRaw,
+		Corporation: &Address_Corporation{
+			Name:        a.Corporation.Name,
+			NameKana:    a.Corporation.NameKana,
+			BlockLot:    a.Corporation.BlockLot,
+			BlockLotNum: toOptional(a.Corporation.BlockLotNum),
+			PostOffice:  a.Corporation.PostOffice,
+			CodeType:    a.Corporation.CodeType.String(),
+		},
+	}
+}
+
+// FromProtoAddress converts Address to kenall.Address, it returns nil if m is nil.
+func FromProtoAddress(m *Address) *kenall.Address {
+	if m == nil {
+		return nil
+	}
+
+	a := &kenall.Address{
+		JISX0402:           m.GetJisx0402(),
+		OldCode:            m.GetOldCode(),
+		PostalCode:         m.GetPostalCode(),
+		PrefectureKana:     m.GetPrefectureKana(),
+		CityKana:           m.GetCityKana(),
+		TownKana:           m.GetTownKana(),
+		TownKanaRaw:        m.GetTownKanaRaw(),
+		Prefecture:         m.GetPrefecture(),
+		City:               m.GetCity(),
+		Town:               m.GetTown(),
+		Koaza:              m.GetKoaza(),
+		KyotoStreet:        m.GetKyotoStreet(),
+		Building:           m.GetBuilding(),
+		Floor:              m.GetFloor(),
+		TownPartial:        m.GetTownPartial(),
+		TownAddressedKoaza: m.GetTownAddressedKoaza(),
+		TownChome:          m.GetTownChome(),
+		TownMulti:          m.GetTownMulti(),
+		TownRaw:            m.GetTownRaw(),
+	}
+
+	c := m.GetCorporation()
+	a.Corporation.Name = c.GetName()
+	a.Corporation.NameKana = c.GetNameKana()
+	a.Corporation.BlockLot = c.GetBlockLot()
+	a.Corporation.BlockLotNum = fromOptional(c.BlockLotNum)
+	a.Corporation.PostOffice = c.GetPostOffice()
+	a.Corporation.CodeType = json.Number(c.GetCodeType())
+
+	return a
+}
+
+// ToProtoCity converts kenall.City to City, it returns nil if c is nil.
+func ToProtoCity(c *kenall.City) *City {
+	if c == nil {
+		return nil
+	}
+
+	return &City{
+		Jisx0402:       c.JISX0402,
+		PrefectureCode: c.PrefectureCode,
+		CityCode:       c.CityCode,
+		PrefectureKana: c.PrefectureKana,
+		CityKana:       c.CityKana,
+		Prefecture:     c.Prefecture,
+		City:           c.City,
+	}
+}
+
+// FromProtoCity converts City to kenall.City, it returns nil if m is nil.
+func FromProtoCity(m *City) *kenall.City {
+	if m == nil {
+		return nil
+	}
+
+	return &kenall.City{
+		JISX0402:       m.GetJisx0402(),
+		PrefectureCode: m.GetPrefectureCode(),
+		CityCode:       m.GetCityCode(),
+		PrefectureKana: m.GetPrefectureKana(),
+		CityKana:       m.GetCityKana(),
+		Prefecture:     m.GetPrefecture(),
+		City:           m.GetCity(),
+	}
+}
+
+// ToProtoCorporation converts kenall.Corporation to Corporation, it returns nil if c is nil.
+func ToProtoCorporation(c *kenall.Corporation) *Corporation {
+	if c == nil {
+		return nil
+	}
+
+	return &Corporation{
+		PublishedDate:            c.PublishedDate,
+		SequenceNumber:           c.SequenceNumber.String(),
+		CorporateNumber:          c.CorporateNumber,
+		Process:                  c.Process.String(),
+		Correct:                  c.Correct.String(),
+		UpdateDate:               c.UpdateDate,
+		ChangeDate:               c.ChangeDate,
+		Name:                     c.Name,
+		NameImageId:              toOptional(c.NameImageID),
+		Kind:                     c.Kind,
+		PrefectureName:           c.PrefectureName,
+		CityName:                 c.CityName,
+		StreetNumber:             c.StreetNumber,
+		Town:                     toOptional(c.Town),
+		KyotoStreet:              toOptional(c.KyotoStreet),
+		BlockLotNum:              toOptional(c.BlockLotNum),
+		Building:                 toOptional(c.Building),
+		FloorRoom:                toOptional(c.FloorRoom),
+		AddressImageId:           toOptional(c.AddressImageID),
+		Jisx0402:                 c.JISX0402,
+		PostCode:                 c.PostCode,
+		AddressOutside:           c.AddressOutside,
+		AddressOutsideImageId:    toOptional(c.AddressOutsideImageID),
+		CloseDate:                toOptional(c.CloseDate),
+		CloseCause:               toOptional(c.CloseCause),
+		SuccessorCorporateNumber: toOptional(c.SuccessorCorporateNumber),
+		ChangeCause:              c.ChangeCause,
+		AssignmentDate:           c.AssignmentDate,
+		EnName:                   c.EnName,
+		EnPrefectureName:         c.EnPrefectureName,
+		EnAddressLine:            toOptional(c.EnAddressLine),
+		EnAddressOutside:         toOptional(c.EnAddressOutside),
+		Furigana:                 c.Furigana,
+		Hihyoji:                  c.Hihyoji,
+	}
+}
+
+// FromProtoCorporation converts Corporation to kenall.Corporation, it returns nil if m is nil.
+func FromProtoCorporation(m *Corporation) *kenall.Corporation {
+	if m == nil {
+		return nil
+	}
+
+	return &kenall.Corporation{
+		PublishedDate:            m.GetPublishedDate(),
+		SequenceNumber:           json.Number(m.GetSequenceNumber()),
+		CorporateNumber:          m.GetCorporateNumber(),
+		Process:                  json.Number(m.GetProcess()),
+		Correct:                  json.Number(m.GetCorrect()),
+		UpdateDate:               m.GetUpdateDate(),
+		ChangeDate:               m.GetChangeDate(),
+		Name:                     m.GetName(),
+		NameImageID:              fromOptional(m.NameImageId),
+		Kind:                     m.GetKind(),
+		PrefectureName:           m.GetPrefectureName(),
+		CityName:                 m.GetCityName(),
+		StreetNumber:             m.GetStreetNumber(),
+		Town:                     fromOptional(m.Town),
+		KyotoStreet:              fromOptional(m.KyotoStreet),
+		BlockLotNum:              fromOptional(m.BlockLotNum),
+		Building:                 fromOptional(m.Building),
+		FloorRoom:                fromOptional(m.FloorRoom),
+		AddressImageID:           fromOptional(m.AddressImageId),
+		JISX0402:                 m.GetJisx0402(),
+		PostCode:                 m.GetPostCode(),
+		AddressOutside:           m.GetAddressOutside(),
+		AddressOutsideImageID:    fromOptional(m.AddressOutsideImageId),
+		CloseDate:                fromOptional(m.CloseDate),
+		CloseCause:               fromOptional(m.CloseCause),
+		SuccessorCorporateNumber: fromOptional(m.SuccessorCorporateNumber),
+		ChangeCause:              m.GetChangeCause(),
+		AssignmentDate:           m.GetAssignmentDate(),
+		EnName:                   m.GetEnName(),
+		EnPrefectureName:         m.GetEnPrefectureName(),
+		EnAddressLine:            fromOptional(m.EnAddressLine),
+		EnAddressOutside:         fromOptional(m.EnAddressOutside),
+		Furigana:                 m.GetFurigana(),
+		Hihyoji:                  m.GetHihyoji(),
+	}
+}
+
+// ToProtoHoliday converts kenall.Holiday to Holiday, it returns nil if h is nil.
+// The date is formatted in Japan Standard Time.
+func ToProtoHoliday(h *kenall.Holiday) *Holiday {
+	if h == nil {
+		return nil
+	}
+
+	return &Holiday{
+		Title: h.Title,
+		Date:  h.In(jst).Format(kenall.RFC3339DateFormat),
+	}
+}
+
+// FromProtoHoliday converts Holiday to kenall.Holiday, it returns nil if m is nil.
+// The date is parsed as midnight in Japan Standard Time.
+func FromProtoHoliday(m *Holiday) (*kenall.Holiday, error) {
+	if m == nil {
+		return nil, nil //nolint: nilnil
+	}
+
+	t, err := time.ParseInLocation(kenall.RFC3339DateFormat, m.GetDate(), jst)
+	if err != nil {
+		return nil, fmt.Errorf("kenallpb: failed to parse Holiday: %w", err)
+	}
+
+	return &kenall.Holiday{Title: m.GetTitle(), Time: t}, nil
+}
+
+func toOptional(ns kenall.NullString) *string {
+	if !ns.Valid {
+		return nil
+	}
+
+	s := ns.String
+
+	return &s
+}
+
+func fromOptional(s *string) kenall.NullString {
+	if s == nil {
+		return kenall.NullString{}
+	}
+
+	return kenall.NullString{String: *s, Valid: true}
+}
@@ -0,0 +1,146 @@
+package kenallpb_test
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+	"github.com/osamingo/go-kenall/v2/kenallpb"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestAddress(t *testing.T) {
+	t.Parallel()
+
+	res := &kenall.GetAddressResponse{}
+	readFixture(t, "addresses.json", res)
+
+	for _, want := range res.Addresses {
+		m := &kenallpb.Address{}
+		roundTrip(t, kenallpb.ToProtoAddress(want), m)
+
+		if give := kenallpb.FromProtoAddress(m); !reflect.DeepEqual(give, want) {
+			t.Errorf("give: %+v, want: %+v", give, want)
+		}
+	}
+
+	if kenallpb.ToProtoAddress(nil) != nil || kenallpb.FromProtoAddress(nil) != nil {
+		t.Error("nil should be converted to nil")
+	}
+}
+
+func TestCity(t *testing.T) {
+	t.Parallel()
+
+	res := &kenall.GetCityResponse{}
+	readFixture(t, "cities.json", res)
+
+	for _, want := range res.Cities {
+		m := &kenallpb.City{}
+		roundTrip(t, kenallpb.ToProtoCity(want), m)
+
+		if give := kenallpb.FromProtoCity(m); !reflect.DeepEqual(give, want) {
+			t.Errorf("give: %+v, want: %+v", give, want)
+		}
+	}
+
+	if kenallpb.ToProtoCity(nil) != nil || kenallpb.FromProtoCity(nil) != nil {
+		t.Error("nil should be converted to nil")
+	}
+}
+
+func TestCorporation(t *testing.T) {
+	t.Parallel()
+
+	res := &kenall.GetCorporationResponse{}
+	readFixture(t, "corporation.json", res)
+
+	m := &kenallpb.Corporation{}
+	roundTrip(t, kenallpb.ToProtoCorporation(res.Corporation), m)
+
+	if give := kenallpb.FromProtoCorporation(m); !reflect.DeepEqual(give, res.Corporation) {
+		t.Errorf("give: %+v, want: %+v", give, res.Corporation)
+	}
+
+	if m.Town == nil || m.CloseDate != nil {
+		t.Errorf("give: %v and %v, want: the presence of nullable fields is kept", m.Town, m.CloseDate)
+	}
+
+	if kenallpb.ToProtoCorporation(nil) != nil || kenallpb.FromProtoCorporation(nil) != nil {
+		t.Error("nil should be converted to nil")
+	}
+}
+
+func TestHoliday(t *testing.T) {
+	t.Parallel()
+
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	cases := map[string]struct {
+		give    *kenallpb.Holiday
+		want    *kenall.Holiday
+		wantErr bool
+	}{
+		"Holiday":      {give: &kenallpb.Holiday{Title: "元日", Date: "2022-01-01"}, want: &kenall.Holiday{Title: "元日", Time: time.Date(2022, 1, 1, 0, 0, 0, 0, jst)}},
+		"Nil":          {give: nil, want: nil},
+		"Invalid date": {give: &kenallpb.Holiday{Title: "元日", Date: "2022/01/01"}, wantErr: true},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			give, err := kenallpb.FromProtoHoliday(c.give)
+			if (err != nil) != c.wantErr {
+				t.Fatalf("give: %v, want: %v", err, c.wantErr)
+			}
+
+			if !reflect.DeepEqual(give, c.want) {
+				t.Errorf("give: %+v, want: %+v", give, c.want)
+			}
+
+			if c.want == nil {
+				return
+			}
+
+			if m := kenallpb.ToProtoHoliday(c.want); !proto.Equal(m, c.give) {
+				t.Errorf("give: %v, want: %v", m, c.give)
+			}
+		})
+	}
+
+	// A time in another location is formatted as the date in Japan.
+	h := &kenall.Holiday{Title: "元日", Time: time.Date(2021, 12, 31, 15, 0, 0, 0, time.UTC)}
+	if give := kenallpb.ToProtoHoliday(h).GetDate(); give != "2022-01-01" {
+		t.Errorf("give: %v, want: %v", give, "2022-01-01")
+	}
+}
+
+func readFixture(t *testing.T, name string, v interface{}) {
+	t.Helper()
+
+	data, err := os.ReadFile("../kenalltest/testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func roundTrip(t *testing.T, src, dst proto.Message) {
+	t.Helper()
+
+	data, err := proto.Marshal(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := proto.Unmarshal(data, dst); err != nil {
+		t.Fatal(err)
+	}
+}
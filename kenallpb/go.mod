@@ -0,0 +1,10 @@
+module github.com/osamingo/go-kenall/v2/kenallpb
+
+go 1.23
+
+replace github.com/osamingo/go-kenall/v2 => ../
+
+require (
+	github.com/osamingo/go-kenall/v2 v2.0.0-00010101000000-000000000000
+	google.golang.org/protobuf v1.36.9
+)
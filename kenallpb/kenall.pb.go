@@ -0,0 +1,927 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: kenall.proto
+
+package kenallpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// An Address is an address associated with the postal code defined by JP POST.
+type Address struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Jisx0402           string                 `protobuf:"bytes,1,opt,name=jisx0402,proto3" json:"jisx0402,omitempty"`
+	OldCode            string                 `protobuf:"bytes,2,opt,name=old_code,json=oldCode,proto3" json:"old_code,omitempty"`
+	PostalCode         string                 `protobuf:"bytes,3,opt,name=postal_code,json=postalCode,proto3" json:"postal_code,omitempty"`
+	PrefectureKana     string                 `protobuf:"bytes,4,opt,name=prefecture_kana,json=prefectureKana,proto3" json:"prefecture_kana,omitempty"`
+	CityKana           string                 `protobuf:"bytes,5,opt,name=city_kana,json=cityKana,proto3" json:"city_kana,omitempty"`
+	TownKana           string                 `protobuf:"bytes,6,opt,name=town_kana,json=townKana,proto3" json:"town_kana,omitempty"`
+	TownKanaRaw        string                 `protobuf:"bytes,7,opt,name=town_kana_raw,json=townKanaRaw,proto3" json:"town_kana_raw,omitempty"`
+	Prefecture         string                 `protobuf:"bytes,8,opt,name=prefecture,proto3" json:"prefecture,omitempty"`
+	City               string                 `protobuf:"bytes,9,opt,name=city,proto3" json:"city,omitempty"`
+	Town               string                 `protobuf:"bytes,10,opt,name=town,proto3" json:"town,omitempty"`
+	Koaza              string                 `protobuf:"bytes,11,opt,name=koaza,proto3" json:"koaza,omitempty"`
+	KyotoStreet        string                 `protobuf:"bytes,12,opt,name=kyoto_street,json=kyotoStreet,proto3" json:"kyoto_street,omitempty"`
+	Building           string                 `protobuf:"bytes,13,opt,name=building,proto3" json:"building,omitempty"`
+	Floor              string                 `protobuf:"bytes,14,opt,name=floor,proto3" json:"floor,omitempty"`
+	TownPartial        bool                   `protobuf:"varint,15,opt,name=town_partial,json=townPartial,proto3" json:"town_partial,omitempty"`
+	TownAddressedKoaza bool                   `protobuf:"varint,16,opt,name=town_addressed_koaza,json=townAddressedKoaza,proto3" json:"town_addressed_koaza,omitempty"`
+	TownChome          bool                   `protobuf:"varint,17,opt,name=town_chome,json=townChome,proto3" json:"town_chome,omitempty"`
+	TownMulti          bool                   `protobuf:"varint,18,opt,name=town_multi,json=townMulti,proto3" json:"town_multi,omitempty"`
+	TownRaw            string                 `protobuf:"bytes,19,opt,name=town_raw,json=townRaw,proto3" json:"town_raw,omitempty"`
+	Corporation        *Address_Corporation   `protobuf:"bytes,20,opt,name=corporation,proto3" json:"corporation,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *Address) Reset() {
+	*x = Address{}
+	mi := &file_kenall_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address) ProtoMessage() {}
+
+func (x *Address) ProtoReflect() protoreflect.Message {
+	mi := &file_kenall_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address.ProtoReflect.Descriptor instead.
+func (*Address) Descriptor() ([]byte, []int) {
+	return file_kenall_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Address) GetJisx0402() string {
+	if x != nil {
+		return x.Jisx0402
+	}
+	return ""
+}
+
+func (x *Address) GetOldCode() string {
+	if x != nil {
+		return x.OldCode
+	}
+	return ""
+}
+
+func (x *Address) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *Address) GetPrefectureKana() string {
+	if x != nil {
+		return x.PrefectureKana
+	}
+	return ""
+}
+
+func (x *Address) GetCityKana() string {
+	if x != nil {
+		return x.CityKana
+	}
+	return ""
+}
+
+func (x *Address) GetTownKana() string {
+	if x != nil {
+		return x.TownKana
+	}
+	return ""
+}
+
+func (x *Address) GetTownKanaRaw() string {
+	if x != nil {
+		return x.TownKanaRaw
+	}
+	return ""
+}
+
+func (x *Address) GetPrefecture() string {
+	if x != nil {
+		return x.Prefecture
+	}
+	return ""
+}
+
+func (x *Address) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *Address) GetTown() string {
+	if x != nil {
+		return x.Town
+	}
+	return ""
+}
+
+func (x *Address) GetKoaza() string {
+	if x != nil {
+		return x.Koaza
+	}
+	return ""
+}
+
+func (x *Address) GetKyotoStreet() string {
+	if x != nil {
+		return x.KyotoStreet
+	}
+	return ""
+}
+
+func (x *Address) GetBuilding() string {
+	if x != nil {
+		return x.Building
+	}
+	return ""
+}
+
+func (x *Address) GetFloor() string {
+	if x != nil {
+		return x.Floor
+	}
+	return ""
+}
+
+func (x *Address) GetTownPartial() bool {
+	if x != nil {
+		return x.TownPartial
+	}
+	return false
+}
+
+func (x *Address) GetTownAddressedKoaza() bool {
+	if x != nil {
+		return x.TownAddressedKoaza
+	}
+	return false
+}
+
+func (x *Address) GetTownChome() bool {
+	if x != nil {
+		return x.TownChome
+	}
+	return false
+}
+
+func (x *Address) GetTownMulti() bool {
+	if x != nil {
+		return x.TownMulti
+	}
+	return false
+}
+
+func (x *Address) GetTownRaw() string {
+	if x != nil {
+		return x.TownRaw
+	}
+	return ""
+}
+
+func (x *Address) GetCorporation() *Address_Corporation {
+	if x != nil {
+		return x.Corporation
+	}
+	return nil
+}
+
+// A City is a city associated with the prefecture code defined by JIS X 0401.
+type City struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Jisx0402       string                 `protobuf:"bytes,1,opt,name=jisx0402,proto3" json:"jisx0402,omitempty"`
+	PrefectureCode string                 `protobuf:"bytes,2,opt,name=prefecture_code,json=prefectureCode,proto3" json:"prefecture_code,omitempty"`
+	CityCode       string                 `protobuf:"bytes,3,opt,name=city_code,json=cityCode,proto3" json:"city_code,omitempty"`
+	PrefectureKana string                 `protobuf:"bytes,4,opt,name=prefecture_kana,json=prefectureKana,proto3" json:"prefecture_kana,omitempty"`
+	CityKana       string                 `protobuf:"bytes,5,opt,name=city_kana,json=cityKana,proto3" json:"city_kana,omitempty"`
+	Prefecture     string                 `protobuf:"bytes,6,opt,name=prefecture,proto3" json:"prefecture,omitempty"`
+	City           string                 `protobuf:"bytes,7,opt,name=city,proto3" json:"city,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *City) Reset() {
+	*x = City{}
+	mi := &file_kenall_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *City) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*City) ProtoMessage() {}
+
+func (x *City) ProtoReflect() protoreflect.Message {
+	mi := &file_kenall_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use City.ProtoReflect.Descriptor instead.
+func (*City) Descriptor() ([]byte, []int) {
+	return file_kenall_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *City) GetJisx0402() string {
+	if x != nil {
+		return x.Jisx0402
+	}
+	return ""
+}
+
+func (x *City) GetPrefectureCode() string {
+	if x != nil {
+		return x.PrefectureCode
+	}
+	return ""
+}
+
+func (x *City) GetCityCode() string {
+	if x != nil {
+		return x.CityCode
+	}
+	return ""
+}
+
+func (x *City) GetPrefectureKana() string {
+	if x != nil {
+		return x.PrefectureKana
+	}
+	return ""
+}
+
+func (x *City) GetCityKana() string {
+	if x != nil {
+		return x.CityKana
+	}
+	return ""
+}
+
+func (x *City) GetPrefecture() string {
+	if x != nil {
+		return x.Prefecture
+	}
+	return ""
+}
+
+func (x *City) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+// A Corporation is a corporation associated with the corporate number defined by National Tax Agency Japan.
+type Corporation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublishedDate string                 `protobuf:"bytes,1,opt,name=published_date,json=publishedDate,proto3" json:"published_date,omitempty"`
+	// sequence_number, process and correct keep the numbers as the kenall service returns them.
+	SequenceNumber           string  `protobuf:"bytes,2,opt,name=sequence_number,json=sequenceNumber,proto3" json:"sequence_number,omitempty"`
+	CorporateNumber          string  `protobuf:"bytes,3,opt,name=corporate_number,json=corporateNumber,proto3" json:"corporate_number,omitempty"`
+	Process                  string  `protobuf:"bytes,4,opt,name=process,proto3" json:"process,omitempty"`
+	Correct                  string  `protobuf:"bytes,5,opt,name=correct,proto3" json:"correct,omitempty"`
+	UpdateDate               string  `protobuf:"bytes,6,opt,name=update_date,json=updateDate,proto3" json:"update_date,omitempty"`
+	ChangeDate               string  `protobuf:"bytes,7,opt,name=change_date,json=changeDate,proto3" json:"change_date,omitempty"`
+	Name                     string  `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	NameImageId              *string `protobuf:"bytes,9,opt,name=name_image_id,json=nameImageId,proto3,oneof" json:"name_image_id,omitempty"`
+	Kind                     string  `protobuf:"bytes,10,opt,name=kind,proto3" json:"kind,omitempty"`
+	PrefectureName           string  `protobuf:"bytes,11,opt,name=prefecture_name,json=prefectureName,proto3" json:"prefecture_name,omitempty"`
+	CityName                 string  `protobuf:"bytes,12,opt,name=city_name,json=cityName,proto3" json:"city_name,omitempty"`
+	StreetNumber             string  `protobuf:"bytes,13,opt,name=street_number,json=streetNumber,proto3" json:"street_number,omitempty"`
+	Town                     *string `protobuf:"bytes,14,opt,name=town,proto3,oneof" json:"town,omitempty"`
+	KyotoStreet              *string `protobuf:"bytes,15,opt,name=kyoto_street,json=kyotoStreet,proto3,oneof" json:"kyoto_street,omitempty"`
+	BlockLotNum              *string `protobuf:"bytes,16,opt,name=block_lot_num,json=blockLotNum,proto3,oneof" json:"block_lot_num,omitempty"`
+	Building                 *string `protobuf:"bytes,17,opt,name=building,proto3,oneof" json:"building,omitempty"`
+	FloorRoom                *string `protobuf:"bytes,18,opt,name=floor_room,json=floorRoom,proto3,oneof" json:"floor_room,omitempty"`
+	AddressImageId           *string `protobuf:"bytes,19,opt,name=address_image_id,json=addressImageId,proto3,oneof" json:"address_image_id,omitempty"`
+	Jisx0402                 string  `protobuf:"bytes,20,opt,name=jisx0402,proto3" json:"jisx0402,omitempty"`
+	PostCode                 string  `protobuf:"bytes,21,opt,name=post_code,json=postCode,proto3" json:"post_code,omitempty"`
+	AddressOutside           string  `protobuf:"bytes,22,opt,name=address_outside,json=addressOutside,proto3" json:"address_outside,omitempty"`
+	AddressOutsideImageId    *string `protobuf:"bytes,23,opt,name=address_outside_image_id,json=addressOutsideImageId,proto3,oneof" json:"address_outside_image_id,omitempty"`
+	CloseDate                *string `protobuf:"bytes,24,opt,name=close_date,json=closeDate,proto3,oneof" json:"close_date,omitempty"`
+	CloseCause               *string `protobuf:"bytes,25,opt,name=close_cause,json=closeCause,proto3,oneof" json:"close_cause,omitempty"`
+	SuccessorCorporateNumber *string `protobuf:"bytes,26,opt,name=successor_corporate_number,json=successorCorporateNumber,proto3,oneof" json:"successor_corporate_number,omitempty"`
+	ChangeCause              string  `protobuf:"bytes,27,opt,name=change_cause,json=changeCause,proto3" json:"change_cause,omitempty"`
+	AssignmentDate           string  `protobuf:"bytes,28,opt,name=assignment_date,json=assignmentDate,proto3" json:"assignment_date,omitempty"`
+	EnName                   string  `protobuf:"bytes,29,opt,name=en_name,json=enName,proto3" json:"en_name,omitempty"`
+	EnPrefectureName         string  `protobuf:"bytes,30,opt,name=en_prefecture_name,json=enPrefectureName,proto3" json:"en_prefecture_name,omitempty"`
+	EnAddressLine            *string `protobuf:"bytes,31,opt,name=en_address_line,json=enAddressLine,proto3,oneof" json:"en_address_line,omitempty"`
+	EnAddressOutside         *string `protobuf:"bytes,32,opt,name=en_address_outside,json=enAddressOutside,proto3,oneof" json:"en_address_outside,omitempty"`
+	Furigana                 string  `protobuf:"bytes,33,opt,name=furigana,proto3" json:"furigana,omitempty"`
+	Hihyoji                  string  `protobuf:"bytes,34,opt,name=hihyoji,proto3" json:"hihyoji,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *Corporation) Reset() {
+	*x = Corporation{}
+	mi := &file_kenall_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Corporation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Corporation) ProtoMessage() {}
+
+func (x *Corporation) ProtoReflect() protoreflect.Message {
+	mi := &file_kenall_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Corporation.ProtoReflect.Descriptor instead.
+func (*Corporation) Descriptor() ([]byte, []int) {
+	return file_kenall_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Corporation) GetPublishedDate() string {
+	if x != nil {
+		return x.PublishedDate
+	}
+	return ""
+}
+
+func (x *Corporation) GetSequenceNumber() string {
+	if x != nil {
+		return x.SequenceNumber
+	}
+	return ""
+}
+
+func (x *Corporation) GetCorporateNumber() string {
+	if x != nil {
+		return x.CorporateNumber
+	}
+	return ""
+}
+
+func (x *Corporation) GetProcess() string {
+	if x != nil {
+		return x.Process
+	}
+	return ""
+}
+
+func (x *Corporation) GetCorrect() string {
+	if x != nil {
+		return x.Correct
+	}
+	return ""
+}
+
+func (x *Corporation) GetUpdateDate() string {
+	if x != nil {
+		return x.UpdateDate
+	}
+	return ""
+}
+
+func (x *Corporation) GetChangeDate() string {
+	if x != nil {
+		return x.ChangeDate
+	}
+	return ""
+}
+
+func (x *Corporation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Corporation) GetNameImageId() string {
+	if x != nil && x.NameImageId != nil {
+		return *x.NameImageId
+	}
+	return ""
+}
+
+func (x *Corporation) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Corporation) GetPrefectureName() string {
+	if x != nil {
+		return x.PrefectureName
+	}
+	return ""
+}
+
+func (x *Corporation) GetCityName() string {
+	if x != nil {
+		return x.CityName
+	}
+	return ""
+}
+
+func (x *Corporation) GetStreetNumber() string {
+	if x != nil {
+		return x.StreetNumber
+	}
+	return ""
+}
+
+func (x *Corporation) GetTown() string {
+	if x != nil && x.Town != nil {
+		return *x.Town
+	}
+	return ""
+}
+
+func (x *Corporation) GetKyotoStreet() string {
+	if x != nil && x.KyotoStreet != nil {
+		return *x.KyotoStreet
+	}
+	return ""
+}
+
+func (x *Corporation) GetBlockLotNum() string {
+	if x != nil && x.BlockLotNum != nil {
+		return *x.BlockLotNum
+	}
+	return ""
+}
+
+func (x *Corporation) GetBuilding() string {
+	if x != nil && x.Building != nil {
+		return *x.Building
+	}
+	return ""
+}
+
+func (x *Corporation) GetFloorRoom() string {
+	if x != nil && x.FloorRoom != nil {
+		return *x.FloorRoom
+	}
+	return ""
+}
+
+func (x *Corporation) GetAddressImageId() string {
+	if x != nil && x.AddressImageId != nil {
+		return *x.AddressImageId
+	}
+	return ""
+}
+
+func (x *Corporation) GetJisx0402() string {
+	if x != nil {
+		return x.Jisx0402
+	}
+	return ""
+}
+
+func (x *Corporation) GetPostCode() string {
+	if x != nil {
+		return x.PostCode
+	}
+	return ""
+}
+
+func (x *Corporation) GetAddressOutside() string {
+	if x != nil {
+		return x.AddressOutside
+	}
+	return ""
+}
+
+func (x *Corporation) GetAddressOutsideImageId() string {
+	if x != nil && x.AddressOutsideImageId != nil {
+		return *x.AddressOutsideImageId
+	}
+	return ""
+}
+
+func (x *Corporation) GetCloseDate() string {
+	if x != nil && x.CloseDate != nil {
+		return *x.CloseDate
+	}
+	return ""
+}
+
+func (x *Corporation) GetCloseCause() string {
+	if x != nil && x.CloseCause != nil {
+		return *x.CloseCause
+	}
+	return ""
+}
+
+func (x *Corporation) GetSuccessorCorporateNumber() string {
+	if x != nil && x.SuccessorCorporateNumber != nil {
+		return *x.SuccessorCorporateNumber
+	}
+	return ""
+}
+
+func (x *Corporation) GetChangeCause() string {
+	if x != nil {
+		return x.ChangeCause
+	}
+	return ""
+}
+
+func (x *Corporation) GetAssignmentDate() string {
+	if x != nil {
+		return x.AssignmentDate
+	}
+	return ""
+}
+
+func (x *Corporation) GetEnName() string {
+	if x != nil {
+		return x.EnName
+	}
+	return ""
+}
+
+func (x *Corporation) GetEnPrefectureName() string {
+	if x != nil {
+		return x.EnPrefectureName
+	}
+	return ""
+}
+
+func (x *Corporation) GetEnAddressLine() string {
+	if x != nil && x.EnAddressLine != nil {
+		return *x.EnAddressLine
+	}
+	return ""
+}
+
+func (x *Corporation) GetEnAddressOutside() string {
+	if x != nil && x.EnAddressOutside != nil {
+		return *x.EnAddressOutside
+	}
+	return ""
+}
+
+func (x *Corporation) GetFurigana() string {
+	if x != nil {
+		return x.Furigana
+	}
+	return ""
+}
+
+func (x *Corporation) GetHihyoji() string {
+	if x != nil {
+		return x.Hihyoji
+	}
+	return ""
+}
+
+// A Holiday is Japan's holiday detail.
+type Holiday struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Title string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	// date is formatted as YYYY-MM-DD in Japan Standard Time.
+	Date          string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Holiday) Reset() {
+	*x = Holiday{}
+	mi := &file_kenall_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Holiday) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Holiday) ProtoMessage() {}
+
+func (x *Holiday) ProtoReflect() protoreflect.Message {
+	mi := &file_kenall_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Holiday.ProtoReflect.Descriptor instead.
+func (*Holiday) Descriptor() ([]byte, []int) {
+	return file_kenall_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Holiday) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Holiday) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+// A Corporation is the business office which has its own postal code.
+type Address_Corporation struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	NameKana    string                 `protobuf:"bytes,2,opt,name=name_kana,json=nameKana,proto3" json:"name_kana,omitempty"`
+	BlockLot    string                 `protobuf:"bytes,3,opt,name=block_lot,json=blockLot,proto3" json:"block_lot,omitempty"`
+	BlockLotNum *string                `protobuf:"bytes,4,opt,name=block_lot_num,json=blockLotNum,proto3,oneof" json:"block_lot_num,omitempty"`
+	PostOffice  string                 `protobuf:"bytes,5,opt,name=post_office,json=postOffice,proto3" json:"post_office,omitempty"`
+	// code_type keeps the number as the kenall service returns it.
+	CodeType      string `protobuf:"bytes,6,opt,name=code_type,json=codeType,proto3" json:"code_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Address_Corporation) Reset() {
+	*x = Address_Corporation{}
+	mi := &file_kenall_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Address_Corporation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Address_Corporation) ProtoMessage() {}
+
+func (x *Address_Corporation) ProtoReflect() protoreflect.Message {
+	mi := &file_kenall_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Address_Corporation.ProtoReflect.Descriptor instead.
+func (*Address_Corporation) Descriptor() ([]byte, []int) {
+	return file_kenall_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *Address_Corporation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Address_Corporation) GetNameKana() string {
+	if x != nil {
+		return x.NameKana
+	}
+	return ""
+}
+
+func (x *Address_Corporation) GetBlockLot() string {
+	if x != nil {
+		return x.BlockLot
+	}
+	return ""
+}
+
+func (x *Address_Corporation) GetBlockLotNum() string {
+	if x != nil && x.BlockLotNum != nil {
+		return *x.BlockLotNum
+	}
+	return ""
+}
+
+func (x *Address_Corporation) GetPostOffice() string {
+	if x != nil {
+		return x.PostOffice
+	}
+	return ""
+}
+
+func (x *Address_Corporation) GetCodeType() string {
+	if x != nil {
+		return x.CodeType
+	}
+	return ""
+}
+
+var File_kenall_proto protoreflect.FileDescriptor
+
+const file_kenall_proto_rawDesc = "" +
+	"\n" +
+	"\fkenall.proto\x12\tkenall.v2\"\xe2\x06\n" +
+	"\aAddress\x12\x1a\n" +
+	"\bjisx0402\x18\x01 \x01(\tR\bjisx0402\x12\x19\n" +
+	"\bold_code\x18\x02 \x01(\tR\aoldCode\x12\x1f\n" +
+	"\vpostal_code\x18\x03 \x01(\tR\n" +
+	"postalCode\x12'\n" +
+	"\x0fprefecture_kana\x18\x04 \x01(\tR\x0eprefectureKana\x12\x1b\n" +
+	"\tcity_kana\x18\x05 \x01(\tR\bcityKana\x12\x1b\n" +
+	"\ttown_kana\x18\x06 \x01(\tR\btownKana\x12\"\n" +
+	"\rtown_kana_raw\x18\a \x01(\tR\vtownKanaRaw\x12\x1e\n" +
+	"\n" +
+	"prefecture\x18\b \x01(\tR\n" +
+	"prefecture\x12\x12\n" +
+	"\x04city\x18\t \x01(\tR\x04city\x12\x12\n" +
+	"\x04town\x18\n" +
+	" \x01(\tR\x04town\x12\x14\n" +
+	"\x05koaza\x18\v \x01(\tR\x05koaza\x12!\n" +
+	"\fkyoto_street\x18\f \x01(\tR\vkyotoStreet\x12\x1a\n" +
+	"\bbuilding\x18\r \x01(\tR\bbuilding\x12\x14\n" +
+	"\x05floor\x18\x0e \x01(\tR\x05floor\x12!\n" +
+	"\ftown_partial\x18\x0f \x01(\bR\vtownPartial\x120\n" +
+	"\x14town_addressed_koaza\x18\x10 \x01(\bR\x12townAddressedKoaza\x12\x1d\n" +
+	"\n" +
+	"town_chome\x18\x11 \x01(\bR\ttownChome\x12\x1d\n" +
+	"\n" +
+	"town_multi\x18\x12 \x01(\bR\ttownMulti\x12\x19\n" +
+	"\btown_raw\x18\x13 \x01(\tR\atownRaw\x12@\n" +
+	"\vcorporation\x18\x14 \x01(\v2\x1e.kenall.v2.Address.CorporationR\vcorporation\x1a\xd4\x01\n" +
+	"\vCorporation\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1b\n" +
+	"\tname_kana\x18\x02 \x01(\tR\bnameKana\x12\x1b\n" +
+	"\tblock_lot\x18\x03 \x01(\tR\bblockLot\x12'\n" +
+	"\rblock_lot_num\x18\x04 \x01(\tH\x00R\vblockLotNum\x88\x01\x01\x12\x1f\n" +
+	"\vpost_office\x18\x05 \x01(\tR\n" +
+	"postOffice\x12\x1b\n" +
+	"\tcode_type\x18\x06 \x01(\tR\bcodeTypeB\x10\n" +
+	"\x0e_block_lot_num\"\xe2\x01\n" +
+	"\x04City\x12\x1a\n" +
+	"\bjisx0402\x18\x01 \x01(\tR\bjisx0402\x12'\n" +
+	"\x0fprefecture_code\x18\x02 \x01(\tR\x0eprefectureCode\x12\x1b\n" +
+	"\tcity_code\x18\x03 \x01(\tR\bcityCode\x12'\n" +
+	"\x0fprefecture_kana\x18\x04 \x01(\tR\x0eprefectureKana\x12\x1b\n" +
+	"\tcity_kana\x18\x05 \x01(\tR\bcityKana\x12\x1e\n" +
+	"\n" +
+	"prefecture\x18\x06 \x01(\tR\n" +
+	"prefecture\x12\x12\n" +
+	"\x04city\x18\a \x01(\tR\x04city\"\xe3\v\n" +
+	"\vCorporation\x12%\n" +
+	"\x0epublished_date\x18\x01 \x01(\tR\rpublishedDate\x12'\n" +
+	"\x0fsequence_number\x18\x02 \x01(\tR\x0esequenceNumber\x12)\n" +
+	"\x10corporate_number\x18\x03 \x01(\tR\x0fcorporateNumber\x12\x18\n" +
+	"\aprocess\x18\x04 \x01(\tR\aprocess\x12\x18\n" +
+	"\acorrect\x18\x05 \x01(\tR\acorrect\x12\x1f\n" +
+	"\vupdate_date\x18\x06 \x01(\tR\n" +
+	"updateDate\x12\x1f\n" +
+	"\vchange_date\x18\a \x01(\tR\n" +
+	"changeDate\x12\x12\n" +
+	"\x04name\x18\b \x01(\tR\x04name\x12'\n" +
+	"\rname_image_id\x18\t \x01(\tH\x00R\vnameImageId\x88\x01\x01\x12\x12\n" +
+	"\x04kind\x18\n" +
+	" \x01(\tR\x04kind\x12'\n" +
+	"\x0fprefecture_name\x18\v \x01(\tR\x0eprefectureName\x12\x1b\n" +
+	"\tcity_name\x18\f \x01(\tR\bcityName\x12#\n" +
+	"\rstreet_number\x18\r \x01(\tR\fstreetNumber\x12\x17\n" +
+	"\x04town\x18\x0e \x01(\tH\x01R\x04town\x88\x01\x01\x12&\n" +
+	"\fkyoto_street\x18\x0f \x01(\tH\x02R\vkyotoStreet\x88\x01\x01\x12'\n" +
+	"\rblock_lot_num\x18\x10 \x01(\tH\x03R\vblockLotNum\x88\x01\x01\x12\x1f\n" +
+	"\bbuilding\x18\x11 \x01(\tH\x04R\bbuilding\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"floor_room\x18\x12 \x01(\tH\x05R\tfloorRoom\x88\x01\x01\x12-\n" +
+	"\x10address_image_id\x18\x13 \x01(\tH\x06R\x0eaddressImageId\x88\x01\x01\x12\x1a\n" +
+	"\bjisx0402\x18\x14 \x01(\tR\bjisx0402\x12\x1b\n" +
+	"\tpost_code\x18\x15 \x01(\tR\bpostCode\x12'\n" +
+	"\x0faddress_outside\x18\x16 \x01(\tR\x0eaddressOutside\x12<\n" +
+	"\x18address_outside_image_id\x18\x17 \x01(\tH\aR\x15addressOutsideImageId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"close_date\x18\x18 \x01(\tH\bR\tcloseDate\x88\x01\x01\x12$\n" +
+	"\vclose_cause\x18\x19 \x01(\tH\tR\n" +
+	"closeCause\x88\x01\x01\x12A\n" +
+	"\x1asuccessor_corporate_number\x18\x1a \x01(\tH\n" +
+	"R\x18successorCorporateNumber\x88\x01\x01\x12!\n" +
+	"\fchange_cause\x18\x1b \x01(\tR\vchangeCause\x12'\n" +
+	"\x0fassignment_date\x18\x1c \x01(\tR\x0eassignmentDate\x12\x17\n" +
+	"\aen_name\x18\x1d \x01(\tR\x06enName\x12,\n" +
+	"\x12en_prefecture_name\x18\x1e \x01(\tR\x10enPrefectureName\x12+\n" +
+	"\x0fen_address_line\x18\x1f \x01(\tH\vR\renAddressLine\x88\x01\x01\x121\n" +
+	"\x12en_address_outside\x18  \x01(\tH\fR\x10enAddressOutside\x88\x01\x01\x12\x1a\n" +
+	"\bfurigana\x18! \x01(\tR\bfurigana\x12\x18\n" +
+	"\ahihyoji\x18\" \x01(\tR\ahihyojiB\x10\n" +
+	"\x0e_name_image_idB\a\n" +
+	"\x05_townB\x0f\n" +
+	"\r_kyoto_streetB\x10\n" +
+	"\x0e_block_lot_numB\v\n" +
+	"\t_buildingB\r\n" +
+	"\v_floor_roomB\x13\n" +
+	"\x11_address_image_idB\x1b\n" +
+	"\x19_address_outside_image_idB\r\n" +
+	"\v_close_dateB\x0e\n" +
+	"\f_close_causeB\x1d\n" +
+	"\x1b_successor_corporate_numberB\x12\n" +
+	"\x10_en_address_lineB\x15\n" +
+	"\x13_en_address_outside\"3\n" +
+	"\aHoliday\x12\x14\n" +
+	"\x05title\x18\x01 \x01(\tR\x05title\x12\x12\n" +
+	"\x04date\x18\x02 \x01(\tR\x04dateB+Z)github.com/osamingo/go-kenall/v2/kenallpbb\x06proto3"
+
+var (
+	file_kenall_proto_rawDescOnce sync.Once
+	file_kenall_proto_rawDescData []byte
+)
+
+func file_kenall_proto_rawDescGZIP() []byte {
+	file_kenall_proto_rawDescOnce.Do(func() {
+		file_kenall_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_kenall_proto_rawDesc), len(file_kenall_proto_rawDesc)))
+	})
+	return file_kenall_proto_rawDescData
+}
+
+var file_kenall_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_kenall_proto_goTypes = []any{
+	(*Address)(nil),             // 0: kenall.v2.Address
+	(*City)(nil),                // 1: kenall.v2.City
+	(*Corporation)(nil),         // 2: kenall.v2.Corporation
+	(*Holiday)(nil),             // 3: kenall.v2.Holiday
+	(*Address_Corporation)(nil), // 4: kenall.v2.Address.Corporation
+}
+var file_kenall_proto_depIdxs = []int32{
+	4, // 0: kenall.v2.Address.corporation:type_name -> kenall.v2.Address.Corporation
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_kenall_proto_init() }
+func file_kenall_proto_init() {
+	if File_kenall_proto != nil {
+		return
+	}
+	file_kenall_proto_msgTypes[2].OneofWrappers = []any{}
+	file_kenall_proto_msgTypes[4].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_kenall_proto_rawDesc), len(file_kenall_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_kenall_proto_goTypes,
+		DependencyIndexes: file_kenall_proto_depIdxs,
+		MessageInfos:      file_kenall_proto_msgTypes,
+	}.Build()
+	File_kenall_proto = out.File
+	file_kenall_proto_goTypes = nil
+	file_kenall_proto_depIdxs = nil
+}
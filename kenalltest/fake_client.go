@@ -0,0 +1,375 @@
+// Package kenalltest provides test doubles of kenall.Client for the downstream packages.
+package kenalltest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+// ErrNotProgrammed is returned by kenalltest.FakeClient when the function of the called method is not set.
+var ErrNotProgrammed = errors.New("kenalltest: the method is not programmed")
+
+// A FakeClient is kenall.API with programmable responses, each method calls the function of the same name
+// with the suffix "Func" and records the name of the method.
+type FakeClient struct {
+	GetAddressFunc func(
+		ctx context.Context, postalCode string, opts ...kenall.RequestOption,
+	) (*kenall.GetAddressResponse, error)
+	GetAddressIntoFunc func(
+		ctx context.Context, postalCode string, res *kenall.GetAddressResponse, opts ...kenall.RequestOption,
+	) error
+	GetAddressesFunc func(
+		ctx context.Context, postalCodes []string, opts ...kenall.BatchOption,
+	) (map[string]*kenall.GetAddressResponse, error)
+	GetAddressesByOldCodeFunc func(
+		ctx context.Context, oldCode string, opts ...kenall.RequestOption,
+	) (*kenall.GetAddressesByOldCodeResponse, error)
+	GetAddressesByCityCodeFunc func(
+		ctx context.Context, jisx0402 string, opts ...kenall.RequestOption,
+	) (*kenall.GetAddressesByCityCodeResponse, error)
+	GetOfficeAddressFunc func(
+		ctx context.Context, postalCode string, opts ...kenall.RequestOption,
+	) (*kenall.GetOfficeAddressResponse, error)
+	SearchAddressesFunc func(
+		ctx context.Context, query string, opts ...kenall.SearchOption,
+	) (*kenall.SearchAddressesResponse, error)
+	GetNormalizeAddressFunc func(
+		ctx context.Context, address string, opts ...kenall.RequestOption,
+	) (*kenall.GetNormalizeAddressResponse, error)
+	GetCityFunc func(
+		ctx context.Context, prefectureCode string, opts ...kenall.RequestOption,
+	) (*kenall.GetCityResponse, error)
+	GetAllCitiesFunc   func(ctx context.Context, opts ...kenall.RequestOption) (*kenall.GetAllCitiesResponse, error)
+	GetCorporationFunc func(
+		ctx context.Context, corporateNumber string, opts ...kenall.CorporationSearchOption,
+	) (*kenall.GetCorporationResponse, error)
+	SearchCorporationsByFuriganaFunc func(
+		ctx context.Context, furigana string, opts ...kenall.CorporationSearchOption,
+	) (*kenall.SearchCorporationsResponse, error)
+	GetWhoamiFunc         func(ctx context.Context, opts ...kenall.RequestOption) (*kenall.GetWhoamiResponse, error)
+	GetHolidaysFunc       func(ctx context.Context, opts ...kenall.RequestOption) (*kenall.GetHolidaysResponse, error)
+	GetHolidaysByYearFunc func(
+		ctx context.Context, year int, opts ...kenall.RequestOption,
+	) (*kenall.GetHolidaysResponse, error)
+	GetHolidaysByPeriodFunc func(
+		ctx context.Context, from, to time.Time, opts ...kenall.RequestOption,
+	) (*kenall.GetHolidaysResponse, error)
+	GetBusinessDaysFunc func(
+		ctx context.Context, date time.Time, opts ...kenall.RequestOption,
+	) (*kenall.GetBusinessDaysResponse, error)
+	GetBusinessDaysByPeriodFunc func(
+		ctx context.Context, from, to time.Time, opts ...kenall.RequestOption,
+	) (*kenall.GetBusinessDaysByPeriodResponse, error)
+	GetBanksFunc func(ctx context.Context, opts ...kenall.RequestOption) (*kenall.GetBanksResponse, error)
+	GetBankFunc  func(
+		ctx context.Context, bankCode string, opts ...kenall.RequestOption,
+	) (*kenall.GetBankResponse, error)
+	GetBankBranchesFunc func(
+		ctx context.Context, bankCode string, opts ...kenall.RequestOption,
+	) (*kenall.GetBankBranchesResponse, error)
+	GetBankBranchFunc func(
+		ctx context.Context, bankCode, branchCode string, opts ...kenall.RequestOption,
+	) (*kenall.GetBankBranchResponse, error)
+	GetInvoiceIssuerFunc func(
+		ctx context.Context, registrationNumber string, opts ...kenall.RequestOption,
+	) (*kenall.GetInvoiceIssuerResponse, error)
+
+	mu    sync.Mutex
+	calls []string
+}
+
+var _ kenall.API = (*FakeClient)(nil)
+
+// Calls returns the names of the called methods in order.
+func (f *FakeClient) Calls() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	calls := make([]string, len(f.calls))
+	copy(calls, f.calls)
+
+	return calls
+}
+
+func (f *FakeClient) record(method string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.calls = append(f.calls, method)
+}
+
+// GetAddress implements kenall.API interface.
+func (f *FakeClient) GetAddress(
+	ctx context.Context, postalCode string, opts ...kenall.RequestOption,
+) (*kenall.GetAddressResponse, error) {
+	f.record("GetAddress")
+	if f.GetAddressFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetAddressFunc(ctx, postalCode, opts...)
+}
+
+// GetAddressInto implements kenall.API interface.
+func (f *FakeClient) GetAddressInto(
+	ctx context.Context, postalCode string, res *kenall.GetAddressResponse, opts ...kenall.RequestOption,
+) error {
+	f.record("GetAddressInto")
+	if f.GetAddressIntoFunc == nil {
+		return ErrNotProgrammed
+	}
+
+	return f.GetAddressIntoFunc(ctx, postalCode, res, opts...)
+}
+
+// GetAddresses implements kenall.API interface.
+func (f *FakeClient) GetAddresses(
+	ctx context.Context, postalCodes []string, opts ...kenall.BatchOption,
+) (map[string]*kenall.GetAddressResponse, error) {
+	f.record("GetAddresses")
+	if f.GetAddressesFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetAddressesFunc(ctx, postalCodes, opts...)
+}
+
+// GetAddressesByOldCode implements kenall.API interface.
+func (f *FakeClient) GetAddressesByOldCode(
+	ctx context.Context, oldCode string, opts ...kenall.RequestOption,
+) (*kenall.GetAddressesByOldCodeResponse, error) {
+	f.record("GetAddressesByOldCode")
+	if f.GetAddressesByOldCodeFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetAddressesByOldCodeFunc(ctx, oldCode, opts...)
+}
+
+// GetAddressesByCityCode implements kenall.API interface.
+func (f *FakeClient) GetAddressesByCityCode(
+	ctx context.Context, jisx0402 string, opts ...kenall.RequestOption,
+) (*kenall.GetAddressesByCityCodeResponse, error) {
+	f.record("GetAddressesByCityCode")
+	if f.GetAddressesByCityCodeFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetAddressesByCityCodeFunc(ctx, jisx0402, opts...)
+}
+
+// GetOfficeAddress implements kenall.API interface.
+func (f *FakeClient) GetOfficeAddress(
+	ctx context.Context, postalCode string, opts ...kenall.RequestOption,
+) (*kenall.GetOfficeAddressResponse, error) {
+	f.record("GetOfficeAddress")
+	if f.GetOfficeAddressFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetOfficeAddressFunc(ctx, postalCode, opts...)
+}
+
+// SearchAddresses implements kenall.API interface.
+func (f *FakeClient) SearchAddresses(
+	ctx context.Context, query string, opts ...kenall.SearchOption,
+) (*kenall.SearchAddressesResponse, error) {
+	f.record("SearchAddresses")
+	if f.SearchAddressesFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.SearchAddressesFunc(ctx, query, opts...)
+}
+
+// GetNormalizeAddress implements kenall.API interface.
+func (f *FakeClient) GetNormalizeAddress(
+	ctx context.Context, address string, opts ...kenall.RequestOption,
+) (*kenall.GetNormalizeAddressResponse, error) {
+	f.record("GetNormalizeAddress")
+	if f.GetNormalizeAddressFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetNormalizeAddressFunc(ctx, address, opts...)
+}
+
+// GetCity implements kenall.API interface.
+func (f *FakeClient) GetCity(
+	ctx context.Context, prefectureCode string, opts ...kenall.RequestOption,
+) (*kenall.GetCityResponse, error) {
+	f.record("GetCity")
+	if f.GetCityFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetCityFunc(ctx, prefectureCode, opts...)
+}
+
+// GetAllCities implements kenall.API interface.
+func (f *FakeClient) GetAllCities(
+	ctx context.Context, opts ...kenall.RequestOption,
+) (*kenall.GetAllCitiesResponse, error) {
+	f.record("GetAllCities")
+	if f.GetAllCitiesFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetAllCitiesFunc(ctx, opts...)
+}
+
+// GetCorporation implements kenall.API interface.
+func (f *FakeClient) GetCorporation(
+	ctx context.Context, corporateNumber string, opts ...kenall.CorporationSearchOption,
+) (*kenall.GetCorporationResponse, error) {
+	f.record("GetCorporation")
+	if f.GetCorporationFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetCorporationFunc(ctx, corporateNumber, opts...)
+}
+
+// SearchCorporationsByFurigana implements kenall.API interface.
+func (f *FakeClient) SearchCorporationsByFurigana(
+	ctx context.Context, furigana string, opts ...kenall.CorporationSearchOption,
+) (*kenall.SearchCorporationsResponse, error) {
+	f.record("SearchCorporationsByFurigana")
+	if f.SearchCorporationsByFuriganaFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.SearchCorporationsByFuriganaFunc(ctx, furigana, opts...)
+}
+
+// GetWhoami implements kenall.API interface.
+func (f *FakeClient) GetWhoami(ctx context.Context, opts ...kenall.RequestOption) (*kenall.GetWhoamiResponse, error) {
+	f.record("GetWhoami")
+	if f.GetWhoamiFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetWhoamiFunc(ctx, opts...)
+}
+
+// GetHolidays implements kenall.API interface.
+func (f *FakeClient) GetHolidays(
+	ctx context.Context, opts ...kenall.RequestOption,
+) (*kenall.GetHolidaysResponse, error) {
+	f.record("GetHolidays")
+	if f.GetHolidaysFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetHolidaysFunc(ctx, opts...)
+}
+
+// GetHolidaysByYear implements kenall.API interface.
+func (f *FakeClient) GetHolidaysByYear(
+	ctx context.Context, year int, opts ...kenall.RequestOption,
+) (*kenall.GetHolidaysResponse, error) {
+	f.record("GetHolidaysByYear")
+	if f.GetHolidaysByYearFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetHolidaysByYearFunc(ctx, year, opts...)
+}
+
+// GetHolidaysByPeriod implements kenall.API interface.
+func (f *FakeClient) GetHolidaysByPeriod(
+	ctx context.Context, from, to time.Time, opts ...kenall.RequestOption,
+) (*kenall.GetHolidaysResponse, error) {
+	f.record("GetHolidaysByPeriod")
+	if f.GetHolidaysByPeriodFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetHolidaysByPeriodFunc(ctx, from, to, opts...)
+}
+
+// GetBusinessDays implements kenall.API interface.
+func (f *FakeClient) GetBusinessDays(
+	ctx context.Context, date time.Time, opts ...kenall.RequestOption,
+) (*kenall.GetBusinessDaysResponse, error) {
+	f.record("GetBusinessDays")
+	if f.GetBusinessDaysFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetBusinessDaysFunc(ctx, date, opts...)
+}
+
+// GetBusinessDaysByPeriod implements kenall.API interface.
+func (f *FakeClient) GetBusinessDaysByPeriod(
+	ctx context.Context, from, to time.Time, opts ...kenall.RequestOption,
+) (*kenall.GetBusinessDaysByPeriodResponse, error) {
+	f.record("GetBusinessDaysByPeriod")
+	if f.GetBusinessDaysByPeriodFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetBusinessDaysByPeriodFunc(ctx, from, to, opts...)
+}
+
+// GetBanks implements kenall.API interface.
+func (f *FakeClient) GetBanks(ctx context.Context, opts ...kenall.RequestOption) (*kenall.GetBanksResponse, error) {
+	f.record("GetBanks")
+	if f.GetBanksFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetBanksFunc(ctx, opts...)
+}
+
+// GetBank implements kenall.API interface.
+func (f *FakeClient) GetBank(
+	ctx context.Context, bankCode string, opts ...kenall.RequestOption,
+) (*kenall.GetBankResponse, error) {
+	f.record("GetBank")
+	if f.GetBankFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetBankFunc(ctx, bankCode, opts...)
+}
+
+// GetBankBranches implements kenall.API interface.
+func (f *FakeClient) GetBankBranches(
+	ctx context.Context, bankCode string, opts ...kenall.RequestOption,
+) (*kenall.GetBankBranchesResponse, error) {
+	f.record("GetBankBranches")
+	if f.GetBankBranchesFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetBankBranchesFunc(ctx, bankCode, opts...)
+}
+
+// GetBankBranch implements kenall.API interface.
+func (f *FakeClient) GetBankBranch(
+	ctx context.Context, bankCode, branchCode string, opts ...kenall.RequestOption,
+) (*kenall.GetBankBranchResponse, error) {
+	f.record("GetBankBranch")
+	if f.GetBankBranchFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetBankBranchFunc(ctx, bankCode, branchCode, opts...)
+}
+
+// GetInvoiceIssuer implements kenall.API interface.
+func (f *FakeClient) GetInvoiceIssuer(
+	ctx context.Context, registrationNumber string, opts ...kenall.RequestOption,
+) (*kenall.GetInvoiceIssuerResponse, error) {
+	f.record("GetInvoiceIssuer")
+	if f.GetInvoiceIssuerFunc == nil {
+		return nil, ErrNotProgrammed
+	}
+
+	return f.GetInvoiceIssuerFunc(ctx, registrationNumber, opts...)
+}
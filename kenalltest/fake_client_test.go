@@ -0,0 +1,55 @@
+package kenalltest_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+	"github.com/osamingo/go-kenall/v2/kenalltest"
+)
+
+func TestFakeClient(t *testing.T) {
+	t.Parallel()
+
+	want := &kenall.GetAddressResponse{
+		Addresses: []*kenall.Address{{PostalCode: "1008105", Town: "大手町"}},
+	}
+
+	var api kenall.API = &kenalltest.FakeClient{
+		GetAddressFunc: func(
+			ctx context.Context, postalCode string, _ ...kenall.RequestOption,
+		) (*kenall.GetAddressResponse, error) {
+			if postalCode != "1008105" {
+				return nil, kenall.ErrNotFound
+			}
+
+			return want, nil
+		},
+	}
+
+	ctx := context.Background()
+
+	res, err := api.GetAddress(ctx, "1008105")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res != want {
+		t.Errorf("give: %v, want: %v", res, want)
+	}
+
+	if _, err := api.GetAddress(ctx, "0000000"); !errors.Is(err, kenall.ErrNotFound) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrNotFound)
+	}
+
+	if _, err := api.GetCity(ctx, "13"); !errors.Is(err, kenalltest.ErrNotProgrammed) {
+		t.Errorf("give: %v, want: %v", err, kenalltest.ErrNotProgrammed)
+	}
+
+	calls := api.(*kenalltest.FakeClient).Calls()
+	if want := []string{"GetAddress", "GetAddress", "GetCity"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("give: %v, want: %v", calls, want)
+	}
+}
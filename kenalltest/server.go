@@ -0,0 +1,181 @@
+package kenalltest
+
+import (
+	"embed"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+// DefaultToken is the token accepted by kenalltest.Server by default.
+const DefaultToken = "kenalltest"
+
+//go:embed testdata
+var testdata embed.FS
+
+// defaultFixtures maps the request paths to the preloaded fixtures, they are served for any query.
+var defaultFixtures = map[string]string{ //nolint: gochecknoglobals
+	"/postalcode/1008105":         "addresses.json",
+	"/postalcode/":                "search_address.json",
+	"/cities/13":                  "cities.json",
+	"/houjinbangou/2021001052596": "corporation.json",
+	"/whoami":                     "whoami.json",
+	"/holidays":                   "holidays.json",
+	"/businessdays/check":         "business_day.json",
+	"/bank":                       "banks.json",
+	"/bank/0001":                  "bank.json",
+	"/bank/0001/branches":         "bank_branches.json",
+	"/bank/0001/branches/001":     "bank_branch.json",
+	"/invoice/T2021001052596":     "invoice.json",
+}
+
+type (
+	// A Server is an in-process fake kenall server preloaded with the fixtures, e.g. the postal code "1008105",
+	// the prefecture code "13" and the corporate number "2021001052596".
+	Server struct {
+		*httptest.Server
+
+		token    string
+		fixtures map[string][]byte
+
+		mu       sync.Mutex
+		failures map[string]*failure
+	}
+
+	// A ServerOption configures kenalltest.Server.
+	ServerOption func(*Server)
+
+	failure struct {
+		status int
+		times  int
+	}
+)
+
+// WithToken specifies the token accepted by kenalltest.Server, any token is accepted if it is empty.
+func WithToken(token string) ServerOption {
+	return func(s *Server) {
+		s.token = token
+	}
+}
+
+// WithFixture serves the body as the response of the path, it overrides the preloaded fixture.
+// A path with a query, e.g. "/holidays?year=2022", is served only for the request with the same query.
+func WithFixture(path string, body []byte) ServerOption {
+	return func(s *Server) {
+		s.fixtures[path] = body
+	}
+}
+
+// WithError responds the path with the status code for the first times requests, or always if times is not positive.
+// A path with a query is matched as kenalltest.WithFixture.
+func WithError(path string, status, times int) ServerOption {
+	return func(s *Server) {
+		s.failures[path] = &failure{status: status, times: times}
+	}
+}
+
+// NewServer starts and returns kenalltest.Server, the caller should call Close when finished.
+func NewServer(opts ...ServerOption) *Server {
+	s := newServer(opts)
+	s.Server = httptest.NewServer(s)
+
+	return s
+}
+
+// NewHandler returns the handler of kenalltest.Server without starting it, e.g. to serve it on another address.
+func NewHandler(opts ...ServerOption) http.Handler {
+	return newServer(opts)
+}
+
+func newServer(opts []ServerOption) *Server {
+	s := &Server{
+		token:    DefaultToken,
+		fixtures: make(map[string][]byte, len(defaultFixtures)),
+		failures: map[string]*failure{},
+	}
+
+	for path, name := range defaultFixtures {
+		body, err := testdata.ReadFile("testdata/" + name)
+		if err != nil {
+			panic("kenalltest: failed to read the fixture: " + err.Error())
+		}
+		s.fixtures[path] = body
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// NewClient returns kenall.Client connected to the server with the token.
+func (s *Server) NewClient(opts ...kenall.ClientOption) (*kenall.Client, error) {
+	token := s.token
+	if token == "" {
+		token = DefaultToken
+	}
+
+	//nolint: wrapcheck
+	return kenall.NewClient(token, append([]kenall.ClientOption{kenall.WithEndpoint(s.URL)}, opts...)...)
+}
+
+// ServeHTTP implements http.Handler interface.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.token != "" {
+		if fields := strings.Fields(r.Header.Get("Authorization")); len(fields) != 2 || fields[1] != s.token {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+	}
+
+	if status := s.fail(r.URL); status != 0 {
+		w.WriteHeader(status)
+
+		return
+	}
+
+	body, ok := s.fixtures[r.URL.RequestURI()]
+	if !ok {
+		body, ok = s.fixtures[r.URL.Path]
+	}
+
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// fail returns the status code of the injected error of the URL, zero means no error.
+func (s *Server) fail(u *url.URL) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := u.RequestURI()
+
+	f, ok := s.failures[path]
+	if !ok {
+		path = u.Path
+		if f, ok = s.failures[path]; !ok {
+			return 0
+		}
+	}
+
+	if f.times > 0 {
+		f.times--
+		if f.times == 0 {
+			delete(s.failures, path)
+		}
+	}
+
+	return f.status
+}
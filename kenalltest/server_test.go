@@ -0,0 +1,155 @@
+package kenalltest_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+	"github.com/osamingo/go-kenall/v2/kenalltest"
+)
+
+func TestNewServer(t *testing.T) {
+	t.Parallel()
+
+	srv := kenalltest.NewServer(
+		kenalltest.WithFixture("/postalcode/1000001", []byte(`{"version":"2022-11-30","data":[{"town":"千代田"}]}`)),
+	)
+	t.Cleanup(srv.Close)
+
+	cli, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	cases := map[string]struct {
+		call    func() (string, error)
+		want    string
+		wantErr error
+	}{
+		"Preloaded address": {
+			call: func() (string, error) {
+				res, err := cli.GetAddress(ctx, "1008105")
+				if err != nil {
+					return "", err
+				}
+
+				return res.Addresses[0].Town, nil
+			},
+			want: "西新宿",
+		},
+		"Added address": {
+			call: func() (string, error) {
+				res, err := cli.GetAddress(ctx, "1000001")
+				if err != nil {
+					return "", err
+				}
+
+				return res.Addresses[0].Town, nil
+			},
+			want: "千代田",
+		},
+		"Unknown address": {
+			call: func() (string, error) {
+				_, err := cli.GetAddress(ctx, "0000000")
+
+				return "", err
+			},
+			wantErr: kenall.ErrNotFound,
+		},
+		"Preloaded corporation": {
+			call: func() (string, error) {
+				res, err := cli.GetCorporation(ctx, "2021001052596")
+				if err != nil {
+					return "", err
+				}
+
+				return res.Corporation.Name, nil
+			},
+			want: "株式会社オープンコレクター",
+		},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			give, err := c.call()
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("give: %v, want: %v", err, c.wantErr)
+			}
+
+			if give != c.want {
+				t.Errorf("give: %v, want: %v", give, c.want)
+			}
+		})
+	}
+}
+
+func TestWithError(t *testing.T) {
+	t.Parallel()
+
+	srv := kenalltest.NewServer(kenalltest.WithError("/cities/13", 500, 1))
+	t.Cleanup(srv.Close)
+
+	cli, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	if _, err := cli.GetCity(ctx, "13"); !errors.Is(err, kenall.ErrInternalServerError) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInternalServerError)
+	}
+
+	if _, err := cli.GetCity(ctx, "13"); err != nil {
+		t.Errorf("give: %v, want: the error injected only once", err)
+	}
+}
+
+func TestWithToken(t *testing.T) {
+	t.Parallel()
+
+	srv := kenalltest.NewServer(kenalltest.WithToken("secret"))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient(kenalltest.DefaultToken, kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetWhoami(context.Background()); !errors.Is(err, kenall.ErrUnauthorized) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrUnauthorized)
+	}
+}
+
+func TestWithFixture_Query(t *testing.T) {
+	t.Parallel()
+
+	srv := kenalltest.NewServer(
+		kenalltest.WithFixture("/holidays?year=1969", []byte(`{"data":[]}`)),
+		kenalltest.WithError("/holidays?year=2000", 500, 0),
+	)
+	t.Cleanup(srv.Close)
+
+	cli, err := srv.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	if res, err := cli.GetHolidaysByYear(ctx, 1969); err != nil || len(res.Holidays) != 0 {
+		t.Errorf("give: %v, want: the fixture of the query", err)
+	}
+	if res, err := cli.GetHolidaysByYear(ctx, 2022); err != nil || len(res.Holidays) == 0 {
+		t.Errorf("give: %v, want: the preloaded fixture of the path", err)
+	}
+	if _, err := cli.GetHolidaysByYear(ctx, 2000); !errors.Is(err, kenall.ErrInternalServerError) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInternalServerError)
+	}
+}
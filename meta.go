@@ -0,0 +1,109 @@
+package kenall
+
+import (
+	"net/http"
+	"time"
+)
+
+type (
+	// A ResponseMeta is the metadata of the HTTP response of the kenall service, it is embedded in the responses
+	// of the requests, e.g. kenall.GetAddressResponse, for the telemetry and the debugging without wrapping
+	// the transport. The responses aggregated from more than one request, e.g. kenall.GetBusinessDaysByPeriodResponse,
+	// have the metadata of the last request with the attempts of all requests and the duration of the whole call.
+	// Only Duration is set for a response served from the cache.
+	ResponseMeta struct {
+		// StatusCode is the HTTP status code of the response.
+		StatusCode int
+		// Header has the headers of interest of the response, i.e. Date, Etag, Last-Modified, X-Request-Id
+		// and X-RateLimit-*.
+		Header http.Header
+		// Duration is the time taken by the call including the retries and the waits for them.
+		Duration time.Duration
+		// Attempts is the number of the requests sent for the call, i.e. the retries plus one.
+		Attempts int
+		// RateLimit is the rate limit of the account reported by the response headers.
+		RateLimit RateLimit
+		// LastModified is the time of the Last-Modified header, it is zero if the header is missing or malformed.
+		// It is a transport timestamp and not the data version of the response.
+		LastModified time.Time
+	}
+	// A RateLimit is the rate limit reported by the X-RateLimit-* headers, the fields are empty if they are missing.
+	// The kenall service does not provide an API of the account usage, so the headers are the only source of it.
+	RateLimit struct {
+		// Limit is the maximum number of the requests in the window.
+		Limit string
+		// Remaining is the number of the requests remaining in the window.
+		Remaining string
+		// Reset is when the window is reset.
+		Reset string
+	}
+
+	// metaResponse is a response embedding kenall.ResponseMeta.
+	metaResponse interface {
+		responseMeta() *ResponseMeta
+	}
+)
+
+// responseMetaHeaders are the headers kept in kenall.ResponseMeta.
+var responseMetaHeaders = []string{ //nolint: gochecknoglobals
+	"Date", "Etag", "Last-Modified", "X-Request-Id", "X-RateLimit-Limit", "X-RateLimit-Remaining", "X-RateLimit-Reset",
+}
+
+func (m *ResponseMeta) responseMeta() *ResponseMeta {
+	return m
+}
+
+// clone returns a copy of the metadata not sharing the headers.
+func (m ResponseMeta) clone() ResponseMeta {
+	m.Header = m.Header.Clone()
+
+	return m
+}
+
+// aggregate replaces the metadata with the one of the next request of an aggregated response,
+// the attempts are summed up and the duration is left to the caller.
+func (m *ResponseMeta) aggregate(next *ResponseMeta) {
+	attempts := m.Attempts + next.Attempts
+	*m = next.clone()
+	m.Attempts = attempts
+}
+
+// responseMetaOf returns the metadata embedded in the response, it returns nil if the response does not embed it.
+func responseMetaOf(res interface{}) *ResponseMeta {
+	if mr, ok := res.(metaResponse); ok {
+		return mr.responseMeta()
+	}
+
+	return nil
+}
+
+func rateLimitOf(h http.Header) RateLimit {
+	return RateLimit{
+		Limit:     h.Get("X-RateLimit-Limit"),
+		Remaining: h.Get("X-RateLimit-Remaining"),
+		Reset:     h.Get("X-RateLimit-Reset"),
+	}
+}
+
+// setResponseMeta sets the metadata of the HTTP response to the response if it embeds kenall.ResponseMeta.
+func setResponseMeta(res interface{}, resp *http.Response) {
+	m := responseMetaOf(res)
+	if m == nil {
+		return
+	}
+
+	m.StatusCode = resp.StatusCode
+	m.RateLimit = rateLimitOf(resp.Header)
+	m.LastModified = time.Time{}
+
+	if t, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		m.LastModified = t
+	}
+	m.Header = make(http.Header, len(responseMetaHeaders))
+
+	for _, k := range responseMetaHeaders {
+		if vs := resp.Header.Values(k); len(vs) > 0 {
+			m.Header[http.CanonicalHeaderKey(k)] = append([]string(nil), vs...)
+		}
+	}
+}
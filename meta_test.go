@@ -0,0 +1,233 @@
+package kenall_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestResponseMeta(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Limit", "1000")
+		w.Header().Set("X-RateLimit-Remaining", "998")
+		w.Header().Set("X-RateLimit-Reset", "1700000000")
+		w.Header().Set("X-Request-Id", "req-1")
+		w.Header().Set("X-Internal-Trace", "secret")
+
+		switch {
+		case r.URL.Path == "/postalcode/1008105":
+			_, _ = w.Write(addressResponse)
+		case r.URL.Path == "/postalcode/":
+			_, _ = fmt.Fprint(w, `{"version":"2022-03-31","count":1,"data":[`+
+				`{"jisx0402":"13101","old_code":"100","postal_code":"1000001","prefecture":"東京都","city":"千代田区","town":"千代田"}]}`)
+		case strings.HasPrefix(r.URL.Path, "/cities/"):
+			_, _ = w.Write(cityResponse)
+		case r.URL.Path == "/holidays":
+			_, _ = w.Write(holidaysResponse)
+		case r.URL.Path == "/businessdays/check":
+			_, _ = w.Write(businessDaysResponse)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	want := kenall.RateLimit{Limit: "1000", Remaining: "998", Reset: "1700000000"}
+
+	t.Run("Responses", func(t *testing.T) {
+		t.Parallel()
+
+		cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx := context.Background()
+
+		resAddr, err := cli.GetAddress(ctx, "1008105")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resAddr.RateLimit != want {
+			t.Errorf("give: %v, want: %v", resAddr.RateLimit, want)
+		}
+		if resAddr.StatusCode != http.StatusOK {
+			t.Errorf("give: %v, want: %v", resAddr.StatusCode, http.StatusOK)
+		}
+		if resAddr.Attempts != 1 {
+			t.Errorf("give: %v, want: %v", resAddr.Attempts, 1)
+		}
+		if v := resAddr.Header.Get("X-Request-Id"); v != "req-1" {
+			t.Errorf("give: %v, want: %v", v, "req-1")
+		}
+		if v := resAddr.Header.Get("X-RateLimit-Remaining"); v != "998" {
+			t.Errorf("give: %v, want: %v", v, "998")
+		}
+		if v := resAddr.Header.Get("X-Internal-Trace"); v != "" {
+			t.Errorf("give: %v, want: only the headers of interest", v)
+		}
+
+		clone := resAddr.Clone()
+		clone.Header.Set("X-Request-Id", "req-2")
+		if v := resAddr.Header.Get("X-Request-Id"); v != "req-1" {
+			t.Errorf("give: %v, want: the headers are not shared with the clone", v)
+		}
+
+		resCity, err := cli.GetCity(ctx, "13")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resCity.RateLimit != want {
+			t.Errorf("give: %v, want: %v", resCity.RateLimit, want)
+		}
+
+		resHolidays, err := cli.GetHolidays(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resHolidays.RateLimit != want {
+			t.Errorf("give: %v, want: %v", resHolidays.RateLimit, want)
+		}
+	})
+
+	t.Run("Aggregated responses", func(t *testing.T) {
+		t.Parallel()
+
+		cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ctx := context.Background()
+
+		resOldCode, err := cli.GetAddressesByOldCode(ctx, "100")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resCityCode, err := cli.GetAddressesByCityCode(ctx, "13101")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resPeriod, err := cli.GetBusinessDaysByPeriod(ctx,
+			time.Date(2022, 1, 10, 0, 0, 0, 0, time.UTC), time.Date(2022, 1, 11, 0, 0, 0, 0, time.UTC))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		resAllCities, err := cli.GetAllCities(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		cases := map[string]struct {
+			give         kenall.ResponseMeta
+			wantAttempts int
+		}{
+			"GetAddressesByOldCode":   {give: resOldCode.ResponseMeta, wantAttempts: 1},
+			"GetAddressesByCityCode":  {give: resCityCode.ResponseMeta, wantAttempts: 2},
+			"GetBusinessDaysByPeriod": {give: resPeriod.ResponseMeta, wantAttempts: 3},
+			"GetAllCities":            {give: resAllCities.ResponseMeta, wantAttempts: 47},
+		}
+
+		for name, c := range cases {
+			if c.give.RateLimit != want {
+				t.Errorf("%s: give: %v, want: %v", name, c.give.RateLimit, want)
+			}
+			if c.give.StatusCode != http.StatusOK {
+				t.Errorf("%s: give: %v, want: %v", name, c.give.StatusCode, http.StatusOK)
+			}
+			if c.give.Attempts != c.wantAttempts {
+				t.Errorf("%s: give: %v, want: %v", name, c.give.Attempts, c.wantAttempts)
+			}
+			if v := c.give.Header.Get("X-Request-Id"); v != "req-1" {
+				t.Errorf("%s: give: %v, want: %v", name, v, "req-1")
+			}
+		}
+
+		clone := resAllCities.Clone()
+		clone.Header.Set("X-Request-Id", "req-2")
+		if v := resAllCities.Header.Get("X-Request-Id"); v != "req-1" {
+			t.Errorf("give: %v, want: the headers are not shared with the clone", v)
+		}
+	})
+
+	t.Run("Cache hit", func(t *testing.T) {
+		t.Parallel()
+
+		cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL),
+			kenall.WithCache(kenall.NewMemoryCache(10, time.Hour)))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, w := range []struct {
+			rateLimit  kenall.RateLimit
+			statusCode int
+			attempts   int
+		}{
+			{rateLimit: want, statusCode: http.StatusOK, attempts: 1},
+			{rateLimit: kenall.RateLimit{}, statusCode: 0, attempts: 0},
+		} {
+			res, err := cli.GetAddress(context.Background(), "1008105")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.RateLimit != w.rateLimit {
+				t.Errorf("%d: give: %v, want: %v", i, res.RateLimit, w.rateLimit)
+			}
+			if res.StatusCode != w.statusCode {
+				t.Errorf("%d: give: %v, want: %v", i, res.StatusCode, w.statusCode)
+			}
+			if res.Attempts != w.attempts {
+				t.Errorf("%d: give: %v, want: %v", i, res.Attempts, w.attempts)
+			}
+		}
+	})
+}
+
+func TestResponseMeta_Retry(t *testing.T) {
+	t.Parallel()
+
+	var attempts int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&attempts, 1) <= 2 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	clock := &fakeClock{now: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC)}
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithClock(clock),
+		kenall.WithRetryPolicy(kenall.RetryPolicy{MaxRetries: 2, MinBackoff: time.Second}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cli.GetAddress(context.Background(), "1008105")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if res.Attempts != 3 {
+		t.Errorf("give: %v, want: %v", res.Attempts, 3)
+	}
+	if want := 3 * time.Second; res.Duration != want {
+		t.Errorf("give: %v, want: %v", res.Duration, want)
+	}
+}
@@ -0,0 +1,178 @@
+package kenall
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLatencyBuckets is the upper bounds in seconds of the latency histogram of kenall.PrometheusCollector.
+var DefaultLatencyBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10} //nolint: gochecknoglobals
+
+type (
+	// A MetricsCollector collects the result of each API call of kenall.Client.
+	MetricsCollector interface {
+		Collect(call *Call, result *CallResult)
+	}
+
+	// A PrometheusCollector is kenall.MetricsCollector which serves the metrics labeled by the operation
+	// in the Prometheus text exposition format.
+	PrometheusCollector struct {
+		buckets    []float64
+		mu         sync.Mutex
+		operations map[string]*operationMetrics
+	}
+
+	operationMetrics struct {
+		requests  uint64
+		cacheHits uint64
+		errors    map[string]uint64
+		buckets   []uint64
+		sum       float64
+	}
+)
+
+// NewPrometheusCollector returns kenall.PrometheusCollector with the latency buckets in seconds,
+// kenall.DefaultLatencyBuckets is used if no buckets are given.
+func NewPrometheusCollector(buckets ...float64) *PrometheusCollector {
+	if len(buckets) == 0 {
+		buckets = DefaultLatencyBuckets
+	}
+
+	b := make([]float64, len(buckets))
+	copy(b, buckets)
+	sort.Float64s(b)
+
+	return &PrometheusCollector{
+		buckets:    b,
+		operations: map[string]*operationMetrics{},
+	}
+}
+
+// Collect implements kenall.MetricsCollector interface.
+func (pc *PrometheusCollector) Collect(call *Call, result *CallResult) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	m, ok := pc.operations[call.Operation]
+	if !ok {
+		m = &operationMetrics{
+			errors:  map[string]uint64{},
+			buckets: make([]uint64, len(pc.buckets)),
+		}
+		pc.operations[call.Operation] = m
+	}
+
+	m.requests++
+	if result.CacheHit {
+		m.cacheHits++
+	}
+
+	if result.Err != nil {
+		status := "none"
+		if result.StatusCode != 0 {
+			status = strconv.Itoa(result.StatusCode)
+		}
+		m.errors[status]++
+	}
+
+	sec := result.Duration.Seconds()
+	m.sum += sec
+
+	for i, le := range pc.buckets {
+		if sec <= le {
+			m.buckets[i]++
+		}
+	}
+}
+
+// ServeHTTP implements http.Handler interface.
+func (pc *PrometheusCollector) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = pc.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text exposition format.
+func (pc *PrometheusCollector) WriteTo(w io.Writer) (int64, error) {
+	pc.mu.Lock()
+	defer pc.mu.Unlock()
+
+	ops := make([]string, 0, len(pc.operations))
+	for op := range pc.operations {
+		ops = append(ops, op)
+	}
+	sort.Strings(ops)
+
+	var b strings.Builder
+
+	b.WriteString("# HELP kenall_requests_total Total number of the kenall API calls.\n")
+	b.WriteString("# TYPE kenall_requests_total counter\n")
+
+	for _, op := range ops {
+		fmt.Fprintf(&b, "kenall_requests_total{operation=%q} %d\n", op, pc.operations[op].requests)
+	}
+
+	b.WriteString("# HELP kenall_request_duration_seconds Latency of the kenall API calls including retries.\n")
+	b.WriteString("# TYPE kenall_request_duration_seconds histogram\n")
+
+	for _, op := range ops {
+		m := pc.operations[op]
+		for i, le := range pc.buckets {
+			fmt.Fprintf(&b, "kenall_request_duration_seconds_bucket{operation=%q,le=%q} %d\n",
+				op, strconv.FormatFloat(le, 'g', -1, 64), m.buckets[i])
+		}
+		fmt.Fprintf(&b, "kenall_request_duration_seconds_bucket{operation=%q,le=\"+Inf\"} %d\n", op, m.requests)
+		fmt.Fprintf(&b, "kenall_request_duration_seconds_sum{operation=%q} %s\n", op, strconv.FormatFloat(m.sum, 'g', -1, 64))
+		fmt.Fprintf(&b, "kenall_request_duration_seconds_count{operation=%q} %d\n", op, m.requests)
+	}
+
+	b.WriteString("# HELP kenall_errors_total Total number of the failed kenall API calls by the status code.\n")
+	b.WriteString("# TYPE kenall_errors_total counter\n")
+
+	for _, op := range ops {
+		m := pc.operations[op]
+		statuses := make([]string, 0, len(m.errors))
+		for s := range m.errors {
+			statuses = append(statuses, s)
+		}
+		sort.Strings(statuses)
+
+		for _, s := range statuses {
+			fmt.Fprintf(&b, "kenall_errors_total{operation=%q,status=%q} %d\n", op, s, m.errors[s])
+		}
+	}
+
+	b.WriteString("# HELP kenall_cache_hits_total Total number of the kenall API calls served from the cache.\n")
+	b.WriteString("# TYPE kenall_cache_hits_total counter\n")
+
+	for _, op := range ops {
+		fmt.Fprintf(&b, "kenall_cache_hits_total{operation=%q} %d\n", op, pc.operations[op].cacheHits)
+	}
+
+	b.WriteString("# HELP kenall_cache_hit_ratio Ratio of the kenall API calls served from the cache.\n")
+	b.WriteString("# TYPE kenall_cache_hit_ratio gauge\n")
+
+	for _, op := range ops {
+		m := pc.operations[op]
+		fmt.Fprintf(&b, "kenall_cache_hit_ratio{operation=%q} %s\n",
+			op, strconv.FormatFloat(float64(m.cacheHits)/float64(m.requests), 'g', -1, 64))
+	}
+
+	n, err := io.WriteString(w, b.String())
+
+	return int64(n), err
+}
+
+// metricsObserver adapts kenall.MetricsCollector to kenall.CallObserver.
+func metricsObserver(c MetricsCollector) CallObserver {
+	return func(ctx context.Context, call *Call) (context.Context, func(*CallResult)) {
+		return ctx, func(res *CallResult) {
+			c.Collect(call, res)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package kenall_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithMetricsCollector(t *testing.T) {
+	t.Parallel()
+
+	if kenall.WithMetricsCollector(kenall.NewPrometheusCollector()) == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestPrometheusCollector(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	collector := kenall.NewPrometheusCollector(1, 0.5)
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithClock(&fakeClock{now: time.Now()}),
+		kenall.WithCache(kenall.NewMemoryCache(10, time.Minute)),
+		kenall.WithMetricsCollector(collector),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := cli.GetAddress(ctx, "1008105"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := cli.GetCity(ctx, "00"); err == nil {
+		t.Fatal("an error should be returned")
+	}
+
+	rec := httptest.NewRecorder()
+	collector.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("give: %s, want: the text exposition format", ct)
+	}
+
+	body := rec.Body.String()
+	for _, want := range []string{
+		`kenall_requests_total{operation="GetAddress"} 2`,
+		`kenall_requests_total{operation="GetCity"} 1`,
+		`kenall_request_duration_seconds_bucket{operation="GetAddress",le="0.5"} 2`,
+		`kenall_request_duration_seconds_bucket{operation="GetAddress",le="1"} 2`,
+		`kenall_request_duration_seconds_bucket{operation="GetAddress",le="+Inf"} 2`,
+		`kenall_request_duration_seconds_count{operation="GetAddress"} 2`,
+		`kenall_errors_total{operation="GetCity",status="404"} 1`,
+		`kenall_cache_hits_total{operation="GetAddress"} 1`,
+		`kenall_cache_hit_ratio{operation="GetAddress"} 0.5`,
+		`kenall_cache_hit_ratio{operation="GetCity"} 0`,
+	} {
+		if !strings.Contains(body, want+"\n") {
+			t.Errorf("give: %s, want: %s", body, want)
+		}
+	}
+
+	if strings.Contains(body, `kenall_errors_total{operation="GetAddress"`) {
+		t.Errorf("give: %s, want: no errors of GetAddress", body)
+	}
+}
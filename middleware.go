@@ -0,0 +1,23 @@
+package kenall
+
+import "net/http"
+
+type (
+	// A RoundTripFunc sends the request and returns the response, like http.Client.Do.
+	RoundTripFunc func(req *http.Request) (*http.Response, error)
+	// A Middleware wraps the round trip of kenall.Client, e.g. for logging, metrics or header munging,
+	// see kenall.WithMiddleware.
+	Middleware func(next RoundTripFunc) RoundTripFunc
+)
+
+// roundTrip sends the request through the middlewares, the first registered one is the outermost.
+// Retries and rate limiting happen outside of the middlewares, so they see every attempt.
+// The middlewares see the decompressed responses.
+func (cli *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	next := cli.decompress(cli.HTTPClient.Do)
+	for i := len(cli.middlewares) - 1; i >= 0; i-- {
+		next = cli.middlewares[i](next)
+	}
+
+	return next(req)
+}
@@ -0,0 +1,66 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	t.Parallel()
+
+	if kenall.WithMiddleware() == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestClient_Middleware(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	var calls []string
+
+	trace := func(name string) kenall.Middleware {
+		return func(next kenall.RoundTripFunc) kenall.RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, name+":request")
+				resp, err := next(req)
+				if resp != nil {
+					calls = append(calls, name+":"+resp.Status)
+				}
+
+				return resp, err
+			}
+		}
+	}
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithMiddleware(trace("outer"), trace("inner")),
+		kenall.WithMiddleware(func(next kenall.RoundTripFunc) kenall.RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				req.Header.Set("Authorization", "token wrong")
+
+				return next(req)
+			}
+		}),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetAddress(context.Background(), "1008105"); !errors.Is(err, kenall.ErrUnauthorized) {
+		t.Errorf("give: %v, want: the header munged by the middleware", err)
+	}
+
+	want := "outer:request,inner:request,inner:401 Unauthorized,outer:401 Unauthorized"
+	if got := strings.Join(calls, ","); got != want {
+		t.Errorf("give: %v, want: %v", got, want)
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !js && !wasip1 && !tinygo
+
+package kenall
+
+import (
+	"errors"
+	"os"
+	"syscall"
+)
+
+// isTimeoutError reports whether the error is a timeout of the HTTP client.
+func isTimeoutError(err error) bool {
+	return os.IsTimeout(err)
+}
+
+// isConnectionResetErrno reports whether the error is caused by the connection reset or closed by the peer.
+func isConnectionResetErrno(err error) bool {
+	return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.EPIPE)
+}
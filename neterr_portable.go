@@ -0,0 +1,18 @@
+//go:build js || wasip1 || tinygo
+
+package kenall
+
+import "errors"
+
+// isTimeoutError reports whether the error is a timeout of the HTTP client.
+// It avoids os and syscall which are limited on js/wasm, wasip1 and TinyGo.
+func isTimeoutError(err error) bool {
+	var te interface{ Timeout() bool }
+
+	return errors.As(err, &te) && te.Timeout()
+}
+
+// isConnectionResetErrno always reports false since the fetch API based transports do not expose errno.
+func isConnectionResetErrno(error) bool {
+	return false
+}
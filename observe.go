@@ -0,0 +1,107 @@
+package kenall
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+type (
+	// A Call is an API call of kenall.Client observed by kenall.CallObserver.
+	Call struct {
+		// Operation is the name of the method, e.g. "GetAddress". Paginated methods are observed for each page.
+		Operation string
+		Family    EndpointFamily
+		Method    string
+		// Path is the path of the request URL without the query, it may include identifiers, e.g. a postal code.
+		Path string
+	}
+	// A CallResult is the result of kenall.Call.
+	CallResult struct {
+		// StatusCode is the status code of the last response, zero if no response is received.
+		StatusCode int
+		// Attempts is the number of the requests sent including retries, zero for a cache hit.
+		Attempts int
+		// CacheHit is true if the response is served from kenall.WithCache.
+		CacheHit bool
+		Duration time.Duration
+		Err      error
+	}
+	// A CallObserver is called at the start of each API call of kenall.Client with the context of the call,
+	// e.g. to start a tracing span. The returned context is used for the call and the returned function
+	// is called with the result at the end of the call.
+	CallObserver func(ctx context.Context, call *Call) (context.Context, func(*CallResult))
+
+	// callState records the progress of a call from the inside of the client, it may be updated
+	// by the background request of kenall.WithStaleOnTimeout after the call ends.
+	callState struct {
+		attempts   int32
+		statusCode int32
+		cacheHit   int32
+	}
+
+	callStateKey struct{}
+)
+
+// observe sends the request with the observers.
+func (cli *Client) observe(operation string, req *http.Request, res interface{}, finish func(interface{})) error {
+	call := &Call{
+		Operation: operation,
+		Family:    endpointFamilyOf(cli.Endpoint, req.URL),
+		Method:    req.Method,
+		Path:      req.URL.Path,
+	}
+
+	state := &callState{}
+	ctx := context.WithValue(req.Context(), callStateKey{}, state)
+	ends := make([]func(*CallResult), 0, len(cli.observers))
+
+	for _, o := range cli.observers {
+		var end func(*CallResult)
+		if ctx, end = o(ctx, call); end != nil {
+			ends = append(ends, end)
+		}
+	}
+
+	start := cli.clock.Now()
+	err := cli.dispatch(req.WithContext(ctx), res, finish)
+
+	result := &CallResult{
+		StatusCode: int(atomic.LoadInt32(&state.statusCode)),
+		Attempts:   int(atomic.LoadInt32(&state.attempts)),
+		CacheHit:   atomic.LoadInt32(&state.cacheHit) == 1,
+		Duration:   cli.clock.Now().Sub(start),
+		Err:        err,
+	}
+
+	for i := len(ends) - 1; i >= 0; i-- {
+		ends[i](result)
+	}
+
+	return err
+}
+
+func callStateFrom(ctx context.Context) *callState {
+	s, _ := ctx.Value(callStateKey{}).(*callState)
+
+	return s
+}
+
+func (s *callState) attempt() {
+	if s != nil {
+		atomic.AddInt32(&s.attempts, 1)
+	}
+}
+
+func (s *callState) respond(statusCode int) {
+	if s != nil {
+		atomic.StoreInt32(&s.statusCode, int32(statusCode))
+	}
+}
+
+func (s *callState) hitCache() {
+	if s != nil {
+		atomic.StoreInt32(&s.cacheHit, 1)
+	}
+}
@@ -0,0 +1,104 @@
+package kenall_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithCallObserver(t *testing.T) {
+	t.Parallel()
+
+	if kenall.WithCallObserver(nil) == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestClient_CallObserver(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	type ctxKey struct{}
+
+	var (
+		calls   []*kenall.Call
+		results []*kenall.CallResult
+		order   []string
+	)
+
+	observer := func(name string) kenall.CallObserver {
+		return func(ctx context.Context, call *kenall.Call) (context.Context, func(*kenall.CallResult)) {
+			order = append(order, name+":start")
+			if name == "outer" {
+				calls = append(calls, call)
+				ctx = context.WithValue(ctx, ctxKey{}, name)
+			} else if ctx.Value(ctxKey{}) != "outer" {
+				t.Error("the context returned by the outer observer should be passed")
+			}
+
+			return ctx, func(res *kenall.CallResult) {
+				order = append(order, name+":end")
+				if name == "outer" {
+					results = append(results, res)
+				}
+			}
+		}
+	}
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithCache(kenall.NewMemoryCache(10, time.Minute)),
+		kenall.WithCallObserver(observer("outer")),
+		kenall.WithCallObserver(observer("inner")),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := cli.GetAddress(ctx, "1008105"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if want := []string{"outer:start", "inner:start", "inner:end", "outer:end"}; len(order) < 4 || !equalStrings(order[:4], want) {
+		t.Errorf("give: %v, want: %v", order, want)
+	}
+
+	if len(calls) != 2 || len(results) != 2 {
+		t.Fatalf("give: %d calls and %d results, want: 2 observed calls", len(calls), len(results))
+	}
+
+	if c := calls[0]; c.Operation != "GetAddress" || c.Family != kenall.EndpointFamilyPostalCode ||
+		c.Method != "GET" || c.Path != "/postalcode/1008105" {
+		t.Errorf("give: %+v, want: the GetAddress call", c)
+	}
+
+	if r := results[0]; r.StatusCode != 200 || r.Attempts != 1 || r.CacheHit || r.Err != nil {
+		t.Errorf("give: %+v, want: a successful attempt", r)
+	}
+
+	if r := results[1]; r.Attempts != 0 || !r.CacheHit || r.Err != nil {
+		t.Errorf("give: %+v, want: a cache hit", r)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,101 @@
+package kenall
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type (
+	// An OfficeAddress is the address of a business office which has its own postal code,
+	// i.e. 大口事業所個別番号 or a post office box, defined by JP POST.
+	OfficeAddress struct {
+		PostalCode     string     `json:"postal_code"`
+		JISX0402       string     `json:"jisx0402"`
+		Prefecture     string     `json:"prefecture"`
+		PrefectureKana string     `json:"prefecture_kana"`
+		City           string     `json:"city"`
+		CityKana       string     `json:"city_kana"`
+		Town           string     `json:"town"`
+		TownKana       string     `json:"town_kana"`
+		KyotoStreet    string     `json:"kyoto_street"`
+		Name           string     `json:"name"`
+		NameKana       string     `json:"name_kana"`
+		BlockLot       string     `json:"block_lot"`
+		BlockLotNum    NullString `json:"block_lot_num"`
+		// PostOffice is the post office in charge of the postal code.
+		PostOffice string `json:"post_office"`
+		// CodeType is 0 for a business office and 1 for a post office box.
+		CodeType json.Number `json:"code_type"`
+	}
+	// A GetOfficeAddressResponse is a result of kenall.Client.GetOfficeAddress.
+	GetOfficeAddressResponse struct {
+		ResponseMeta `json:"-"`
+
+		Version Version          `json:"version"`
+		Offices []*OfficeAddress `json:"data"`
+	}
+)
+
+// IsOffice reports whether the address is of a business office which has its own postal code.
+func (a *Address) IsOffice() bool {
+	return a.Corporation.Name != ""
+}
+
+// OfficeAddress returns the address as kenall.OfficeAddress, it returns nil unless kenall.Address.IsOffice.
+func (a *Address) OfficeAddress() *OfficeAddress {
+	if !a.IsOffice() {
+		return nil
+	}
+
+	return &OfficeAddress{
+		PostalCode:     a.PostalCode,
+		JISX0402:       a.JISX0402,
+		Prefecture:     a.Prefecture,
+		PrefectureKana: a.PrefectureKana,
+		City:           a.City,
+		CityKana:       a.CityKana,
+		Town:           a.Town,
+		TownKana:       a.TownKana,
+		KyotoStreet:    a.KyotoStreet,
+		Name:           a.Corporation.Name,
+		NameKana:       a.Corporation.NameKana,
+		BlockLot:       a.Corporation.BlockLot,
+		BlockLotNum:    a.Corporation.BlockLotNum,
+		PostOffice:     a.Corporation.PostOffice,
+		CodeType:       a.Corporation.CodeType,
+	}
+}
+
+// IsPostOfficeBox reports whether the postal code is of a post office box.
+func (o *OfficeAddress) IsPostOfficeBox() bool {
+	return o.CodeType.String() == "1"
+}
+
+// GetOfficeAddress requests to the kenall service to get the business offices by postal code.
+// The postal code is normalized as kenall.GetAddress does. It returns ErrNotFound if the postal code
+// is not of a business office, e.g. it is of an area.
+func (cli *Client) GetOfficeAddress(
+	ctx context.Context, postalCode string, opts ...RequestOption,
+) (*GetOfficeAddressResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
+	res, err := cli.GetAddress(ctx, postalCode)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &GetOfficeAddressResponse{ResponseMeta: res.ResponseMeta, Version: res.Version}
+
+	for _, a := range res.Addresses {
+		if o := a.OfficeAddress(); o != nil {
+			ret.Offices = append(ret.Offices, o)
+		}
+	}
+
+	if len(ret.Offices) == 0 {
+		return nil, fmt.Errorf(errFailedRequestFormat, ErrNotFound)
+	}
+
+	return ret, nil
+}
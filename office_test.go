@@ -0,0 +1,82 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_GetOfficeAddress(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	area := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"version":"2022-11-30","data":[{"postal_code":"1600023","prefecture":"東京都","city":"新宿区",` +
+			`"town":"西新宿","corporation":null}]}`))
+	}))
+	t.Cleanup(area.Close)
+
+	cases := map[string]struct {
+		endpoint string
+		give     string
+		want     []*kenall.OfficeAddress
+		wantErr  error
+	}{
+		"Office": {
+			endpoint: srv.URL,
+			give:     "100-8105",
+			want: []*kenall.OfficeAddress{{
+				PostalCode:  "1638001",
+				JISX0402:    "13104",
+				Prefecture:  "東京都",
+				City:        "新宿区",
+				Town:        "西新宿",
+				Name:        "東京都庁",
+				NameKana:    "トウキヨウトチヨウ",
+				BlockLot:    "２丁目８－１",
+				BlockLotNum: kenall.NullString{String: "2-8-1", Valid: true},
+				PostOffice:  "新宿",
+				CodeType:    "0",
+			}},
+		},
+		"Area":      {endpoint: area.URL, give: "1600023", wantErr: kenall.ErrNotFound},
+		"Not found": {endpoint: srv.URL, give: "0000000", wantErr: kenall.ErrNotFound},
+		"Invalid":   {endpoint: srv.URL, give: "100", wantErr: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(c.endpoint))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := cli.GetOfficeAddress(context.Background(), c.give)
+			if !errors.Is(err, c.wantErr) {
+				t.Fatalf("give: %v, want: %v", err, c.wantErr)
+			}
+
+			if c.wantErr != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(res.Offices, c.want) {
+				t.Errorf("give: %+v, want: %+v", res.Offices[0], c.want[0])
+			}
+
+			if res.Offices[0].IsPostOfficeBox() {
+				t.Error("give: true, want: false")
+			}
+		})
+	}
+}
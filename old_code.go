@@ -0,0 +1,76 @@
+package kenall
+
+import (
+	"context"
+	"strconv"
+	"strings"
+)
+
+// A GetAddressesByOldCodeResponse is a result from the kenall service of the API to search addresses
+// by the old postal code.
+type GetAddressesByOldCodeResponse struct {
+	ResponseMeta `json:"-"`
+
+	Version   Version    `json:"version"`
+	Addresses []*Address `json:"data"`
+	// DecodeErrors are the errors of the malformed elements skipped by kenall.WithTolerantDecoding.
+	DecodeErrors []error `json:"-"`
+}
+
+// GetAddressesByOldCode requests to the kenall service to search current addresses by the old 3 or 5-digit postal code
+// used before 1998, e.g. "100" or "100-01". Only the addresses whose old code matches exactly are returned.
+func (cli *Client) GetAddressesByOldCode(
+	ctx context.Context, oldCode string, opts ...RequestOption,
+) (*GetAddressesByOldCodeResponse, error) {
+	ctx = contextWithRequestOptions(ctx, opts)
+
+	oldCode = strings.ReplaceAll(strings.TrimSpace(oldCode), "-", "")
+	if _, err := strconv.Atoi(oldCode); err != nil || (len(oldCode) != 3 && len(oldCode) != 5) {
+		return nil, ErrInvalidArgument
+	}
+
+	start := cli.clock.Now()
+	res := &GetAddressesByOldCodeResponse{}
+
+	pager := cli.SearchAddressesPager(ctx, oldCode, searchPageLimit)
+
+	err := pager.walk(ctx, nil, func(page *SearchAddressesResponse) error {
+		res.Version = page.Version
+		res.DecodeErrors = append(res.DecodeErrors, page.DecodeErrors...)
+		res.aggregate(&page.ResponseMeta)
+
+		for _, a := range page.Addresses {
+			if a.OldCode == oldCode {
+				res.Addresses = append(res.Addresses, a)
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res.Duration = cli.clock.Now().Sub(start)
+
+	return res, nil
+}
+
+// PostalCodes returns the distinct current postal codes of the addresses in order of appearance.
+func (r *GetAddressesByOldCodeResponse) PostalCodes() []string {
+	return distinctPostalCodes(r.Addresses)
+}
+
+func distinctPostalCodes(addresses []*Address) []string {
+	seen := make(map[string]bool, len(addresses))
+	codes := make([]string, 0, len(addresses))
+
+	for _, a := range addresses {
+		if !seen[a.PostalCode] {
+			seen[a.PostalCode] = true
+			codes = append(codes, a.PostalCode)
+		}
+	}
+
+	return codes
+}
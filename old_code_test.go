@@ -0,0 +1,81 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_GetAddressesByOldCode(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("q") == "107" {
+			// NOTE: the search never ends within the pages requested by the client.
+			_, _ = fmt.Fprint(w, `{"version":"2022-03-31","count":1000000,"data":[{"postal_code":"1070052","old_code":"107"}]}`)
+
+			return
+		}
+
+		if r.URL.Path != "/postalcode/" || q.Get("q") != "106" || q.Get("limit") != "100" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		var body string
+		switch offset, _ := strconv.Atoi(q.Get("offset")); offset {
+		case 0:
+			body = `{"version":"2022-03-31","count":3,"data":[{"postal_code":"1060032","old_code":"106"},{"postal_code":"1066090","old_code":"106"}]}`
+		case 2:
+			body = `{"version":"2022-03-31","count":3,"data":[{"postal_code":"1068622","old_code":"10601"}]}`
+		default:
+			body = `{"version":"2022-03-31","count":3,"data":[]}`
+		}
+
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		give      string
+		want      []string
+		wantError error
+	}{
+		"Normal case":    {give: "106", want: []string{"1060032", "1066090"}, wantError: nil},
+		"Not found":      {give: "100-01", want: nil, wantError: kenall.ErrNotFound},
+		"Invalid code":   {give: "1060", want: nil, wantError: kenall.ErrInvalidArgument},
+		"Too many pages": {give: "107", want: nil, wantError: kenall.ErrTooManyPages},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := cli.GetAddressesByOldCode(context.Background(), c.give)
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if res != nil && !reflect.DeepEqual(res.PostalCodes(), c.want) {
+				t.Errorf("give: %v, want: %v", res.PostalCodes(), c.want)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package kenall
 
-import "net/http"
+import (
+	"net/http"
+	"time"
+)
 
 type (
 	withHTTPClient struct {
@@ -9,6 +12,93 @@ type (
 	withEndpoint struct {
 		endpoint string
 	}
+	withRetryPolicy struct {
+		policy RetryPolicy
+	}
+	withIdempotency struct {
+		family     EndpointFamily
+		idempotent bool
+	}
+	withEndpointRateLimits struct {
+		rates map[EndpointFamily]Rate
+	}
+	withRateLimit struct {
+		rate Rate
+	}
+	withRateLimiter struct {
+		limiter RateLimiter
+	}
+	withClock struct {
+		clock Clock
+	}
+	withVersionSkewHandler struct {
+		handler VersionSkewHandler
+	}
+	withKanaScript struct {
+		script KanaScript
+	}
+	withTolerantDecoding struct {
+		handler DecodeErrorHandler
+	}
+	withValidation struct {
+		handler ValidationHandler
+	}
+	withAddressHook struct {
+		hook AddressHook
+	}
+	withCityHook struct {
+		hook CityHook
+	}
+	withCorporationHook struct {
+		hook CorporationHook
+	}
+	withPooledDecoding   struct{}
+	withStrictValidation struct{}
+	withMaxConcurrency   struct {
+		n int
+	}
+	withCache struct {
+		cache Cache
+	}
+	withCacheRevalidation struct {
+		maxAge time.Duration
+	}
+	withMiddleware struct {
+		middlewares []Middleware
+	}
+	withCallObserver struct {
+		observer CallObserver
+	}
+	withMetricsCollector struct {
+		collector MetricsCollector
+	}
+	withAPIVersion struct {
+		version string
+	}
+	withOperationAPIVersion struct {
+		operation string
+		version   string
+	}
+	withTokenProvider struct {
+		provider TokenProvider
+	}
+	withTimeout struct {
+		d time.Duration
+	}
+	withSingleflight struct{}
+	withCompression  struct {
+		enabled bool
+	}
+	withJSONCodec struct {
+		codec JSONCodec
+	}
+	withRecorder struct {
+		dir string
+	}
+	withStaleOnTimeout struct {
+		softDeadline time.Duration
+		maxEntries   int
+	}
 )
 
 // Apply implements kenall.ClientOption interface.
@@ -21,6 +111,52 @@ func (w *withEndpoint) Apply(cli *Client) {
 	cli.Endpoint = w.endpoint
 }
 
+// Apply implements kenall.ClientOption interface.
+func (w *withRetryPolicy) Apply(cli *Client) {
+	cli.retryPolicy = w.policy
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withIdempotency) Apply(cli *Client) {
+	cli.idempotency[w.family] = w.idempotent
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withEndpointRateLimits) Apply(cli *Client) {
+	for family, rate := range w.rates {
+		cli.rateLimiters[family] = newTokenBucket(rate)
+	}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withRateLimit) Apply(cli *Client) {
+	cli.globalRateLimiter = newTokenBucket(w.rate)
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withRateLimiter) Apply(cli *Client) {
+	cli.rateLimiter = w.limiter
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withClock) Apply(cli *Client) {
+	if w.clock == nil {
+		return
+	}
+
+	cli.clock = w.clock
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withVersionSkewHandler) Apply(cli *Client) {
+	cli.versions.handler = w.handler
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withKanaScript) Apply(cli *Client) {
+	cli.kanaScript = w.script
+}
+
 // WithHTTPClient injects optional HTTP Client to kenall.Client.
 func WithHTTPClient(cli *http.Client) ClientOption {
 	return &withHTTPClient{client: cli}
@@ -30,3 +166,316 @@ func WithHTTPClient(cli *http.Client) ClientOption {
 func WithEndpoint(endpoint string) ClientOption {
 	return &withEndpoint{endpoint: endpoint}
 }
+
+// WithRetryPolicy injects optional retry policy to kenall.Client.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return &withRetryPolicy{policy: policy}
+}
+
+// WithIdempotency overrides whether requests to the endpoint family are retried automatically,
+// e.g. to allow retrying a POST API known to be safe.
+func WithIdempotency(family EndpointFamily, idempotent bool) ClientOption {
+	return &withIdempotency{family: family, idempotent: idempotent}
+}
+
+// WithEndpointRateLimits injects optional request budgets for each endpoint family to kenall.Client,
+// since the kenall service enforces different limits for each API.
+func WithEndpointRateLimits(rates map[EndpointFamily]Rate) ClientOption {
+	return &withEndpointRateLimits{rates: rates}
+}
+
+// WithRateLimit injects optional request budget shared by all endpoint families to kenall.Client,
+// requests wait for the budget before being sent, including retries. It is combined with kenall.WithEndpointRateLimits.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return &withRateLimit{rate: Rate{QPS: rps, Burst: burst}}
+}
+
+// WithRateLimiter injects optional limiter shared by all endpoint families to kenall.Client, e.g. *rate.Limiter
+// shared with other clients. It is combined with kenall.WithRateLimit and kenall.WithEndpointRateLimits.
+func WithRateLimiter(limiter RateLimiter) ClientOption {
+	return &withRateLimiter{limiter: limiter}
+}
+
+// WithClock injects optional clock to kenall.Client, it is useful to test retries and rate limiting without real sleeps.
+// A nil clock keeps the system clock.
+func WithClock(clock Clock) ClientOption {
+	return &withClock{clock: clock}
+}
+
+// WithVersionSkewHandler injects optional handler to be notified when the data version of responses changes.
+func WithVersionSkewHandler(handler VersionSkewHandler) ClientOption {
+	return &withVersionSkewHandler{handler: handler}
+}
+
+// WithKanaScript injects optional script of kana fields in responses to kenall.Client,
+// it keeps kana consistent regardless of the representation of the kenall service.
+func WithKanaScript(script KanaScript) ClientOption {
+	return &withKanaScript{script: script}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withTolerantDecoding) Apply(cli *Client) {
+	cli.tolerant = true
+	cli.decodeErrorHandler = w.handler
+}
+
+// WithTolerantDecoding skips malformed elements of list responses instead of failing the whole response.
+// The errors of kenall.ElementDecodeError for the skipped elements are set to DecodeErrors of the response
+// and the handler is called with each of them if it is not nil.
+func WithTolerantDecoding(handler DecodeErrorHandler) ClientOption {
+	return &withTolerantDecoding{handler: handler}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withValidation) Apply(cli *Client) {
+	cli.validationHandler = w.handler
+}
+
+// WithValidation validates postal codes, JIS X 0402 codes and kana fields of responses
+// and calls the handler for each anomaly instead of failing the request.
+// JIS X 0402 codes are validated by the format and the prefecture code.
+func WithValidation(handler ValidationHandler) ClientOption {
+	return &withValidation{handler: handler}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withAddressHook) Apply(cli *Client) {
+	cli.hooks.addresses = append(cli.hooks.addresses, w.hook)
+}
+
+// WithAddressHook registers the hook called for each address of responses after it is decoded,
+// e.g. to trim whitespace or to map legacy names. Hooks run in order of registration after kenall.WithKanaScript.
+func WithAddressHook(hook AddressHook) ClientOption {
+	return &withAddressHook{hook: hook}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withCityHook) Apply(cli *Client) {
+	cli.hooks.cities = append(cli.hooks.cities, w.hook)
+}
+
+// WithCityHook registers the hook called for each city of responses after it is decoded.
+// Hooks run in order of registration after kenall.WithKanaScript.
+func WithCityHook(hook CityHook) ClientOption {
+	return &withCityHook{hook: hook}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withCorporationHook) Apply(cli *Client) {
+	cli.hooks.corporations = append(cli.hooks.corporations, w.hook)
+}
+
+// WithCorporationHook registers the hook called for each corporation of responses after it is decoded.
+// Hooks run in order of registration after kenall.WithKanaScript.
+func WithCorporationHook(hook CorporationHook) ClientOption {
+	return &withCorporationHook{hook: hook}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withPooledDecoding) Apply(cli *Client) {
+	cli.pooled = true
+}
+
+// WithPooledDecoding decodes the addresses and the cities of kenall.GetAddressResponse, kenall.GetCityResponse and
+// kenall.GetNormalizeAddressResponse into objects from pools to reduce allocations in high-throughput services.
+//
+// The caller owns a pooled response and should call its Release method once it is no longer used,
+// after which neither the response nor any of its records may be used, including by other goroutines.
+// Records needed beyond the release must be copied with Clone beforehand. An unreleased response is
+// simply garbage collected. The records are decoded with kenall.WithJSONCodec if it is given.
+// The option has no effect with kenall.WithTolerantDecoding.
+func WithPooledDecoding() ClientOption {
+	return &withPooledDecoding{}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withStaleOnTimeout) Apply(cli *Client) {
+	cli.stale = newStaleCache(w.softDeadline, w.maxEntries)
+}
+
+// WithStaleOnTimeout keeps the latest results of GET requests for up to maxEntries requests, zero means no limit,
+// and returns the kept result flagged Stale when a request does not complete within the soft deadline,
+// while the request continues in the background to update the kept result.
+// Without a kept result the request waits as usual. The background request is bounded by kenall.WithRequestTimeout,
+// kenall.WithTimeout or the timeout of the HTTP client in this order, and by 30 seconds if none of them is set.
+func WithStaleOnTimeout(softDeadline time.Duration, maxEntries int) ClientOption {
+	return &withStaleOnTimeout{softDeadline: softDeadline, maxEntries: maxEntries}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withMaxConcurrency) Apply(cli *Client) {
+	if w.n > 0 {
+		cli.maxConcurrency = w.n
+	}
+}
+
+// WithMaxConcurrency limits the number of concurrent requests of the batch APIs, e.g. kenall.Client.GetAddresses.
+// A zero or negative value keeps the default.
+func WithMaxConcurrency(n int) ClientOption {
+	return &withMaxConcurrency{n: n}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withCache) Apply(cli *Client) {
+	cli.cache = w.cache
+}
+
+// WithCache injects optional cache of the responses to kenall.Client, e.g. kenall.NewMemoryCache.
+// GET requests hitting the cache skip the network entirely, the responses of the whoami API are not cached.
+func WithCache(cache Cache) ClientOption {
+	return &withCache{cache: cache}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withCacheRevalidation) Apply(cli *Client) {
+	cli.cacheMaxAge = w.maxAge
+}
+
+// WithCacheRevalidation revalidates the responses cached by kenall.WithCache after the max age
+// with the If-None-Match and If-Modified-Since headers, a not modified response is used as a cache hit.
+// It saves bandwidth for large responses refreshed periodically, e.g. holidays and cities.
+// Without the option cached responses are used until the cache drops them.
+func WithCacheRevalidation(maxAge time.Duration) ClientOption {
+	return &withCacheRevalidation{maxAge: maxAge}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withMiddleware) Apply(cli *Client) {
+	cli.middlewares = append(cli.middlewares, w.middlewares...)
+}
+
+// WithMiddleware injects optional middlewares wrapping each attempt of the requests to kenall.Client,
+// without replacing the transport of the HTTP client. The first given middleware is the outermost.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return &withMiddleware{middlewares: middlewares}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withCallObserver) Apply(cli *Client) {
+	cli.observers = append(cli.observers, w.observer)
+}
+
+// WithCallObserver injects optional observer of the API calls to kenall.Client, e.g. for tracing or metrics.
+// Observers are called in order of registration at the start and in reverse order at the end of a call.
+func WithCallObserver(observer CallObserver) ClientOption {
+	return &withCallObserver{observer: observer}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withMetricsCollector) Apply(cli *Client) {
+	cli.observers = append(cli.observers, metricsObserver(w.collector))
+}
+
+// WithMetricsCollector injects optional collector of the API call metrics to kenall.Client,
+// e.g. kenall.NewPrometheusCollector.
+func WithMetricsCollector(collector MetricsCollector) ClientOption {
+	return &withMetricsCollector{collector: collector}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withAPIVersion) Apply(cli *Client) {
+	cli.apiVersion = w.version
+}
+
+// WithAPIVersion injects optional API version, e.g. "v2", to kenall.Client. The version replaces the one
+// at the end of the endpoint, or is appended if the endpoint does not end with a version.
+func WithAPIVersion(version string) ClientOption {
+	return &withAPIVersion{version: version}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withOperationAPIVersion) Apply(cli *Client) {
+	if cli.operationVersions == nil {
+		cli.operationVersions = map[string]string{}
+	}
+
+	cli.operationVersions[w.operation] = w.version
+}
+
+// WithOperationAPIVersion overrides the API version of the operation, e.g. "GetAddress",
+// for an endpoint that only exists in the version.
+func WithOperationAPIVersion(operation, version string) ClientOption {
+	return &withOperationAPIVersion{operation: operation, version: version}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withTokenProvider) Apply(cli *Client) {
+	cli.tokenProvider = w.provider
+}
+
+// WithTokenProvider injects optional token provider to kenall.Client, it is called for each request
+// instead of using the token given to kenall.NewClient so that the token can be rotated at runtime.
+func WithTokenProvider(provider TokenProvider) ClientOption {
+	return &withTokenProvider{provider: provider}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withStrictValidation) Apply(cli *Client) {
+	cli.strictValidation = true
+}
+
+// WithStrictValidation disables the normalization of postal codes given to kenall.Client,
+// e.g. "100-8105" is rejected with kenall.ErrInvalidArgument.
+func WithStrictValidation() ClientOption {
+	return &withStrictValidation{}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withTimeout) Apply(cli *Client) {
+	cli.timeout = w.d
+}
+
+// WithTimeout sets the default deadline of each API call applied when the given context has no deadline,
+// it includes the retries. kenall.WithRequestTimeout overrides it for a single call.
+func WithTimeout(d time.Duration) ClientOption {
+	return &withTimeout{d: d}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withSingleflight) Apply(cli *Client) {
+	cli.flights = newFlightGroup()
+}
+
+// WithSingleflight coalesces the identical GET requests in flight into one, keyed by the method, the URL
+// and the token, so that the callers share the decoded response and must not modify the values referred by it.
+// It is disabled with kenall.WithPooledDecoding.
+func WithSingleflight() ClientOption {
+	return &withSingleflight{}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withCompression) Apply(cli *Client) {
+	cli.compression = w.enabled
+}
+
+// WithCompression toggles requesting gzip or deflate compressed responses, it is enabled by default.
+// The compressed responses are decompressed by kenall.Client regardless of http.Client.
+func WithCompression(enabled bool) ClientOption {
+	return &withCompression{enabled: enabled}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withJSONCodec) Apply(cli *Client) {
+	codec := w.codec
+	cli.codec = &codec
+}
+
+// WithJSONCodec replaces encoding/json to decode the responses and the cached ones, e.g. with json-iterator,
+// sonic or go-json. Both of the functions are required. The custom JSON methods of the types must be respected
+// by the codec, and kenall.WithTolerantDecoding keeps using encoding/json.
+func WithJSONCodec(codec JSONCodec) ClientOption {
+	return &withJSONCodec{codec: codec}
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withRecorder) Apply(cli *Client) {
+	cli.middlewares = append(cli.middlewares, recorder(w.dir))
+}
+
+// WithRecorder saves every response as a fixture file in the directory, which is created if it does not exist,
+// for golden tests replaying them with kenall.NewReplayTransport. The fixture has the method, the URL, the status
+// code, a few response headers and the body, but no request headers, so the authorization token is stripped.
+// A response of the same request overwrites the previous fixture.
+func WithRecorder(dir string) ClientOption {
+	return &withRecorder{dir: dir}
+}
@@ -1,6 +1,8 @@
 package kenall_test
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	"github.com/osamingo/go-kenall/v2"
@@ -23,3 +25,165 @@ func TestWithEndpoint(t *testing.T) {
 		t.Error("a return value should not be nil")
 	}
 }
+
+func TestWithRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithRetryPolicy(kenall.RetryPolicy{})
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithIdempotency(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithIdempotency(kenall.EndpointFamilyPostalCode, false)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithEndpointRateLimits(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithEndpointRateLimits(nil)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithClock(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithClock(nil)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), ret)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetAddress(context.Background(), "1008105"); err != nil {
+		t.Errorf("give: %v, want: the system clock kept for a nil clock", err)
+	}
+}
+
+func TestWithVersionSkewHandler(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithVersionSkewHandler(nil)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithKanaScript(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithKanaScript(kenall.KanaScriptKatakana)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithTolerantDecoding_Option(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithTolerantDecoding(nil)
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithValidation(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithValidation(func(kenall.ValidationWarning) {})
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithAddressHook(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithAddressHook(func(*kenall.Address) {})
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithCityHook(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithCityHook(func(*kenall.City) {})
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithCorporationHook(t *testing.T) {
+	t.Parallel()
+
+	ret := kenall.WithCorporationHook(func(*kenall.Corporation) {})
+	if ret == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	t.Parallel()
+
+	if kenall.WithRateLimit(10, 1) == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	if kenall.WithRateLimiter(nil) == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestWithStrictValidation(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		opts    []kenall.ClientOption
+		give    string
+		wantErr error
+	}{
+		"Normalized":        {give: "〒100-8105", wantErr: nil},
+		"Full-width":        {give: "１００８１０５", wantErr: nil},
+		"Strict":            {opts: []kenall.ClientOption{kenall.WithStrictValidation()}, give: "100-8105", wantErr: kenall.ErrInvalidArgument},
+		"Strict and digits": {opts: []kenall.ClientOption{kenall.WithStrictValidation()}, give: "1008105", wantErr: nil},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", append(c.opts, kenall.WithEndpoint(srv.URL))...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := cli.GetAddress(context.Background(), c.give); !errors.Is(err, c.wantErr) {
+				t.Errorf("give: %v, want: %v", err, c.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package kenall
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	// searchPageLimit is the number of items requested for each page of the search APIs.
+	searchPageLimit = 100
+	// maxSearchPages is the maximum number of pages requested for a search to protect from runaway pagination.
+	maxSearchPages = 100
+)
+
+// A SearchAddressesPager walks the pages of kenall.Client.SearchAddresses by the offset and the limit.
+//
+//	pager := cli.SearchAddressesPager(ctx, "千代田", 100)
+//	for pager.More() {
+//		page, err := pager.NextPage(ctx)
+//		...
+//	}
+type SearchAddressesPager struct {
+	ctx      context.Context //nolint: containedctx
+	cli      *Client
+	query    string
+	opts     []SearchOption
+	pageSize int
+	offset   int
+	done     bool
+}
+
+// SearchAddressesPager returns the pager of the addresses searched by the query, each page has up to pageSize
+// addresses. The ctx bounds the whole pagination, and kenall.WithLimit and kenall.WithOffset of the opts
+// are overridden by the pager.
+func (cli *Client) SearchAddressesPager(
+	ctx context.Context, query string, pageSize int, opts ...SearchOption,
+) *SearchAddressesPager {
+	return &SearchAddressesPager{
+		ctx:      ctx,
+		cli:      cli,
+		query:    query,
+		opts:     opts,
+		pageSize: pageSize,
+	}
+}
+
+// More returns true if the next page may exist.
+func (p *SearchAddressesPager) More() bool {
+	return !p.done
+}
+
+// NextPage requests the next page, it returns kenall.ErrNoMorePages after the last page.
+func (p *SearchAddressesPager) NextPage(ctx context.Context) (*SearchAddressesResponse, error) {
+	if p.done {
+		return nil, ErrNoMorePages
+	}
+
+	if p.pageSize <= 0 {
+		return nil, ErrInvalidArgument
+	}
+
+	if err := p.ctx.Err(); err != nil {
+		return nil, fmt.Errorf("kenall: the pagination is canceled: %w", err)
+	}
+
+	opts := make([]SearchOption, 0, len(p.opts)+2) //nolint: gomnd
+	opts = append(opts, p.opts...)
+	opts = append(opts, WithLimit(p.pageSize), WithOffset(p.offset))
+
+	res, err := p.cli.SearchAddresses(ctx, p.query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.offset += len(res.Addresses); len(res.Addresses) == 0 || p.offset >= res.Count {
+		p.done = true
+	}
+
+	return res, nil
+}
+
+// All requests the remaining pages and returns the addresses of them.
+func (p *SearchAddressesPager) All(ctx context.Context) ([]*Address, error) {
+	var addresses []*Address
+
+	for p.More() {
+		res, err := p.NextPage(ctx)
+		if err != nil {
+			return addresses, err
+		}
+
+		addresses = append(addresses, res.Addresses...)
+	}
+
+	return addresses, nil
+}
+
+// walk requests the remaining pages and calls the function for each page.
+// If the limiter is not nil, it is waited for before each request in addition to the rate limits of the client.
+// It returns an error wrapping kenall.ErrTooManyPages if the search does not end within maxSearchPages.
+func (p *SearchAddressesPager) walk(
+	ctx context.Context, limiter *tokenBucket, fn func(*SearchAddressesResponse) error,
+) error {
+	for page := 0; p.More(); page++ {
+		if page == maxSearchPages {
+			return fmt.Errorf("kenall: the search exceeds %d pages, q = %s: %w", maxSearchPages, p.query, ErrTooManyPages)
+		}
+
+		if limiter != nil {
+			if err := limiter.Wait(ctx, p.cli.clock); err != nil {
+				return fmt.Errorf("kenall: failed to wait for the rate limit: %w", err)
+			}
+		}
+
+		res, err := p.NextPage(ctx)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
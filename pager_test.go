@@ -0,0 +1,118 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_SearchAddressesPager(t *testing.T) {
+	t.Parallel()
+
+	codes := []string{"1000001", "1000002", "1000003", "1000004", "1000005"}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if r.URL.Path != "/postalcode/" || q.Get("q") != "千代田" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		offset, _ := strconv.Atoi(q.Get("offset"))
+		limit, _ := strconv.Atoi(q.Get("limit"))
+
+		data := ""
+		for i := offset; i < offset+limit && i < len(codes); i++ {
+			if data != "" {
+				data += ","
+			}
+			data += `{"postal_code":"` + codes[i] + `"}`
+		}
+
+		if _, err := fmt.Fprintf(w, `{"version":"2022-03-31","count":%d,"offset":%d,"limit":%d,"data":[%s]}`,
+			len(codes), offset, limit, data); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	t.Run("NextPage", func(t *testing.T) {
+		t.Parallel()
+
+		pager := cli.SearchAddressesPager(ctx, "千代田", 2)
+
+		var sizes []int
+		for pager.More() {
+			page, err := pager.NextPage(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sizes = append(sizes, len(page.Addresses))
+		}
+
+		if fmt.Sprint(sizes) != "[2 2 1]" {
+			t.Errorf("give: %v, want: [2 2 1]", sizes)
+		}
+
+		if _, err := pager.NextPage(ctx); !errors.Is(err, kenall.ErrNoMorePages) {
+			t.Errorf("give: %v, want: %v", err, kenall.ErrNoMorePages)
+		}
+	})
+
+	t.Run("All", func(t *testing.T) {
+		t.Parallel()
+
+		addresses, err := cli.SearchAddressesPager(ctx, "千代田", 3).All(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(addresses) != len(codes) || addresses[4].PostalCode != codes[4] {
+			t.Errorf("give: %d addresses, want: %d addresses", len(addresses), len(codes))
+		}
+	})
+
+	t.Run("Canceled", func(t *testing.T) {
+		t.Parallel()
+
+		cctx, cancel := context.WithCancel(ctx)
+		pager := cli.SearchAddressesPager(cctx, "千代田", 2)
+
+		if _, err := pager.NextPage(ctx); err != nil {
+			t.Fatal(err)
+		}
+
+		cancel()
+
+		addresses, err := pager.All(ctx)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("give: %v, want: %v", err, context.Canceled)
+		}
+
+		if len(addresses) != 0 {
+			t.Errorf("give: %d addresses, want: no addresses after the cancellation", len(addresses))
+		}
+	})
+
+	t.Run("Invalid page size", func(t *testing.T) {
+		t.Parallel()
+
+		if _, err := cli.SearchAddressesPager(ctx, "千代田", 0).NextPage(ctx); !errors.Is(err, kenall.ErrInvalidArgument) {
+			t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+		}
+	})
+}
@@ -0,0 +1,266 @@
+package kenall
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+type (
+	// pooledDecoder is a response which can decode its records into pooled objects, see kenall.WithPooledDecoding.
+	pooledDecoder interface {
+		decodePooled(cli *Client, r io.Reader) error
+	}
+
+	// pooledAddresses and pooledCities decode the records with the codec of kenall.WithJSONCodec if it is not nil.
+	pooledAddresses struct {
+		codec *JSONCodec
+		list  []*Address
+	}
+	pooledCities struct {
+		codec *JSONCodec
+		list  []*City
+	}
+
+	addressResponseAlias          GetAddressResponse
+	cityResponseAlias             GetCityResponse
+	normalizeAddressResponseAlias GetNormalizeAddressResponse
+)
+
+var (
+	addressPool      = sync.Pool{New: func() interface{} { return new(Address) }}    //nolint: gochecknoglobals
+	cityPool         = sync.Pool{New: func() interface{} { return new(City) }}       //nolint: gochecknoglobals
+	addressSlicePool = sync.Pool{New: func() interface{} { return new([]*Address) }} //nolint: gochecknoglobals
+	citySlicePool    = sync.Pool{New: func() interface{} { return new([]*City) }}    //nolint: gochecknoglobals
+
+	_ pooledDecoder = (*GetAddressResponse)(nil)
+	_ pooledDecoder = (*GetCityResponse)(nil)
+	_ pooledDecoder = (*GetNormalizeAddressResponse)(nil)
+)
+
+func (r *GetAddressResponse) decodePooled(cli *Client, rd io.Reader) error {
+	tmp := struct {
+		*addressResponseAlias
+		Addresses pooledAddresses `json:"data"`
+	}{addressResponseAlias: (*addressResponseAlias)(r), Addresses: pooledAddresses{codec: cli.codec}}
+
+	if err := cli.decodeJSON(rd, &tmp); err != nil {
+		releaseAddresses(tmp.Addresses.list)
+
+		return err
+	}
+
+	r.Addresses = tmp.Addresses.list
+	atomic.StoreInt32(&r.pooled, 1)
+
+	return nil
+}
+
+// Release returns the addresses of the response decoded by kenall.WithPooledDecoding to the pool.
+// The response and its addresses must not be used after the release, call Clone beforehand to keep them.
+// Release is a no-op for a response not decoded into the pool or already released.
+func (r *GetAddressResponse) Release() {
+	if r == nil || !atomic.CompareAndSwapInt32(&r.pooled, 1, 0) {
+		return
+	}
+
+	releaseAddresses(r.Addresses)
+	r.Addresses = nil
+}
+
+// reset clears the response to be decoded again, the addresses are zeroed and kept to be reused by the decoder
+// unless they are shared with the other callers by kenall.WithSingleflight.
+func (r *GetAddressResponse) reset() {
+	r.Release()
+
+	if r.shared {
+		*r = GetAddressResponse{}
+
+		return
+	}
+
+	addrs := r.Addresses[:cap(r.Addresses)]
+	for _, a := range addrs {
+		if a != nil {
+			*a = Address{}
+		}
+	}
+
+	*r = GetAddressResponse{Addresses: addrs[:0]}
+}
+
+func (r *GetCityResponse) decodePooled(cli *Client, rd io.Reader) error {
+	tmp := struct {
+		*cityResponseAlias
+		Cities pooledCities `json:"data"`
+	}{cityResponseAlias: (*cityResponseAlias)(r), Cities: pooledCities{codec: cli.codec}}
+
+	if err := cli.decodeJSON(rd, &tmp); err != nil {
+		releaseCities(tmp.Cities.list)
+
+		return err
+	}
+
+	r.Cities = tmp.Cities.list
+	atomic.StoreInt32(&r.pooled, 1)
+
+	return nil
+}
+
+// Release returns the cities of the response decoded by kenall.WithPooledDecoding to the pool.
+// The response and its cities must not be used after the release, call Clone beforehand to keep them.
+// Release is a no-op for a response not decoded into the pool or already released.
+func (r *GetCityResponse) Release() {
+	if r == nil || !atomic.CompareAndSwapInt32(&r.pooled, 1, 0) {
+		return
+	}
+
+	releaseCities(r.Cities)
+	r.Cities = nil
+}
+
+func (r *GetNormalizeAddressResponse) decodePooled(cli *Client, rd io.Reader) error {
+	tmp := struct {
+		*normalizeAddressResponseAlias
+		Addresses pooledAddresses `json:"data"`
+	}{normalizeAddressResponseAlias: (*normalizeAddressResponseAlias)(r), Addresses: pooledAddresses{codec: cli.codec}}
+
+	if err := cli.decodeJSON(rd, &tmp); err != nil {
+		releaseAddresses(tmp.Addresses.list)
+
+		return err
+	}
+
+	r.Addresses = tmp.Addresses.list
+	atomic.StoreInt32(&r.pooled, 1)
+
+	return nil
+}
+
+// Release returns the addresses of the response decoded by kenall.WithPooledDecoding to the pool.
+// The response and its addresses must not be used after the release, call Clone beforehand to keep them.
+// Release is a no-op for a response not decoded into the pool or already released.
+func (r *GetNormalizeAddressResponse) Release() {
+	if r == nil || !atomic.CompareAndSwapInt32(&r.pooled, 1, 0) {
+		return
+	}
+
+	releaseAddresses(r.Addresses)
+	r.Addresses = nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (p *pooledAddresses) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullLiteral) {
+		return nil
+	}
+
+	list := (*addressSlicePool.Get().(*[]*Address))[:0]
+
+	err := decodeArray(data, p.codec, func() interface{} {
+		a, _ := addressPool.Get().(*Address)
+		list = append(list, a)
+
+		return a
+	})
+	if err != nil {
+		releaseAddresses(list)
+
+		return err
+	}
+
+	p.list = list
+
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface.
+func (p *pooledCities) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullLiteral) {
+		return nil
+	}
+
+	list := (*citySlicePool.Get().(*[]*City))[:0]
+
+	err := decodeArray(data, p.codec, func() interface{} {
+		c, _ := cityPool.Get().(*City)
+		list = append(list, c)
+
+		return c
+	})
+	if err != nil {
+		releaseCities(list)
+
+		return err
+	}
+
+	p.list = list
+
+	return nil
+}
+
+// decodeArray decodes each element of the JSON array into the value returned by next, the elements are streamed
+// with encoding/json unless the codec of kenall.WithJSONCodec is given.
+func decodeArray(data []byte, codec *JSONCodec, next func() interface{}) error {
+	if codec != nil {
+		var raws []json.RawMessage
+		if err := codec.Unmarshal(data, &raws); err != nil {
+			return fmt.Errorf("kenall: failed to decode to response: %w", err)
+		}
+
+		for _, raw := range raws {
+			if err := codec.Unmarshal(raw, next()); err != nil {
+				return fmt.Errorf("kenall: failed to decode to response: %w", err)
+			}
+		}
+
+		return nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('[') {
+		return errors.New("kenall: failed to decode to response: data is not an array") //nolint: goerr113
+	}
+
+	for dec.More() {
+		if err := dec.Decode(next()); err != nil {
+			return fmt.Errorf("kenall: failed to decode to response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func releaseAddresses(list []*Address) {
+	if list == nil {
+		return
+	}
+
+	for i, a := range list {
+		*a = Address{}
+		addressPool.Put(a)
+		list[i] = nil
+	}
+
+	list = list[:0]
+	addressSlicePool.Put(&list)
+}
+
+func releaseCities(list []*City) {
+	if list == nil {
+		return
+	}
+
+	for i, c := range list {
+		*c = City{}
+		cityPool.Put(c)
+		list[i] = nil
+	}
+
+	list = list[:0]
+	citySlicePool.Put(&list)
+}
@@ -0,0 +1,226 @@
+package kenall_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithPooledDecoding(t *testing.T) {
+	t.Parallel()
+
+	if kenall.WithPooledDecoding() == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestGetAddressResponse_Release(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+
+	plain, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pooled, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithPooledDecoding())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := plain.GetAddress(ctx, "1008105")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := pooled.GetAddress(ctx, "1008105")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(res.Addresses, want.Addresses) || !reflect.DeepEqual(res.Version, want.Version) {
+		t.Errorf("give: %+v, want: %+v", res.Addresses, want.Addresses)
+	}
+
+	kept := res.Clone()
+
+	res.Release()
+	res.Release()
+
+	if res.Addresses != nil {
+		t.Error("addresses should be nil after the release")
+	}
+	if !reflect.DeepEqual(kept.Addresses, want.Addresses) {
+		t.Errorf("give: %+v, want: the clone kept after the release", kept.Addresses)
+	}
+
+	want.Release()
+	if want.Addresses == nil {
+		t.Error("a response not decoded into the pool should not be released")
+	}
+
+	var nilRes *kenall.GetAddressResponse
+	nilRes.Release()
+}
+
+func TestClient_GetAddressInto(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	ctx := context.Background()
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want, err := cli.GetAddress(ctx, "1008105")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := &kenall.GetAddressResponse{
+		Addresses: []*kenall.Address{{Building: "stale", Floor: "99"}},
+		Stale:     true,
+	}
+	reused := res.Addresses[0]
+
+	if err := cli.GetAddressInto(ctx, "1008105", res); err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(res.Addresses, want.Addresses) || res.Stale {
+		t.Errorf("give: %+v, want: %+v", res.Addresses, want.Addresses)
+	}
+	if res.Addresses[0] != reused {
+		t.Error("the address should be reused")
+	}
+
+	if err := cli.GetAddressInto(ctx, "1008105", nil); err == nil {
+		t.Error("an error should not be nil")
+	}
+	if err := cli.GetAddressInto(ctx, "abc", res); err == nil {
+		t.Error("an error should not be nil")
+	}
+}
+
+func BenchmarkClient_GetAddressInto(b *testing.B) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(addressResponse)
+	}))
+	b.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	b.Run("GetAddress", func(b *testing.B) {
+		b.ReportAllocs()
+
+		for i := 0; i < b.N; i++ {
+			if _, err := cli.GetAddress(ctx, "1008105"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("GetAddressInto", func(b *testing.B) {
+		b.ReportAllocs()
+
+		var res kenall.GetAddressResponse
+		for i := 0; i < b.N; i++ {
+			if err := cli.GetAddressInto(ctx, "1008105", &res); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestGetCityResponse_Release(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithPooledDecoding())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cli.GetCity(context.Background(), "13")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Cities) == 0 || res.Cities[0].JISX0402 != "13101" {
+		t.Errorf("give: %+v, want: the cities of Tokyo", res.Cities)
+	}
+
+	res.Release()
+
+	if res.Cities != nil {
+		t.Error("cities should be nil after the release")
+	}
+}
+
+// TestPooledDecoding_Concurrent is meant to be run with the race detector to check the ownership rules.
+func TestPooledDecoding_Concurrent(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithPooledDecoding())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const n = 16
+
+	var (
+		wg    sync.WaitGroup
+		kepts = make([]*kenall.GetAddressResponse, n)
+	)
+
+	for i := 0; i < n; i++ {
+		i := i
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			res, err := cli.GetAddress(context.Background(), "1008105")
+			if err != nil {
+				t.Error(err)
+
+				return
+			}
+
+			kepts[i] = res.Clone()
+			res.Release()
+		}()
+	}
+
+	wg.Wait()
+
+	for _, kept := range kepts {
+		if kept == nil || len(kept.Addresses) == 0 || kept.Addresses[0].Town != "西新宿" {
+			t.Errorf("give: %+v, want: the clone not reused by the pool", kept)
+		}
+	}
+}
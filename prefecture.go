@@ -0,0 +1,187 @@
+package kenall
+
+import (
+	"fmt"
+	"strings"
+)
+
+// A Prefecture is a prefecture defined by JIS X 0401.
+type Prefecture struct {
+	// Code is the 2-digit prefecture code, e.g. "13".
+	Code string `json:"code"`
+	// Name is the prefecture name, e.g. "東京都".
+	Name string `json:"name"`
+	// Kana is the prefecture name in katakana, e.g. "トウキョウト".
+	Kana string `json:"kana"`
+	// EnglishName is the prefecture name in English without the suffix, e.g. "Tokyo".
+	EnglishName string `json:"english_name"`
+}
+
+// prefectures is the table of prefectures defined by JIS X 0401.
+var prefectures = []Prefecture{ //nolint: gochecknoglobals
+	{Code: "01", Name: "北海道", Kana: "ホッカイドウ", EnglishName: "Hokkaido"},
+	{Code: "02", Name: "青森県", Kana: "アオモリケン", EnglishName: "Aomori"},
+	{Code: "03", Name: "岩手県", Kana: "イワテケン", EnglishName: "Iwate"},
+	{Code: "04", Name: "宮城県", Kana: "ミヤギケン", EnglishName: "Miyagi"},
+	{Code: "05", Name: "秋田県", Kana: "アキタケン", EnglishName: "Akita"},
+	{Code: "06", Name: "山形県", Kana: "ヤマガタケン", EnglishName: "Yamagata"},
+	{Code: "07", Name: "福島県", Kana: "フクシマケン", EnglishName: "Fukushima"},
+	{Code: "08", Name: "茨城県", Kana: "イバラキケン", EnglishName: "Ibaraki"},
+	{Code: "09", Name: "栃木県", Kana: "トチギケン", EnglishName: "Tochigi"},
+	{Code: "10", Name: "群馬県", Kana: "グンマケン", EnglishName: "Gunma"},
+	{Code: "11", Name: "埼玉県", Kana: "サイタマケン", EnglishName: "Saitama"},
+	{Code: "12", Name: "千葉県", Kana: "チバケン", EnglishName: "Chiba"},
+	{Code: "13", Name: "東京都", Kana: "トウキョウト", EnglishName: "Tokyo"},
+	{Code: "14", Name: "神奈川県", Kana: "カナガワケン", EnglishName: "Kanagawa"},
+	{Code: "15", Name: "新潟県", Kana: "ニイガタケン", EnglishName: "Niigata"},
+	{Code: "16", Name: "富山県", Kana: "トヤマケン", EnglishName: "Toyama"},
+	{Code: "17", Name: "石川県", Kana: "イシカワケン", EnglishName: "Ishikawa"},
+	{Code: "18", Name: "福井県", Kana: "フクイケン", EnglishName: "Fukui"},
+	{Code: "19", Name: "山梨県", Kana: "ヤマナシケン", EnglishName: "Yamanashi"},
+	{Code: "20", Name: "長野県", Kana: "ナガノケン", EnglishName: "Nagano"},
+	{Code: "21", Name: "岐阜県", Kana: "ギフケン", EnglishName: "Gifu"},
+	{Code: "22", Name: "静岡県", Kana: "シズオカケン", EnglishName: "Shizuoka"},
+	{Code: "23", Name: "愛知県", Kana: "アイチケン", EnglishName: "Aichi"},
+	{Code: "24", Name: "三重県", Kana: "ミエケン", EnglishName: "Mie"},
+	{Code: "25", Name: "滋賀県", Kana: "シガケン", EnglishName: "Shiga"},
+	{Code: "26", Name: "京都府", Kana: "キョウトフ", EnglishName: "Kyoto"},
+	{Code: "27", Name: "大阪府", Kana: "オオサカフ", EnglishName: "Osaka"},
+	{Code: "28", Name: "兵庫県", Kana: "ヒョウゴケン", EnglishName: "Hyogo"},
+	{Code: "29", Name: "奈良県", Kana: "ナラケン", EnglishName: "Nara"},
+	{Code: "30", Name: "和歌山県", Kana: "ワカヤマケン", EnglishName: "Wakayama"},
+	{Code: "31", Name: "鳥取県", Kana: "トットリケン", EnglishName: "Tottori"},
+	{Code: "32", Name: "島根県", Kana: "シマネケン", EnglishName: "Shimane"},
+	{Code: "33", Name: "岡山県", Kana: "オカヤマケン", EnglishName: "Okayama"},
+	{Code: "34", Name: "広島県", Kana: "ヒロシマケン", EnglishName: "Hiroshima"},
+	{Code: "35", Name: "山口県", Kana: "ヤマグチケン", EnglishName: "Yamaguchi"},
+	{Code: "36", Name: "徳島県", Kana: "トクシマケン", EnglishName: "Tokushima"},
+	{Code: "37", Name: "香川県", Kana: "カガワケン", EnglishName: "Kagawa"},
+	{Code: "38", Name: "愛媛県", Kana: "エヒメケン", EnglishName: "Ehime"},
+	{Code: "39", Name: "高知県", Kana: "コウチケン", EnglishName: "Kochi"},
+	{Code: "40", Name: "福岡県", Kana: "フクオカケン", EnglishName: "Fukuoka"},
+	{Code: "41", Name: "佐賀県", Kana: "サガケン", EnglishName: "Saga"},
+	{Code: "42", Name: "長崎県", Kana: "ナガサキケン", EnglishName: "Nagasaki"},
+	{Code: "43", Name: "熊本県", Kana: "クマモトケン", EnglishName: "Kumamoto"},
+	{Code: "44", Name: "大分県", Kana: "オオイタケン", EnglishName: "Oita"},
+	{Code: "45", Name: "宮崎県", Kana: "ミヤザキケン", EnglishName: "Miyazaki"},
+	{Code: "46", Name: "鹿児島県", Kana: "カゴシマケン", EnglishName: "Kagoshima"},
+	{Code: "47", Name: "沖縄県", Kana: "オキナワケン", EnglishName: "Okinawa"},
+}
+
+// Prefectures returns the 47 prefectures defined by JIS X 0401 in order of the code, e.g. for a dropdown of UIs
+// without requests to the kenall service.
+func Prefectures() []*Prefecture {
+	ps := make([]*Prefecture, 0, len(prefectures))
+	for _, p := range prefectures {
+		p := p
+		ps = append(ps, &p)
+	}
+
+	return ps
+}
+
+// PrefectureByCode returns the prefecture for the prefecture code defined by JIS X 0401, e.g. "13".
+func PrefectureByCode(code string) (*Prefecture, error) {
+	p, err := prefectureByCode(code)
+	if err != nil {
+		return nil, err
+	}
+
+	v := *p
+
+	return &v, nil
+}
+
+// PrefectureByName returns the prefecture for the name, the suffix like "都" may be omitted and the English name
+// is accepted case-insensitively, e.g. "東京都", "東京" and "tokyo" return the prefecture of "13".
+func PrefectureByName(name string) (*Prefecture, error) {
+	name = strings.TrimSpace(name)
+	for _, p := range prefectures {
+		if name == p.Name || name == trimPrefectureSuffix(p.Name) || strings.EqualFold(name, p.EnglishName) {
+			p := p
+
+			return &p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("kenall: undefined prefecture name, name = %s: %w", name, ErrInvalidArgument)
+}
+
+// PrefectureNameToCode returns the prefecture code defined by JIS X 0401 for the name,
+// the suffix like "都" may be omitted, e.g. both "東京都" and "東京" return "13".
+func PrefectureNameToCode(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	for _, p := range prefectures {
+		if name == p.Name || name == trimPrefectureSuffix(p.Name) {
+			return p.Code, nil
+		}
+	}
+
+	return "", fmt.Errorf("kenall: undefined prefecture name, name = %s: %w", name, ErrInvalidArgument)
+}
+
+// PrefectureKanaToCode returns the prefecture code defined by JIS X 0401 for the kana in katakana or hiragana,
+// the suffix like "ト" may be omitted, e.g. both "トウキョウト" and "とうきょう" return "13".
+func PrefectureKanaToCode(kana string) (string, error) {
+	kana = KanaScriptKatakana.convert(strings.TrimSpace(kana))
+	for _, p := range prefectures {
+		if kana == p.Kana || kana == trimPrefectureKanaSuffix(p.Kana) {
+			return p.Code, nil
+		}
+	}
+
+	return "", fmt.Errorf("kenall: undefined prefecture kana, kana = %s: %w", kana, ErrInvalidArgument)
+}
+
+// PrefectureCodeToName returns the prefecture name for the prefecture code defined by JIS X 0401, e.g. "東京都" for "13".
+func PrefectureCodeToName(code string) (string, error) {
+	p, err := prefectureByCode(code)
+	if err != nil {
+		return "", err
+	}
+
+	return p.Name, nil
+}
+
+// PrefectureCodeToKana returns the prefecture kana in katakana for the prefecture code defined by JIS X 0401,
+// e.g. "トウキョウト" for "13".
+func PrefectureCodeToKana(code string) (string, error) {
+	p, err := prefectureByCode(code)
+	if err != nil {
+		return "", err
+	}
+
+	return p.Kana, nil
+}
+
+func prefectureByCode(code string) (*Prefecture, error) {
+	for i := range prefectures {
+		if prefectures[i].Code == code {
+			return &prefectures[i], nil
+		}
+	}
+
+	return nil, fmt.Errorf("kenall: undefined prefecture code, code = %s: %w", code, ErrInvalidArgument)
+}
+
+// trimPrefectureSuffix trims the suffix of the prefecture name except "北海道" which is never abbreviated.
+func trimPrefectureSuffix(name string) string {
+	for _, suffix := range []string{"都", "府", "県"} {
+		if strings.HasSuffix(name, suffix) {
+			return strings.TrimSuffix(name, suffix)
+		}
+	}
+
+	return name
+}
+
+// trimPrefectureKanaSuffix trims the suffix of the prefecture kana except "ホッカイドウ" which is never abbreviated.
+func trimPrefectureKanaSuffix(kana string) string {
+	for _, suffix := range []string{"ト", "フ", "ケン"} {
+		if strings.HasSuffix(kana, suffix) {
+			return strings.TrimSuffix(kana, suffix)
+		}
+	}
+
+	return kana
+}
@@ -0,0 +1,182 @@
+package kenall_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestPrefectureNameToCode(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		want      string
+		wantError error
+	}{
+		"Full name":        {give: "東京都", want: "13", wantError: nil},
+		"Without suffix":   {give: "京都", want: "26", wantError: nil},
+		"Hokkaido":         {give: "北海道", want: "01", wantError: nil},
+		"Hokkaido trimmed": {give: "北海", want: "", wantError: kenall.ErrInvalidArgument},
+		"With spaces":      {give: " 沖縄県 ", want: "47", wantError: nil},
+		"Undefined":        {give: "東京府", want: "", wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := kenall.PrefectureNameToCode(c.give)
+			if !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if got != c.want {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestPrefectureKanaToCode(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		want      string
+		wantError error
+	}{
+		"Katakana":       {give: "トウキョウト", want: "13", wantError: nil},
+		"Hiragana":       {give: "おおさかふ", want: "27", wantError: nil},
+		"Half width":     {give: "ｶｺﾞｼﾏｹﾝ", want: "46", wantError: nil},
+		"Without suffix": {give: "かながわ", want: "14", wantError: nil},
+		"Undefined":      {give: "エド", want: "", wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := kenall.PrefectureKanaToCode(c.give)
+			if !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if got != c.want {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestPrefectureCodeToName(t *testing.T) {
+	t.Parallel()
+
+	name, err := kenall.PrefectureCodeToName("13")
+	if err != nil || name != "東京都" {
+		t.Errorf("give: %v, %v, want: %v", name, err, "東京都")
+	}
+
+	kana, err := kenall.PrefectureCodeToKana("01")
+	if err != nil || kana != "ホッカイドウ" {
+		t.Errorf("give: %v, %v, want: %v", kana, err, "ホッカイドウ")
+	}
+
+	if _, err := kenall.PrefectureCodeToName("48"); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+	if _, err := kenall.PrefectureCodeToKana("1"); !errors.Is(err, kenall.ErrInvalidArgument) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInvalidArgument)
+	}
+}
+
+func TestPrefectures(t *testing.T) {
+	t.Parallel()
+
+	ps := kenall.Prefectures()
+	if len(ps) != 47 {
+		t.Fatalf("give: %v, want: %v", len(ps), 47)
+	}
+
+	for i, p := range ps {
+		if want := fmt.Sprintf("%02d", i+1); p.Code != want {
+			t.Errorf("give: %v, want: %v", p.Code, want)
+		}
+		if p.Name == "" || p.Kana == "" || p.EnglishName == "" {
+			t.Errorf("give: %+v, want: all the names", p)
+		}
+	}
+
+	ps[12].Name = "modified"
+	if p, _ := kenall.PrefectureByCode("13"); p.Name != "東京都" {
+		t.Error("the table should not be shared")
+	}
+}
+
+func TestPrefectureByCode(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		want      *kenall.Prefecture
+		wantError error
+	}{
+		"Tokyo":      {give: "13", want: &kenall.Prefecture{Code: "13", Name: "東京都", Kana: "トウキョウト", EnglishName: "Tokyo"}, wantError: nil},
+		"Okinawa":    {give: "47", want: &kenall.Prefecture{Code: "47", Name: "沖縄県", Kana: "オキナワケン", EnglishName: "Okinawa"}, wantError: nil},
+		"Undefined":  {give: "48", want: nil, wantError: kenall.ErrInvalidArgument},
+		"Not padded": {give: "1", want: nil, wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := kenall.PrefectureByCode(c.give)
+			if !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
+
+func TestPrefectureByName(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      string
+		want      string
+		wantError error
+	}{
+		"Full name":      {give: "東京都", want: "13", wantError: nil},
+		"Without suffix": {give: "大阪", want: "27", wantError: nil},
+		"English name":   {give: "Hokkaido", want: "01", wantError: nil},
+		"Lower case":     {give: " kyoto ", want: "26", wantError: nil},
+		"Undefined":      {give: "Edo", want: "", wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := kenall.PrefectureByName(c.give)
+			if !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if got != nil && got.Code != c.want {
+				t.Errorf("give: %v, want: %v", got.Code, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package kenall
+
+import "time"
+
+type (
+	// A Progress is a snapshot of a long-running bulk operation, e.g. kenall.Exporter or kenall.Syncer.
+	Progress struct {
+		// Processed is the number of items processed so far including failures.
+		Processed int
+		// Total is the number of items to be processed, zero means unknown.
+		Total int
+		// Errors is the number of items failed to be processed.
+		Errors int
+		// Elapsed is the time since the operation started.
+		Elapsed time.Duration
+		// ETA is the estimated time to complete the operation, zero if Total is unknown.
+		ETA time.Duration
+	}
+	// A ProgressFunc is called with the progress each time an item is processed by a bulk operation.
+	ProgressFunc func(Progress)
+
+	progressTracker struct {
+		fn    ProgressFunc
+		clock Clock
+		start time.Time
+		p     Progress
+	}
+)
+
+func newProgressTracker(fn ProgressFunc, clock Clock, total int) *progressTracker {
+	return &progressTracker{fn: fn, clock: clock, start: clock.Now(), p: Progress{Total: total}}
+}
+
+// done records an item processed, failed is true if the item failed to be processed.
+func (t *progressTracker) done(failed bool) {
+	if t.fn == nil {
+		return
+	}
+
+	t.p.Processed++
+	if failed {
+		t.p.Errors++
+	}
+
+	t.p.Elapsed = t.clock.Now().Sub(t.start)
+
+	if t.p.Total > t.p.Processed {
+		t.p.ETA = t.p.Elapsed / time.Duration(t.p.Processed) * time.Duration(t.p.Total-t.p.Processed)
+	} else {
+		t.p.ETA = 0
+	}
+
+	t.fn(t.p)
+}
@@ -0,0 +1,41 @@
+package kenall_test
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestExporter_OnProgress(t *testing.T) {
+	t.Parallel()
+
+	srv := runExporterServer(t)
+
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithClock(clock))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []kenall.Progress
+	e := kenall.NewExporter(cli, kenall.ExportFormatNDJSON, 1)
+	e.OnProgress = func(p kenall.Progress) {
+		got = append(got, p)
+	}
+
+	if _, err := e.ExportPrefecture(context.Background(), "13", &bytes.Buffer{}); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []kenall.Progress{
+		{Processed: 1, Total: 2, Errors: 0, Elapsed: time.Second, ETA: time.Second},
+		{Processed: 2, Total: 2, Errors: 0, Elapsed: 2 * time.Second, ETA: 0},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("give: %+v, want: %+v", got, want)
+	}
+}
@@ -0,0 +1,119 @@
+package kenall
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+)
+
+// proxyOperation is the operation name of the requests sent by the proxy handler, e.g. for kenall.CallObserver.
+const proxyOperation = "Proxy"
+
+// proxyFamilies are the endpoint families exposed by the proxy handler.
+var proxyFamilies = map[EndpointFamily]bool{ //nolint: gochecknoglobals
+	EndpointFamilyPostalCode:   true,
+	EndpointFamilyCities:       true,
+	EndpointFamilyHoujinbangou: true,
+	EndpointFamilyWhoami:       true,
+	EndpointFamilyHolidays:     true,
+	EndpointFamilyBusinessDays: true,
+	EndpointFamilyBank:         true,
+	EndpointFamilyInvoice:      true,
+}
+
+type proxyHandler struct {
+	cli *Client
+}
+
+// NewProxyHandler returns http.Handler which relays GET requests of the same REST paths as the kenall service,
+// e.g. "/postalcode/1008105" or "/holidays?year=2022", with the authorization token of the client.
+// It lets a browser call the kenall service without knowing the token, so the handler should be protected
+// as the API key is. The requests go through the retries, the rate limiting, the cache and the middlewares
+// of the client. Use http.StripPrefix to mount it under a path.
+func NewProxyHandler(cli *Client) http.Handler {
+	return &proxyHandler{cli: cli}
+}
+
+// ServeHTTP implements http.Handler interface.
+func (h *proxyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		writeProxyError(w, http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	p := path.Clean("/" + r.URL.Path)
+	family, _, _ := strings.Cut(strings.TrimPrefix(p, "/"), "/")
+
+	if !proxyFamilies[EndpointFamily(family)] {
+		writeProxyError(w, http.StatusNotFound)
+
+		return
+	}
+
+	body, err := h.cli.relay(r.Context(), p, r.URL.RawQuery)
+	if err != nil {
+		var ae *APIError
+		if errors.As(err, &ae) && len(ae.Body) > 0 {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(ae.StatusCode)
+			_, _ = w.Write(ae.Body)
+
+			return
+		}
+
+		writeProxyError(w, proxyStatusOf(err))
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// relay sends the GET request of the path and the query to the kenall service and returns the raw response body.
+func (cli *Client) relay(ctx context.Context, p, rawQuery string) (json.RawMessage, error) {
+	u := cli.Endpoint + p
+	if rawQuery != "" {
+		u += "?" + rawQuery
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, fmt.Errorf(errFailedGenerateRequestFormat, err)
+	}
+
+	var res json.RawMessage
+	if err := cli.sendRequest(proxyOperation, req, &res); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// proxyStatusOf returns the status code of the response for the error of the request to the kenall service.
+func proxyStatusOf(err error) int {
+	var ae *APIError
+
+	switch {
+	case errors.As(err, &ae):
+		return ae.StatusCode
+	case errors.Is(err, context.DeadlineExceeded) || isTimeoutError(err):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+func writeProxyError(w http.ResponseWriter, code int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(struct {
+		Message string `json:"message"`
+	}{Message: http.StatusText(code)})
+}
@@ -0,0 +1,65 @@
+package kenall_test
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestNewProxyHandler(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unauthorized, err := kenall.NewClient("bad_token", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		cli      *kenall.Client
+		method   string
+		target   string
+		wantCode int
+		wantBody []byte
+	}{
+		"Postal code":        {cli: cli, method: http.MethodGet, target: "/postalcode/1008105", wantCode: http.StatusOK, wantBody: addressResponse},
+		"Holidays by year":   {cli: cli, method: http.MethodGet, target: "/holidays?year=2022", wantCode: http.StatusOK, wantBody: holidaysResponse},
+		"Not found":          {cli: cli, method: http.MethodGet, target: "/postalcode/0000000", wantCode: http.StatusNotFound},
+		"Unknown family":     {cli: cli, method: http.MethodGet, target: "/unknown/1008105", wantCode: http.StatusNotFound},
+		"Traversal":          {cli: cli, method: http.MethodGet, target: "/postalcode/../../unknown", wantCode: http.StatusNotFound},
+		"Method not allowed": {cli: cli, method: http.MethodPost, target: "/postalcode/1008105", wantCode: http.StatusMethodNotAllowed},
+		"Unauthorized":       {cli: unauthorized, method: http.MethodGet, target: "/postalcode/1008105", wantCode: http.StatusUnauthorized},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			rec := httptest.NewRecorder()
+			kenall.NewProxyHandler(c.cli).ServeHTTP(rec, httptest.NewRequest(c.method, c.target, nil))
+
+			if rec.Code != c.wantCode {
+				t.Errorf("give: %v, want: %v", rec.Code, c.wantCode)
+			}
+
+			if give := rec.Header().Get("Content-Type"); give != "application/json" {
+				t.Errorf("give: %v, want: %v", give, "application/json")
+			}
+
+			if c.wantBody != nil && !bytes.Equal(bytes.TrimSpace(rec.Body.Bytes()), bytes.TrimSpace(c.wantBody)) {
+				t.Errorf("give: %s, want: %s", rec.Body.Bytes(), c.wantBody)
+			}
+		})
+	}
+}
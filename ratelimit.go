@@ -0,0 +1,87 @@
+package kenall
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// A Rate is a budget of requests per second for kenall.Client.
+type Rate struct {
+	// QPS is the number of requests allowed per second, zero or negative means no limit.
+	QPS float64
+	// Burst is the number of requests allowed at once, values smaller than 1 are treated as 1.
+	Burst int
+}
+
+// A RateLimiter limits requests of kenall.Client, e.g. *rate.Limiter of golang.org/x/time/rate.
+type RateLimiter interface {
+	// Wait blocks until a request is allowed or the ctx is done.
+	Wait(ctx context.Context) error
+}
+
+// A tokenBucket is a limiter which implements the token bucket algorithm.
+type tokenBucket struct {
+	mu     sync.Mutex
+	rate   Rate
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(r Rate) *tokenBucket {
+	if r.Burst < 1 {
+		r.Burst = 1
+	}
+
+	return &tokenBucket{rate: r, tokens: float64(r.Burst)}
+}
+
+// Wait blocks until a request is allowed by the bucket or the ctx is done.
+func (b *tokenBucket) Wait(ctx context.Context, clock Clock) error {
+	if b.rate.QPS <= 0 {
+		return nil
+	}
+
+	b.mu.Lock()
+	now := clock.Now()
+	if !b.last.IsZero() {
+		b.tokens = math.Min(float64(b.rate.Burst), b.tokens+now.Sub(b.last).Seconds()*b.rate.QPS)
+	}
+	b.last = now
+	b.tokens--
+	wait := time.Duration(-b.tokens / b.rate.QPS * float64(time.Second))
+	b.mu.Unlock()
+
+	if err := clock.Sleep(ctx, wait); err != nil {
+		b.mu.Lock()
+		b.tokens++
+		b.mu.Unlock()
+
+		return err
+	}
+
+	return nil
+}
+
+// waitRateLimit waits for the rate limit of the client, the custom limiter and the limit of the endpoint family in order.
+func (cli *Client) waitRateLimit(ctx context.Context, family EndpointFamily) error {
+	if cli.globalRateLimiter != nil {
+		if err := cli.globalRateLimiter.Wait(ctx, cli.clock); err != nil {
+			return err
+		}
+	}
+
+	if cli.rateLimiter != nil {
+		if err := cli.rateLimiter.Wait(ctx); err != nil {
+			return err //nolint: wrapcheck
+		}
+	}
+
+	l, ok := cli.rateLimiters[family]
+	if !ok {
+		return nil
+	}
+
+	return l.Wait(ctx, cli.clock)
+}
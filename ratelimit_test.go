@@ -0,0 +1,91 @@
+package kenall_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_RateLimit(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithEndpointRateLimits(map[kenall.EndpointFamily]kenall.Rate{
+		kenall.EndpointFamilyPostalCode:   {QPS: 0.001, Burst: 1},
+		kenall.EndpointFamilyHoujinbangou: {QPS: 0, Burst: 0},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if _, err := cli.GetAddress(ctx, "1008105"); err != nil {
+		t.Fatal(err)
+	}
+
+	toctx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	t.Cleanup(cancel)
+
+	if _, err := cli.GetAddress(toctx, "1008105"); err == nil {
+		t.Error("an error should not be nil")
+	}
+
+	for i := 0; i < 3; i++ {
+		if _, err := cli.GetCity(ctx, "13"); err != nil {
+			t.Errorf("an error should be nil, err = %s", err)
+		}
+		if _, err := cli.GetCorporation(ctx, "2021001052596"); err != nil {
+			t.Errorf("an error should be nil, err = %s", err)
+		}
+	}
+}
+
+type countingLimiter struct {
+	n atomic.Int32
+}
+
+func (l *countingLimiter) Wait(ctx context.Context) error {
+	l.n.Add(1)
+
+	return ctx.Err()
+}
+
+func TestClient_GlobalRateLimit(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	clock := &fakeClock{now: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)}
+	limiter := &countingLimiter{}
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithClock(clock),
+		kenall.WithRateLimit(2, 1),
+		kenall.WithRateLimiter(limiter),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if _, err := cli.GetAddress(ctx, "1008105"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.GetCity(ctx, "13"); err != nil {
+		t.Fatal(err)
+	}
+
+	if got := clock.Slept(); len(got) != 1 || got[0] != 500*time.Millisecond {
+		t.Errorf("give: %v, want: [500ms] shared by the endpoint families", got)
+	}
+	if got := limiter.n.Load(); got != 2 {
+		t.Errorf("give: %v, want: %v", got, 2)
+	}
+}
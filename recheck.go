@@ -0,0 +1,220 @@
+package kenall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// RecheckStatus values.
+const (
+	// RecheckUnchanged means the stored address still matches the current data.
+	RecheckUnchanged RecheckStatus = iota
+	// RecheckChanged means the postal code exists but none of its current addresses matches the stored address.
+	RecheckChanged
+	// RecheckRemoved means the postal code does not exist in the current data.
+	RecheckRemoved
+)
+
+type (
+	// A StoredAddress is a previously validated address to be rechecked by kenall.Rechecker.
+	StoredAddress struct {
+		// ID identifies the address in the caller's storage, it is not used by the recheck.
+		ID         string
+		PostalCode string
+		Prefecture string
+		City       string
+		Town       string
+		// Version is the version of the data which the address was validated against.
+		Version Version
+	}
+	// A RecheckStatus is a result of rechecking a stored address.
+	RecheckStatus int
+	// A RecheckReport is a change of a stored address found by kenall.Rechecker.
+	RecheckReport struct {
+		Address *StoredAddress
+		Status  RecheckStatus
+		// Version is the version of the current data.
+		Version Version
+		// Candidates are the current addresses of the postal code, they are empty if the postal code is removed.
+		Candidates []*Address
+	}
+	// A RecheckSummary is counts of the stored addresses processed by kenall.Rechecker.
+	RecheckSummary struct {
+		// Skipped is the number of addresses validated against the current data, they are not rechecked.
+		Skipped   int
+		Unchanged int
+		Changed   int
+		Removed   int
+	}
+	// A Rechecker re-verifies stored addresses validated against older data versions.
+	// The current addresses are looked up once per postal code in a recheck and shared among the stored addresses.
+	Rechecker struct {
+		// OnProgress is called for each stored address if it is not nil, the total is unknown.
+		OnProgress ProgressFunc
+		// Config configures the concurrency and the request budget of the recheck.
+		Config BulkConfig
+
+		cli    *Client
+		mu     sync.Mutex
+		latest Version
+	}
+)
+
+// NewRechecker creates kenall.Rechecker with the client.
+func NewRechecker(cli *Client) *Rechecker {
+	return &Rechecker{cli: cli}
+}
+
+// Recheck re-verifies the stored addresses received until the channel is closed and calls the report function
+// for each address which is changed or removed. Addresses validated against a version not older than
+// the latest version seen in the responses are skipped. The names are compared ignoring the width, letter case
+// and spaces. The report function is not called concurrently.
+// It stops at the first error of a lookup or the report function.
+func (rc *Rechecker) Recheck(
+	ctx context.Context, addresses <-chan *StoredAddress, report func(*RecheckReport) error,
+) (*RecheckSummary, error) {
+	summary := &RecheckSummary{}
+	progress := newProgressTracker(rc.OnProgress, rc.cli.clock, 0)
+
+	cfg, err := rc.Config.resolve(rc.cli, EndpointFamilyPostalCode)
+	if err != nil {
+		return summary, err
+	}
+
+	limiter := cfg.limiter()
+
+	var mu sync.Mutex
+
+	// NOTE: the lookups are scoped to the run, so that a later run sees the current addresses.
+	lookups := map[string]*GetAddressResponse{}
+
+	err = runWorkers(ctx, cfg.Workers, cfg.Workers, func(ctx context.Context, _ int) error {
+		for {
+			var (
+				sa *StoredAddress
+				ok bool
+			)
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case sa, ok = <-addresses:
+			}
+
+			if !ok {
+				return nil
+			}
+
+			if err := rc.process(ctx, sa, limiter, lookups, &mu, summary, progress, report); err != nil {
+				return err
+			}
+		}
+	})
+
+	return summary, err
+}
+
+func (rc *Rechecker) process(
+	ctx context.Context, sa *StoredAddress, limiter *tokenBucket, lookups map[string]*GetAddressResponse,
+	mu *sync.Mutex, summary *RecheckSummary, progress *progressTracker, report func(*RecheckReport) error,
+) error {
+	if !rc.isOutdated(sa) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		summary.Skipped++
+		progress.done(false)
+
+		return nil
+	}
+
+	r, err := rc.recheck(ctx, sa, limiter, lookups)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	progress.done(err != nil)
+
+	if err != nil {
+		return err
+	}
+
+	switch r.Status {
+	case RecheckUnchanged:
+		summary.Unchanged++
+
+		return nil
+	case RecheckChanged:
+		summary.Changed++
+	case RecheckRemoved:
+		summary.Removed++
+	}
+
+	return report(r)
+}
+
+func (rc *Rechecker) isOutdated(sa *StoredAddress) bool {
+	rc.mu.Lock()
+	latest := rc.latest
+	rc.mu.Unlock()
+
+	return latest.IsZero() || sa.Version.Before(latest)
+}
+
+func (rc *Rechecker) recheck(
+	ctx context.Context, sa *StoredAddress, limiter *tokenBucket, lookups map[string]*GetAddressResponse,
+) (*RecheckReport, error) {
+	postalCode := strings.ReplaceAll(sa.PostalCode, "-", "")
+
+	rc.mu.Lock()
+	res, ok := lookups[postalCode]
+	rc.mu.Unlock()
+
+	if !ok {
+		if err := limiter.Wait(ctx, rc.cli.clock); err != nil {
+			return nil, fmt.Errorf("kenall: failed to wait for the rate limit: %w", err)
+		}
+
+		var err error
+
+		res, err = rc.cli.GetAddress(ctx, postalCode)
+
+		switch {
+		case errors.Is(err, ErrNotFound) || errors.Is(err, ErrInvalidArgument):
+			res = nil
+		case err != nil:
+			return nil, err
+		}
+
+		rc.mu.Lock()
+		if res != nil && res.Version.After(rc.latest) {
+			rc.latest = res.Version
+		}
+		lookups[postalCode] = res
+		rc.mu.Unlock()
+	}
+
+	if res == nil || len(res.Addresses) == 0 {
+		rc.mu.Lock()
+		defer rc.mu.Unlock()
+
+		return &RecheckReport{Address: sa, Status: RecheckRemoved, Version: rc.latest}, nil
+	}
+
+	r := &RecheckReport{Address: sa, Status: RecheckChanged, Version: res.Version, Candidates: res.Addresses}
+
+	for _, a := range res.Addresses {
+		if a != nil && normalizeName(a.Prefecture) == normalizeName(sa.Prefecture) &&
+			normalizeName(a.City) == normalizeName(sa.City) &&
+			normalizeName(a.Town) == normalizeName(sa.Town) {
+			r.Status = RecheckUnchanged
+
+			break
+		}
+	}
+
+	return r, nil
+}
@@ -0,0 +1,119 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestRechecker_Recheck(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	older := kenall.Version(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	current := kenall.Version(time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC))
+
+	addresses := make(chan *kenall.StoredAddress, 5)
+	addresses <- &kenall.StoredAddress{ID: "unchanged", PostalCode: "1008105", Prefecture: "東京都", City: "新宿区", Town: "西新宿", Version: older}
+	addresses <- &kenall.StoredAddress{ID: "normalized", PostalCode: "1008105", Prefecture: "東京都　", City: "新宿区", Town: " 西新宿", Version: older}
+	addresses <- &kenall.StoredAddress{ID: "changed", PostalCode: "100-8105", Prefecture: "東京都", City: "千代田区", Town: "大手町", Version: older}
+	addresses <- &kenall.StoredAddress{ID: "skipped", PostalCode: "1008105", Prefecture: "東京都", City: "千代田区", Town: "大手町", Version: current}
+	addresses <- &kenall.StoredAddress{ID: "removed", PostalCode: "9999999", Prefecture: "東京都", City: "千代田区", Town: "大手町", Version: older}
+	close(addresses)
+
+	var ids []string
+	summary, err := kenall.NewRechecker(cli).Recheck(context.Background(), addresses, func(r *kenall.RecheckReport) error {
+		ids = append(ids, r.Address.ID)
+		if r.Status == kenall.RecheckChanged && len(r.Candidates) == 0 {
+			t.Error("a changed report should have candidates")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"changed", "removed"}; !reflect.DeepEqual(ids, want) {
+		t.Errorf("give: %v, want: %v", ids, want)
+	}
+	if want := (kenall.RecheckSummary{Skipped: 1, Unchanged: 2, Changed: 1, Removed: 1}); *summary != want {
+		t.Errorf("give: %+v, want: %+v", *summary, want)
+	}
+}
+
+func TestRechecker_RecheckError(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	addresses := make(chan *kenall.StoredAddress, 1)
+	addresses <- &kenall.StoredAddress{PostalCode: "5000000"}
+	close(addresses)
+
+	_, err = kenall.NewRechecker(cli).Recheck(context.Background(), addresses, func(*kenall.RecheckReport) error { return nil })
+	if !errors.Is(err, kenall.ErrInternalServerError) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrInternalServerError)
+	}
+}
+
+func TestRechecker_RecheckLookups(t *testing.T) {
+	t.Parallel()
+
+	var requested atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested.Add(1)
+
+		body := `{"version":"2021-06-30","data":[{"postal_code":"1638001","prefecture":"東京都","city":"新宿区","town":"西新宿"}]}`
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rc := kenall.NewRechecker(cli)
+	older := kenall.Version(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	for i := 1; i <= 2; i++ {
+		addresses := make(chan *kenall.StoredAddress, 2)
+		addresses <- &kenall.StoredAddress{ID: "1", PostalCode: "1638001", Prefecture: "東京都", City: "新宿区", Town: "西新宿", Version: older}
+		addresses <- &kenall.StoredAddress{ID: "2", PostalCode: "1638001", Prefecture: "東京都", City: "新宿区", Town: "西新宿", Version: older}
+		close(addresses)
+
+		if _, err := rc.Recheck(context.Background(), addresses, func(*kenall.RecheckReport) error { return nil }); err != nil {
+			t.Fatal(err)
+		}
+
+		// NOTE: a postal code is looked up once per recheck, and again in the next recheck.
+		if got := requested.Load(); got != int32(i) {
+			t.Errorf("give: %v, want: %v", got, i)
+		}
+	}
+}
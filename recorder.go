@@ -0,0 +1,207 @@
+package kenall
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type (
+	// A ReplayTransport is http.RoundTripper serving the responses recorded by kenall.WithRecorder,
+	// it does not send any request to the network.
+	ReplayTransport struct {
+		dir string
+	}
+
+	// fixture is a request and response pair recorded by kenall.WithRecorder.
+	fixture struct {
+		Method     string              `json:"method"`
+		URL        string              `json:"url"`
+		StatusCode int                 `json:"status_code"`
+		Header     map[string][]string `json:"header,omitempty"`
+		// Body is the response body if it is JSON, otherwise BodyText is.
+		Body     json.RawMessage `json:"body,omitempty"`
+		BodyText string          `json:"body_text,omitempty"`
+	}
+)
+
+// recordedHeaders are the response headers to be recorded, the others may be sensitive or depend on the transport.
+var recordedHeaders = []string{ //nolint: gochecknoglobals
+	"Content-Type", "Date", "Etag", "Last-Modified", "Retry-After", "X-Request-Id",
+}
+
+var _ http.RoundTripper = (*ReplayTransport)(nil)
+
+// NewReplayTransport creates kenall.ReplayTransport serving the fixtures in the directory.
+//
+//	cli, err := kenall.NewClient("token", kenall.WithHTTPClient(&http.Client{
+//		Transport: kenall.NewReplayTransport("testdata/fixtures"),
+//	}))
+func NewReplayTransport(dir string) *ReplayTransport {
+	return &ReplayTransport{dir: dir}
+}
+
+// RoundTrip implements http.RoundTripper interface.
+// It returns an error wrapping kenall.ErrNoRecording if the request is not recorded.
+func (t *ReplayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		_ = req.Body.Close()
+	}
+
+	b, err := os.ReadFile(fixturePath(t.dir, req))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("kenall: %s %s: %w", req.Method, req.URL.RequestURI(), ErrNoRecording)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("kenall: failed to read a fixture: %w", err)
+	}
+
+	var f fixture
+	if err := json.Unmarshal(b, &f); err != nil {
+		return nil, fmt.Errorf("kenall: failed to read a fixture: %w", err)
+	}
+
+	body := []byte(f.BodyText)
+	if f.Body != nil {
+		body = f.Body
+	}
+
+	header := http.Header{}
+	for k, vs := range f.Header {
+		for _, v := range vs {
+			header.Add(k, v)
+		}
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", f.StatusCode, http.StatusText(f.StatusCode)),
+		StatusCode:    f.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// recorder returns the middleware saving each response as a fixture in the directory.
+// The fixture does not have the request headers, so the authorization token is never recorded.
+func recorder(dir string) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			resp, err := next(req)
+			if err != nil {
+				return resp, err
+			}
+
+			body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodySize))
+			_ = resp.Body.Close()
+
+			if err != nil {
+				return nil, fmt.Errorf("kenall: failed to record a response: %w", err)
+			}
+
+			resp.Body = io.NopCloser(bytes.NewReader(body))
+
+			if err := saveFixture(dir, req, resp, body); err != nil {
+				return nil, err
+			}
+
+			return resp, nil
+		}
+	}
+}
+
+func saveFixture(dir string, req *http.Request, resp *http.Response, body []byte) error {
+	f := &fixture{
+		Method:     req.Method,
+		URL:        req.URL.RequestURI(),
+		StatusCode: resp.StatusCode,
+		Header:     map[string][]string{},
+	}
+
+	for _, k := range recordedHeaders {
+		if vs := resp.Header.Values(k); len(vs) > 0 {
+			f.Header[k] = vs
+		}
+	}
+
+	if json.Valid(body) {
+		f.Body = body
+	} else {
+		f.BodyText = string(body)
+	}
+
+	b, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return fmt.Errorf("kenall: failed to record a response: %w", err)
+	}
+
+	if err := os.MkdirAll(dir, 0o755); err != nil { //nolint: gomnd
+		return fmt.Errorf("kenall: failed to record a response: %w", err)
+	}
+
+	p := fixturePath(dir, req)
+
+	tmp := p + ".tmp"
+	if err := os.WriteFile(tmp, append(b, '\n'), 0o600); err != nil { //nolint: gomnd
+		return fmt.Errorf("kenall: failed to record a response: %w", err)
+	}
+
+	if err := os.Rename(tmp, p); err != nil {
+		return fmt.Errorf("kenall: failed to record a response: %w", err)
+	}
+
+	return nil
+}
+
+// fixturePath returns the file of the request named after the method and the path,
+// e.g. "GET_postalcode_1008105.json", the query and the request body are appended as digests
+// since they may be too long for a file name.
+func fixturePath(dir string, req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+
+	name := req.Method
+	for _, s := range segments {
+		if s != "" {
+			name += "_" + url.PathEscape(s)
+		}
+	}
+
+	if req.URL.RawQuery != "" {
+		name += "_" + digest([]byte(req.URL.Query().Encode()))
+	}
+
+	// NOTE: the body is read from GetBody, since the transport may have consumed req.Body already.
+	if req.GetBody != nil {
+		if rc, err := req.GetBody(); err == nil {
+			body, err := io.ReadAll(rc)
+			_ = rc.Close()
+
+			if err == nil && len(body) > 0 {
+				name += "_" + digest(body)
+			}
+		}
+	}
+
+	return filepath.Join(dir, name+".json")
+}
+
+func digest(b []byte) string {
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,147 @@
+package kenall_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithRecorder(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	dir := filepath.Join(t.TempDir(), "fixtures")
+
+	rec, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithRecorder(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	want, err := rec.GetAddress(ctx, "1008105")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantHolidays, err := rec.GetHolidaysByYear(ctx, 2022)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := rec.GetAddress(ctx, "0000000"); !errors.Is(err, kenall.ErrNotFound) {
+		t.Fatalf("give: %v, want: %v", err, kenall.ErrNotFound)
+	}
+
+	b, err := os.ReadFile(filepath.Join(dir, "GET_postalcode_1008105.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if strings.Contains(string(b), "opencollector") {
+		t.Errorf("give: %s, want: the token is stripped", b)
+	}
+
+	replay, err := kenall.NewClient("another", kenall.WithEndpoint("http://kenall.invalid"), kenall.WithHTTPClient(&http.Client{
+		Transport: kenall.NewReplayTransport(dir),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	give, err := replay.GetAddress(ctx, "1008105")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// NOTE: the duration of the call is not recorded.
+	give.Duration, want.Duration = 0, 0
+
+	if !reflect.DeepEqual(give, want) {
+		t.Errorf("give: %+v, want: %+v", give, want)
+	}
+
+	giveHolidays, err := replay.GetHolidaysByYear(ctx, 2022)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	giveHolidays.Duration, wantHolidays.Duration = 0, 0
+
+	if !reflect.DeepEqual(giveHolidays, wantHolidays) {
+		t.Errorf("give: %+v, want: %+v", giveHolidays, wantHolidays)
+	}
+
+	if _, err := replay.GetAddress(ctx, "0000000"); !errors.Is(err, kenall.ErrNotFound) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrNotFound)
+	}
+
+	if _, err := replay.GetCity(ctx, "13"); !errors.Is(err, kenall.ErrNoRecording) {
+		t.Errorf("give: %v, want: %v", err, kenall.ErrNoRecording)
+	}
+}
+
+func TestWithRecorder_RequestBody(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewEncoder(w).Encode(map[string]interface{}{
+			"version": "2021-06-30",
+			"query":   map[string]string{"t": r.FormValue("t")},
+			"data":    []interface{}{},
+		}); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	dir := t.TempDir()
+
+	rec, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithRecorder(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// NOTE: the addresses are long enough to be sent in the request body.
+	addresses := []string{
+		"東京都千代田区千代田" + strings.Repeat("一", 1000),
+		"東京都千代田区千代田" + strings.Repeat("二", 1000),
+	}
+
+	ctx := context.Background()
+
+	for _, a := range addresses {
+		if _, err := rec.GetNormalizeAddress(ctx, a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	replay, err := kenall.NewClient("another", kenall.WithEndpoint("http://kenall.invalid"), kenall.WithHTTPClient(&http.Client{
+		Transport: kenall.NewReplayTransport(dir),
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, a := range addresses {
+		res, err := replay.GetNormalizeAddress(ctx, a)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if res.Query.T.String != a {
+			t.Errorf("give: %s, want: %s", res.Query.T.String, a)
+		}
+	}
+}
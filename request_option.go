@@ -0,0 +1,163 @@
+package kenall
+
+import (
+	"context"
+	"net/http"
+	"time"
+)
+
+type (
+	// A RequestOption customizes the requests of a call given to the method or sent with the context,
+	// see kenall.ContextWithRequestOptions. The methods taking kenall.SearchOption, kenall.CorporationSearchOption
+	// or kenall.BatchOption accept it as them.
+	RequestOption interface {
+		SearchOption
+		CorporationSearchOption
+		BatchOption
+		applyRequest(req *http.Request)
+	}
+
+	requestOptionsKey struct{}
+
+	// requestOption implements kenall.SearchOption, kenall.CorporationSearchOption and kenall.BatchOption
+	// for kenall.RequestOption.
+	requestOption struct{}
+
+	withHeader struct {
+		requestOption
+		key   string
+		value string
+	}
+	withUserAgent struct {
+		requestOption
+		userAgent string
+	}
+	withQuery struct {
+		requestOption
+		key   string
+		value string
+	}
+	withRequestTimeout struct {
+		requestOption
+		d time.Duration
+	}
+	withToken struct {
+		requestOption
+		token string
+	}
+)
+
+// ContextWithRequestOptions returns a copy of the context with the options applied to every request
+// sent with it, e.g. to add a header to a single call without creating another client.
+// The options are appended to the ones already in the context.
+func ContextWithRequestOptions(ctx context.Context, opts ...RequestOption) context.Context {
+	prev, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+
+	return context.WithValue(ctx, requestOptionsKey{}, append(append([]RequestOption(nil), prev...), opts...))
+}
+
+// contextWithRequestOptions returns the context with the options given to a method.
+func contextWithRequestOptions(ctx context.Context, opts []RequestOption) context.Context {
+	if len(opts) == 0 {
+		return ctx
+	}
+
+	return ContextWithRequestOptions(ctx, opts...)
+}
+
+func (requestOption) applySearch(*addressSearch) {}
+
+func (requestOption) applyCorporationSearch(*corporationSearch) {}
+
+func (requestOption) applyBatch(*batch) {}
+
+func applyRequestOptions(req *http.Request) {
+	opts, _ := req.Context().Value(requestOptionsKey{}).([]RequestOption)
+	for _, opt := range opts {
+		opt.applyRequest(req)
+	}
+}
+
+func (w withHeader) applyRequest(req *http.Request) {
+	req.Header.Add(w.key, w.value)
+}
+
+// WithHeader adds the header to the request.
+func WithHeader(key, value string) RequestOption {
+	return withHeader{key: key, value: value}
+}
+
+func (w withUserAgent) applyRequest(req *http.Request) {
+	req.Header.Set("User-Agent", w.userAgent)
+}
+
+// WithUserAgent overrides the User-Agent header of the request.
+func WithUserAgent(userAgent string) RequestOption {
+	return withUserAgent{userAgent: userAgent}
+}
+
+func (w withQuery) applyRequest(req *http.Request) {
+	q := req.URL.Query()
+	q.Set(w.key, w.value)
+	req.URL.RawQuery = q.Encode()
+}
+
+// WithQuery sets the query parameter of the request, replacing the existing values of the key.
+func WithQuery(key, value string) RequestOption {
+	return withQuery{key: key, value: value}
+}
+
+// applyRequest does nothing, the timeout is applied to the context by kenall.Client.
+func (w withRequestTimeout) applyRequest(*http.Request) {}
+
+// WithRequestTimeout sets the deadline of the call instead of kenall.WithTimeout, it is applied
+// even if the context has a deadline, the earlier one wins.
+func WithRequestTimeout(d time.Duration) RequestOption {
+	return withRequestTimeout{d: d}
+}
+
+// timeoutOf returns the timeout of the call with the context, the last kenall.WithRequestTimeout in the context
+// or kenall.WithTimeout if the context has no deadline.
+func (cli *Client) timeoutOf(ctx context.Context) time.Duration {
+	opts, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+	for i := len(opts) - 1; i >= 0; i-- {
+		if w, ok := opts[i].(withRequestTimeout); ok {
+			return w.d
+		}
+	}
+
+	if _, ok := ctx.Deadline(); ok {
+		return 0
+	}
+
+	return cli.timeout
+}
+
+// ContextWithToken returns a copy of the context overriding the authorization token of the client and
+// kenall.WithTokenProvider for the calls with it, e.g. to call the kenall service on behalf of a tenant
+// with its own token. It is equivalent to kenall.ContextWithRequestOptions with kenall.WithToken.
+// The responses cached by kenall.WithCache and kenall.WithStaleOnTimeout are kept apart for each overriding token.
+func ContextWithToken(ctx context.Context, token string) context.Context {
+	return ContextWithRequestOptions(ctx, WithToken(token))
+}
+
+// applyRequest does nothing, the token is applied to the request by kenall.Client.
+func (w withToken) applyRequest(*http.Request) {}
+
+// WithToken overrides the authorization token of the request, the call fails with kenall.ErrInvalidArgument
+// if the token is empty.
+func WithToken(token string) RequestOption {
+	return withToken{token: token}
+}
+
+// tokenOf returns the token of the last kenall.WithToken in the context.
+func tokenOf(ctx context.Context) (string, bool) {
+	opts, _ := ctx.Value(requestOptionsKey{}).([]RequestOption)
+	for i := len(opts) - 1; i >= 0; i-- {
+		if w, ok := opts[i].(withToken); ok {
+			return w.token, true
+		}
+	}
+
+	return "", false
+}
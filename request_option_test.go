@@ -0,0 +1,220 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+	"github.com/osamingo/go-kenall/v2/kenalltest"
+)
+
+func TestContextWithRequestOptions(t *testing.T) {
+	t.Parallel()
+
+	var got *http.Request
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Clone(context.Background())
+
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := kenall.ContextWithRequestOptions(context.Background(),
+		kenall.WithHeader("X-Request-Id", "1"),
+		kenall.WithUserAgent("my-app/1.0"),
+	)
+	ctx = kenall.ContextWithRequestOptions(ctx, kenall.WithHeader("X-Request-Id", "2"), kenall.WithQuery("debug", "true"))
+
+	if _, err := cli.GetAddress(ctx, "1008105"); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := got.Header.Values("X-Request-Id"); len(v) != 2 || v[0] != "1" || v[1] != "2" {
+		t.Errorf("give: %v, want: [1 2]", v)
+	}
+	if v := got.Header.Get("User-Agent"); v != "my-app/1.0" {
+		t.Errorf("give: %v, want: %v", v, "my-app/1.0")
+	}
+	if v := got.URL.Query().Get("debug"); v != "true" {
+		t.Errorf("give: %v, want: %v", v, "true")
+	}
+	if v := got.Header.Get("Authorization"); v != "token opencollector" {
+		t.Errorf("give: %v, want: %v", v, "token opencollector")
+	}
+
+	if _, err := cli.GetAddress(context.Background(), "1008105"); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := got.Header.Get("X-Request-Id"); v != "" {
+		t.Errorf("give: %v, want: the options only for the context", v)
+	}
+}
+
+func TestClient_RequestOptions(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu   sync.Mutex
+		seen = map[string]int{}
+	)
+
+	next := kenalltest.NewHandler(kenalltest.WithToken("opencollector"))
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		for _, v := range r.Header.Values("X-Request-Id") {
+			seen[v]++
+		}
+		mu.Unlock()
+
+		next.ServeHTTP(w, r)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := map[string]struct {
+		call func(ctx context.Context, opt kenall.RequestOption) error
+	}{
+		"GetAddress": {call: func(ctx context.Context, opt kenall.RequestOption) error {
+			_, err := cli.GetAddress(ctx, "1008105", opt)
+
+			return err
+		}},
+		"GetCity": {call: func(ctx context.Context, opt kenall.RequestOption) error {
+			_, err := cli.GetCity(ctx, "13", opt)
+
+			return err
+		}},
+		"GetCorporation": {call: func(ctx context.Context, opt kenall.RequestOption) error {
+			_, err := cli.GetCorporation(ctx, "2021001052596", opt, kenall.WithoutClosedCorporations())
+
+			return err
+		}},
+		"GetWhoami": {call: func(ctx context.Context, opt kenall.RequestOption) error {
+			_, err := cli.GetWhoami(ctx, opt)
+
+			return err
+		}},
+		"GetHolidays": {call: func(ctx context.Context, opt kenall.RequestOption) error {
+			_, err := cli.GetHolidays(ctx, opt)
+
+			return err
+		}},
+		"SearchAddresses": {call: func(ctx context.Context, opt kenall.RequestOption) error {
+			_, err := cli.SearchAddresses(ctx, "東京都", kenall.WithLimit(1), opt)
+
+			return err
+		}},
+		"GetBanks": {call: func(ctx context.Context, opt kenall.RequestOption) error {
+			_, err := cli.GetBanks(ctx, opt)
+
+			return err
+		}},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := kenall.ContextWithRequestOptions(context.Background(), kenall.WithHeader("X-Request-Id", name+"-ctx"))
+			if err := c.call(ctx, kenall.WithHeader("X-Request-Id", name)); err != nil {
+				t.Fatal(err)
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if seen[name] != 1 || seen[name+"-ctx"] != 1 {
+				t.Errorf("give: %v, want: the option and the context applied once", seen)
+			}
+		})
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithTimeout(20*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// NOTE: the contexts are created in each subtest, since a deadline may pass while the parallel subtests wait.
+	longer := func() (context.Context, context.CancelFunc) {
+		return context.WithTimeout(context.Background(), 5*time.Second)
+	}
+
+	cases := map[string]struct {
+		ctx       func() (context.Context, context.CancelFunc)
+		wantError bool
+	}{
+		"Default timeout": {
+			ctx:       func() (context.Context, context.CancelFunc) { return context.Background(), func() {} },
+			wantError: true,
+		},
+		"Deadline of context": {ctx: longer, wantError: false},
+		"Longer request timeout": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				return kenall.ContextWithRequestOptions(context.Background(), kenall.WithRequestTimeout(5*time.Second)), func() {}
+			},
+			wantError: false,
+		},
+		"Shorter request timeout": {
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := longer()
+
+				return kenall.ContextWithRequestOptions(ctx, kenall.WithRequestTimeout(20*time.Millisecond)), cancel
+			},
+			wantError: true,
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := c.ctx()
+			defer cancel()
+
+			_, err := cli.GetAddress(ctx, "1008105")
+			if c.wantError {
+				if !errors.Is(err, context.DeadlineExceeded) {
+					t.Errorf("give: %v, want: %v", err, context.DeadlineExceeded)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package kenall
+
+import (
+	"context"
+	"strings"
+)
+
+// A ResolveAddressResponse is a result of kenall.Client.ResolveAddress.
+type ResolveAddressResponse struct {
+	Normalized *GetNormalizeAddressResponse
+	// PostalCode is the postal code determined from the normalized address, it is empty if it is ambiguous.
+	PostalCode string
+	// Address is the result of the postal code, it is nil if the postal code is not determined.
+	Address *GetAddressResponse
+}
+
+// ResolveAddress normalizes the address and, when the postal code is determined confidently,
+// requests the addresses for the postal code as well.
+func (cli *Client) ResolveAddress(ctx context.Context, address string) (*ResolveAddressResponse, error) {
+	normalized, err := cli.GetNormalizeAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ResolveAddressResponse{Normalized: normalized}
+
+	code, ok := normalized.determinePostalCode()
+	if !ok {
+		return res, nil
+	}
+
+	if res.Address, err = cli.GetAddress(ctx, code); err != nil {
+		return nil, err
+	}
+
+	res.PostalCode = code
+
+	return res, nil
+}
+
+// determinePostalCode returns the postal code if the matched addresses of the town narrow it down to one.
+// An address of a building is taken only if the query has the building, and its floor is taken if possible.
+func (r *GetNormalizeAddressResponse) determinePostalCode() (string, bool) {
+	q := &r.Query
+	if !q.Prefecture.Valid || !q.City.Valid || !q.Town.Valid {
+		return "", false
+	}
+
+	var general, building []*Address
+
+	for _, a := range r.Addresses {
+		if a.Corporation.Name != "" || a.Prefecture != q.Prefecture.String || a.City != q.City.String || a.Town != q.Town.String {
+			continue
+		}
+
+		switch {
+		case a.Building == "":
+			general = append(general, a)
+		case q.Building.Valid && a.Building == q.Building.String:
+			building = append(building, a)
+		}
+	}
+
+	if len(building) > 0 {
+		floor := normalizeFloor(q.FloorRoom.String)
+
+		for _, want := range []string{floor, ""} {
+			var matched []*Address
+
+			for _, a := range building {
+				if normalizeFloor(a.Floor) == want {
+					matched = append(matched, a)
+				}
+			}
+
+			if len(matched) > 0 {
+				return uniquePostalCode(matched)
+			}
+		}
+
+		return "", false
+	}
+
+	// NOTE: the building may have its own postal code in the addresses beyond the limit.
+	if q.Building.Valid && r.Count > len(r.Addresses) {
+		return "", false
+	}
+
+	return uniquePostalCode(general)
+}
+
+func uniquePostalCode(addrs []*Address) (string, bool) {
+	if len(addrs) == 0 {
+		return "", false
+	}
+
+	for _, a := range addrs[1:] {
+		if a.PostalCode != addrs[0].PostalCode {
+			return "", false
+		}
+	}
+
+	return addrs[0].PostalCode, true
+}
+
+// normalizeFloor folds full-width characters and trims the suffix of the floor, e.g. "１８階" and "18F" to "18".
+func normalizeFloor(floor string) string {
+	floor = strings.Map(func(r rune) rune {
+		if r >= '！' && r <= '～' {
+			return r - '！' + '!'
+		}
+
+		return r
+	}, strings.TrimSpace(floor))
+
+	for _, suffix := range []string{"階", "F", "f"} {
+		floor = strings.TrimSuffix(floor, suffix)
+	}
+
+	return floor
+}
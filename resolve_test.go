@@ -0,0 +1,85 @@
+package kenall_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_ResolveAddress(t *testing.T) {
+	t.Parallel()
+
+	i := bytes.LastIndex(searchAddressResponse, []byte(`"building": "六本木ヒルズ森タワー"`))
+	izumi := append(append(append([]byte{}, searchAddressResponse[:i]...), []byte(`"building": "泉ガーデンタワー"`)...),
+		searchAddressResponse[i+len(`"building": "六本木ヒルズ森タワー"`):]...)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body []byte
+
+		switch r.URL.Path {
+		case "/postalcode/":
+			switch r.URL.Query().Get("t") {
+			case "東京都千代田区千代田1-1":
+				body = []byte(`{"version":"2022-03-31","count":1,"query":{"prefecture":"東京都","city":"千代田区","town":"千代田","block_lot_num":"1-1"},"data":[{"postal_code":"1000001","prefecture":"東京都","city":"千代田区","town":"千代田"}]}`)
+			case "東京都港区六本木一丁目6番1号泉ガーデンタワー18F":
+				body = izumi
+			default:
+				body = searchAddressResponse
+			}
+		case "/postalcode/1000001", "/postalcode/1066018":
+			body = addressResponse
+		default:
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		if _, err := w.Write(body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cases := map[string]struct {
+		give           string
+		wantPostalCode string
+		wantError      error
+	}{
+		"Town":               {give: "東京都千代田区千代田1-1", wantPostalCode: "1000001", wantError: nil},
+		"Building and floor": {give: "東京都港区六本木一丁目6番1号泉ガーデンタワー18F", wantPostalCode: "1066018", wantError: nil},
+		"Ambiguous building": {give: "東京都港区六本木六丁目10番1号六本木ヒルズ森タワー18F", wantPostalCode: "", wantError: nil},
+		"Empty":              {give: "", wantPostalCode: "", wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			res, err := cli.ResolveAddress(context.Background(), c.give)
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if res == nil {
+				return
+			}
+			if res.PostalCode != c.wantPostalCode {
+				t.Errorf("give: %v, want: %v", res.PostalCode, c.wantPostalCode)
+			}
+			if (res.Address != nil) != (c.wantPostalCode != "") {
+				t.Errorf("give: %v, want: %v", res.Address, c.wantPostalCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package kenall
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// A RetryPolicy configures automatic retries of kenall.Client.
+// Only requests classified as idempotent are retried, see kenall.WithIdempotency.
+type RetryPolicy struct {
+	// MaxRetries is the maximum number of retries after the first attempt, zero disables retrying.
+	MaxRetries int
+	// MinBackoff is the wait before the first retry, it is doubled for each following retry.
+	MinBackoff time.Duration
+	// MaxBackoff caps the wait between retries if it is positive.
+	MaxBackoff time.Duration
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.MinBackoff
+	for i := 0; i < attempt && (p.MaxBackoff <= 0 || d < p.MaxBackoff); i++ {
+		d *= 2
+	}
+
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+
+	return d
+}
+
+// isIdempotent reports whether the request is safe to be sent again.
+// The classification follows the HTTP method unless it is overridden for the endpoint family.
+func (cli *Client) isIdempotent(method string, family EndpointFamily) bool {
+	if v, ok := cli.idempotency[family]; ok {
+		return v
+	}
+
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func isRetryableError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if errors.Is(err, ErrInternalServerError) || errors.Is(err, ErrBadGateway) ||
+		errors.Is(err, ErrServiceUnavailable) || errors.Is(err, ErrGatewayTimeout) {
+		return true
+	}
+
+	var te interface{ Timeout() bool }
+
+	return errors.As(err, &te) && te.Timeout()
+}
+
+// IsTemporary reports whether the error is caused by a temporary condition of the kenall service or the network,
+// i.e. a timeout, the rate limit (429), a bad gateway (502), an unavailable service (503) or a gateway timeout (504).
+// The same request is expected to succeed later, see kenall.TooManyRequestsError for the wait of the rate limit.
+func IsTemporary(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if errors.Is(err, ErrTooManyRequests) || errors.Is(err, ErrBadGateway) ||
+		errors.Is(err, ErrServiceUnavailable) || errors.Is(err, ErrGatewayTimeout) {
+		return true
+	}
+
+	var te interface{ Timeout() bool }
+
+	return errors.As(err, &te) && te.Timeout()
+}
+
+// IsRetryable reports whether the same request may succeed if it is sent again, for the callers implementing their
+// own retry loops. In addition to kenall.IsTemporary, it reports true for the other 5xx statuses except
+// 501 not implemented and 505 HTTP version not supported, and for a connection reset or closed by the peer.
+// A canceled request and the other 4xx statuses are never retryable.
+func IsRetryable(err error) bool {
+	if IsTemporary(err) {
+		return true
+	}
+
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	var aerr *APIError
+	if errors.As(err, &aerr) {
+		switch aerr.StatusCode {
+		case http.StatusNotImplemented, http.StatusHTTPVersionNotSupported:
+			return false
+		default:
+			return aerr.StatusCode >= http.StatusInternalServerError
+		}
+	}
+
+	return errors.Is(err, ErrInternalServerError) || isConnectionResetError(context.Background(), err)
+}
+
+// isConnectionResetError reports whether the request failed because the connection was reset or closed by the peer,
+// which typically happens when a long idle keep-alive connection is reused.
+func isConnectionResetError(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	var ue *url.Error
+	if !errors.As(err, &ue) || ue.Timeout() {
+		return false
+	}
+
+	return isConnectionResetErrno(err) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+func endpointFamilyOf(endpoint string, u *url.URL) EndpointFamily {
+	p := u.Path
+	if base, err := url.Parse(endpoint); err == nil {
+		p = strings.TrimPrefix(p, strings.TrimSuffix(base.Path, "/"))
+	}
+
+	p = strings.TrimPrefix(p, "/")
+	if i := strings.IndexByte(p, '/'); i >= 0 {
+		// NOTE: the API version is skipped for a request overridden by kenall.WithOperationAPIVersion.
+		if isAPIVersion(p[:i]) {
+			p = p[i+1:]
+			if i = strings.IndexByte(p, '/'); i < 0 {
+				return EndpointFamily(p)
+			}
+		}
+
+		p = p[:i]
+	}
+
+	return EndpointFamily(p)
+}
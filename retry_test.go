@@ -0,0 +1,187 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_Retry(t *testing.T) {
+	t.Parallel()
+
+	policy := kenall.RetryPolicy{MaxRetries: 2, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+	cases := map[string]struct {
+		failures     int32
+		status       int
+		opts         []kenall.ClientOption
+		wantError    error
+		wantAttempts int32
+	}{
+		"No retry policy":         {failures: 1, opts: nil, wantError: kenall.ErrInternalServerError, wantAttempts: 1},
+		"Recover by retry":        {failures: 2, opts: []kenall.ClientOption{kenall.WithRetryPolicy(policy)}, wantError: nil, wantAttempts: 3},
+		"Exceed max retries":      {failures: 3, opts: []kenall.ClientOption{kenall.WithRetryPolicy(policy)}, wantError: kenall.ErrInternalServerError, wantAttempts: 3},
+		"Non idempotent endpoint": {failures: 1, opts: []kenall.ClientOption{kenall.WithRetryPolicy(policy), kenall.WithIdempotency(kenall.EndpointFamilyPostalCode, false)}, wantError: kenall.ErrInternalServerError, wantAttempts: 1},
+		"Bad gateway":             {failures: 3, status: http.StatusBadGateway, opts: []kenall.ClientOption{kenall.WithRetryPolicy(policy)}, wantError: kenall.ErrBadGateway, wantAttempts: 3},
+		"Service unavailable":     {failures: 2, status: http.StatusServiceUnavailable, opts: []kenall.ClientOption{kenall.WithRetryPolicy(policy)}, wantError: nil, wantAttempts: 3},
+		"Gateway timeout":         {failures: 3, status: http.StatusGatewayTimeout, opts: []kenall.ClientOption{kenall.WithRetryPolicy(policy)}, wantError: kenall.ErrGatewayTimeout, wantAttempts: 3},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) <= c.failures {
+					status := c.status
+					if status == 0 {
+						status = http.StatusInternalServerError
+					}
+					w.WriteHeader(status)
+
+					return
+				}
+				if _, err := w.Write(addressResponse); err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			cli, err := kenall.NewClient("opencollector", append(c.opts, kenall.WithEndpoint(srv.URL))...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = cli.GetAddress(context.Background(), "1008105")
+			if !errors.Is(err, c.wantError) {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if n := atomic.LoadInt32(&attempts); n != c.wantAttempts {
+				t.Errorf("give: %v, want: %v", n, c.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestClient_RetryConnectionReset(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		resets       int32
+		wantError    bool
+		wantAttempts int32
+	}{
+		"Recover on a fresh connection": {resets: 1, wantError: false, wantAttempts: 2},
+		"Retry only once":               {resets: 2, wantError: true, wantAttempts: 2},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts int32
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&attempts, 1) <= c.resets {
+					conn, _, err := w.(http.Hijacker).Hijack()
+					if err != nil {
+						t.Error(err)
+
+						return
+					}
+					_ = conn.Close()
+
+					return
+				}
+				if _, err := w.Write(addressResponse); err != nil {
+					w.WriteHeader(http.StatusInternalServerError)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = cli.GetAddress(context.Background(), "1008105")
+			if (err != nil) != c.wantError {
+				t.Errorf("give: %v, want: %v", err, c.wantError)
+			}
+			if n := atomic.LoadInt32(&attempts); n != c.wantAttempts {
+				t.Errorf("give: %v, want: %v", n, c.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	t.Parallel()
+
+	reset := &url.Error{Op: "Get", URL: "https://api.kenall.jp/v1/postalcode/1008105", Err: io.ErrUnexpectedEOF}
+
+	cases := map[string]struct {
+		err           error
+		wantTemporary bool
+		wantRetryable bool
+	}{
+		"Nil":                   {err: nil, wantTemporary: false, wantRetryable: false},
+		"Not found":             {err: &kenall.APIError{StatusCode: http.StatusNotFound, Err: kenall.ErrNotFound}, wantTemporary: false, wantRetryable: false},
+		"Too many requests":     {err: &kenall.APIError{StatusCode: http.StatusTooManyRequests, Err: &kenall.TooManyRequestsError{}}, wantTemporary: true, wantRetryable: true},
+		"Internal server error": {err: &kenall.APIError{StatusCode: http.StatusInternalServerError, Err: kenall.ErrInternalServerError}, wantTemporary: false, wantRetryable: true},
+		"Not implemented":       {err: &kenall.APIError{StatusCode: http.StatusNotImplemented, Err: errors.New("501")}, wantTemporary: false, wantRetryable: false},
+		"Service unavailable":   {err: fmt.Errorf("wrapped: %w", kenall.ErrServiceUnavailable), wantTemporary: true, wantRetryable: true},
+		"Insufficient storage":  {err: &kenall.APIError{StatusCode: http.StatusInsufficientStorage, Err: errors.New("507")}, wantTemporary: false, wantRetryable: true},
+		"Timeout":               {err: kenall.ErrTimeout(context.DeadlineExceeded), wantTemporary: true, wantRetryable: true},
+		"Canceled":              {err: fmt.Errorf("kenall: %w", context.Canceled), wantTemporary: false, wantRetryable: false},
+		"Connection reset":      {err: fmt.Errorf("kenall: %w", reset), wantTemporary: false, wantRetryable: true},
+		"Invalid argument":      {err: kenall.ErrInvalidArgument, wantTemporary: false, wantRetryable: false},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := kenall.IsTemporary(c.err); got != c.wantTemporary {
+				t.Errorf("give: %v, want: %v", got, c.wantTemporary)
+			}
+			if got := kenall.IsRetryable(c.err); got != c.wantRetryable {
+				t.Errorf("give: %v, want: %v", got, c.wantRetryable)
+			}
+		})
+	}
+
+	t.Run("Response", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		t.Cleanup(srv.Close)
+
+		cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = cli.GetAddress(context.Background(), "1008105")
+		if !kenall.IsTemporary(err) || !kenall.IsRetryable(err) {
+			t.Errorf("give: %v, want: %v", err, "a temporary error")
+		}
+	})
+}
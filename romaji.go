@@ -0,0 +1,216 @@
+package kenall
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+var (
+	// romajiSyllables is the Hepburn romanization of a katakana or a katakana with a small kana.
+	//nolint: gochecknoglobals
+	romajiSyllables = newRomajiSyllables()
+
+	// romajiSuffixes are the romanization of the administrative suffixes keyed by the kanji and the reading.
+	//nolint: gochecknoglobals
+	romajiSuffixes = []struct{ kanji, kana, romaji string }{
+		{"区", "ク", "ku"}, {"市", "シ", "shi"}, {"町", "チョウ", "cho"}, {"町", "マチ", "machi"},
+		{"村", "ムラ", "mura"}, {"村", "ソン", "son"}, {"郡", "グン", "gun"},
+	}
+
+	// designatedCityKana is the reading of the designated cities which consist of wards.
+	//nolint: gochecknoglobals
+	designatedCityKana = map[string]string{
+		"札幌市": "サッポロ", "仙台市": "センダイ", "さいたま市": "サイタマ", "千葉市": "チバ", "横浜市": "ヨコハマ",
+		"川崎市": "カワサキ", "相模原市": "サガミハラ", "新潟市": "ニイガタ", "静岡市": "シズオカ", "浜松市": "ハママツ",
+		"名古屋市": "ナゴヤ", "京都市": "キョウト", "大阪市": "オオサカ", "堺市": "サカイ", "神戸市": "コウベ",
+		"岡山市": "オカヤマ", "広島市": "ヒロシマ", "北九州市": "キタキュウシュウ", "福岡市": "フクオカ", "熊本市": "クマモト",
+	}
+)
+
+func newRomajiSyllables() map[string]string {
+	m := map[string]string{}
+
+	rows := []struct{ kana, romaji string }{
+		{"アイウエオ", "a i u e o"}, {"カキクケコ", "ka ki ku ke ko"}, {"サシスセソ", "sa shi su se so"},
+		{"タチツテト", "ta chi tsu te to"}, {"ナニヌネノ", "na ni nu ne no"}, {"ハヒフヘホ", "ha hi fu he ho"},
+		{"マミムメモ", "ma mi mu me mo"}, {"ヤユヨ", "ya yu yo"}, {"ラリルレロ", "ra ri ru re ro"},
+		{"ワヰヱヲン", "wa i e o n"}, {"ガギグゲゴ", "ga gi gu ge go"}, {"ザジズゼゾ", "za ji zu ze zo"},
+		{"ダヂヅデド", "da ji zu de do"}, {"バビブベボ", "ba bi bu be bo"}, {"パピプペポ", "pa pi pu pe po"},
+		{"ァィゥェォ", "a i u e o"}, {"ャュョヮヴ", "ya yu yo wa vu"},
+	}
+	for _, row := range rows {
+		for i, r := range []rune(row.kana) {
+			m[string(r)] = strings.Fields(row.romaji)[i]
+		}
+	}
+
+	for kana, c := range map[string]string{
+		"キ": "k", "ギ": "g", "ニ": "n", "ヒ": "h", "ミ": "m", "リ": "r", "ビ": "b", "ピ": "p",
+	} {
+		m[kana+"ャ"], m[kana+"ュ"], m[kana+"ョ"] = c+"ya", c+"yu", c+"yo"
+	}
+
+	for kana, c := range map[string]string{"シ": "sh", "ジ": "j", "ヂ": "j", "チ": "ch"} {
+		m[kana+"ャ"], m[kana+"ュ"], m[kana+"ェ"], m[kana+"ョ"] = c+"a", c+"u", c+"e", c+"o"
+	}
+
+	for kana, romaji := range map[string]string{
+		"ティ": "ti", "ディ": "di", "デュ": "dyu", "ファ": "fa", "フィ": "fi", "フェ": "fe", "フォ": "fo",
+		"ウィ": "wi", "ウェ": "we", "ウォ": "wo", "ヴァ": "va", "ヴィ": "vi", "ヴェ": "ve", "ヴォ": "vo",
+	} {
+		m[kana] = romaji
+	}
+
+	return m
+}
+
+// kanaToRomaji transliterates the kana into the Hepburn romanization in lower case. The long vowels are
+// simplified without macrons as in the addresses for the international mail, e.g. "トウキョウ" to "tokyo".
+// Characters other than kana are kept.
+func kanaToRomaji(s string) string {
+	kana := []rune(hiraganaToKatakana(halfWidthToFullWidthKana(s)))
+
+	var (
+		b       strings.Builder
+		doubled bool
+	)
+
+	for i := 0; i < len(kana); i++ {
+		switch kana[i] {
+		case 'ッ':
+			doubled = true
+
+			continue
+		case 'ー':
+			if last, _ := utf8.DecodeLastRuneInString(b.String()); strings.ContainsRune("aiueo", last) {
+				b.WriteRune(last)
+			}
+
+			continue
+		}
+
+		start, syllable, ok := i, "", false
+		if i+1 < len(kana) {
+			if syllable, ok = romajiSyllables[string(kana[i:i+2])]; ok {
+				i++
+			}
+		}
+
+		if !ok {
+			if syllable, ok = romajiSyllables[string(kana[i])]; !ok {
+				doubled = false
+				b.WriteRune(kana[i])
+
+				continue
+			}
+		}
+
+		if doubled {
+			doubled = false
+			if strings.HasPrefix(syllable, "ch") {
+				b.WriteByte('t')
+			} else if !strings.ContainsRune("aiueon", rune(syllable[0])) {
+				b.WriteByte(syllable[0])
+			}
+		}
+
+		// NOTE: an apostrophe separates the syllabic n from the following vowel or y, e.g. "shin'osaka".
+		if start > 0 && kana[start-1] == 'ン' && strings.ContainsRune("aiueoy", rune(syllable[0])) {
+			b.WriteByte('\'')
+		}
+
+		b.WriteString(syllable)
+	}
+
+	return simplifyLongVowels(b.String())
+}
+
+func simplifyLongVowels(s string) string {
+	for _, long := range []string{"ou", "oo", "uu"} {
+		s = strings.ReplaceAll(s, long, long[:1])
+	}
+
+	return s
+}
+
+// romanizeName transliterates the name in kana with its administrative suffix hyphenated, e.g. "ミナトク" of
+// "港区" to "Minato-ku".
+func romanizeName(name, kana string) string {
+	kana = hiraganaToKatakana(halfWidthToFullWidthKana(kana))
+
+	for _, sfx := range romajiSuffixes {
+		if strings.HasSuffix(name, sfx.kanji) && strings.HasSuffix(kana, sfx.kana) && len(kana) > len(sfx.kana) {
+			return capitalize(kanaToRomaji(strings.TrimSuffix(kana, sfx.kana))) + "-" + sfx.romaji
+		}
+	}
+
+	return capitalize(kanaToRomaji(kana))
+}
+
+// romanizeCity transliterates the city in kana, and splits a ward of a designated city into the ward and the city
+// in the Western order, e.g. "オオサカシヨドガワク" of "大阪市淀川区" to "Yodogawa-ku", "Osaka-shi".
+func romanizeCity(name, kana string) []string {
+	kana = hiraganaToKatakana(halfWidthToFullWidthKana(kana))
+
+	if i := strings.Index(name, "市"); i > 0 && strings.HasSuffix(name, "区") {
+		city := name[:i+len("市")]
+		if prefix, ok := designatedCityKana[city]; ok && strings.HasPrefix(kana, prefix+"シ") {
+			return []string{
+				romanizeName(name[len(city):], kana[len(prefix+"シ"):]),
+				romanizeName(city, prefix+"シ"),
+			}
+		}
+	}
+
+	return []string{romanizeName(name, kana)}
+}
+
+// romanizePrefecture transliterates the prefecture in kana without its suffix except Hokkaido, e.g. "Tokyo".
+func romanizePrefecture(name, kana string) string {
+	kana = hiraganaToKatakana(halfWidthToFullWidthKana(kana))
+
+	for _, sfx := range []struct{ kanji, kana string }{{"都", "ト"}, {"府", "フ"}, {"県", "ケン"}} {
+		if strings.HasSuffix(name, sfx.kanji) && strings.HasSuffix(kana, sfx.kana) {
+			kana = strings.TrimSuffix(kana, sfx.kana)
+
+			break
+		}
+	}
+
+	return capitalize(kanaToRomaji(kana))
+}
+
+// capitalize capitalizes the first letter of each word separated by spaces.
+func capitalize(s string) string {
+	words := strings.Fields(s)
+	for i, w := range words {
+		r, n := utf8.DecodeRuneInString(w)
+		words[i] = string(unicode.ToUpper(r)) + w[n:]
+	}
+
+	return strings.Join(words, " ")
+}
+
+// FormatRomaji composes the address into a single line of the Western order in the Hepburn romanization
+// from the kana fields, e.g. "Roppongi, Minato-ku, Tokyo 106-6290, Japan" for shipping labels
+// of the international carriers. A ward of a designated city precedes the city, e.g. "Yodogawa-ku, Osaka-shi".
+// The components without kana, i.e. the koaza, the street of Kyoto, the building and the floor, are not included.
+func (a *Address) FormatRomaji() string {
+	parts := make([]string, 0, 4) //nolint: gomnd
+
+	if a.TownKana != "" && !isAreaNote(a.Town) {
+		parts = append(parts, romanizeName(a.Town, a.TownKana))
+	}
+
+	if a.CityKana != "" {
+		parts = append(parts, romanizeCity(a.City, a.CityKana)...)
+	}
+
+	pref := joinNonEmpty(" ", romanizePrefecture(a.Prefecture, a.PrefectureKana), formatPostalCode(a.PostalCode))
+	if pref != "" {
+		parts = append(parts, pref)
+	}
+
+	return strings.Join(append(parts, "Japan"), ", ")
+}
@@ -0,0 +1,73 @@
+package kenall_test
+
+import (
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestAddress_FormatRomaji(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give *kenall.Address
+		want string
+	}{
+		"Ward": {
+			give: &kenall.Address{
+				PostalCode: "1066290", Prefecture: "東京都", PrefectureKana: "トウキョウト", City: "港区", CityKana: "ミナトク",
+				Town: "六本木", TownKana: "ロッポンギ", Building: "六本木ヒルズ森タワー", Floor: "18F",
+			},
+			want: "Roppongi, Minato-ku, Tokyo 106-6290, Japan",
+		},
+		"Sokuon before ch": {
+			give: &kenall.Address{
+				PostalCode: "1040032", Prefecture: "東京都", PrefectureKana: "トウキョウト", City: "中央区", CityKana: "チュウオウク",
+				Town: "八丁堀", TownKana: "ハッチョウボリ",
+			},
+			want: "Hatchobori, Chuo-ku, Tokyo 104-0032, Japan",
+		},
+		"Syllabic n": {
+			give: &kenall.Address{
+				PostalCode: "5320011", Prefecture: "大阪府", PrefectureKana: "オオサカフ", City: "大阪市淀川区",
+				CityKana: "オオサカシヨドガワク", Town: "西中島", TownKana: "ニシナカジマ",
+			},
+			want: "Nishinakajima, Yodogawa-ku, Osaka-shi, Osaka 532-0011, Japan",
+		},
+		"Apostrophe": {
+			give: &kenall.Address{Prefecture: "東京都", PrefectureKana: "トウキョウト", City: "新宿区", CityKana: "シンジュクク",
+				Town: "新小川町", TownKana: "シンオガワマチ"},
+			want: "Shin'ogawa-machi, Shinjuku-ku, Tokyo, Japan",
+		},
+		"Half-width and hiragana": {
+			give: &kenall.Address{
+				PostalCode: "6800001", Prefecture: "鳥取県", PrefectureKana: "ﾄｯﾄﾘｹﾝ", City: "鳥取市", CityKana: "とっとりし",
+				Town: "浜坂", TownKana: "ﾊﾏｻｶ",
+			},
+			want: "Hamasaka, Tottori-shi, Tottori 680-0001, Japan",
+		},
+		"Hokkaido and area note": {
+			give: &kenall.Address{
+				PostalCode: "0600000", Prefecture: "北海道", PrefectureKana: "ホッカイドウ", City: "札幌市中央区",
+				CityKana: "サッポロシチュウオウク", Town: "以下に掲載がない場合", TownKana: "イカニケイサイガナイバアイ",
+			},
+			want: "Chuo-ku, Sapporo-shi, Hokkaido 060-0000, Japan",
+		},
+		"Long vowel mark": {
+			give: &kenall.Address{Prefecture: "東京都", PrefectureKana: "トーキョート", City: "町田市", CityKana: "マチダシ"},
+			want: "Machida-shi, Tokyo, Japan",
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := c.give.FormatRomaji(); got != c.want {
+				t.Errorf("give: %q, want: %q", got, c.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package kenall
+
+import (
+	"fmt"
+	"time"
+)
+
+// A ScheduleSpec is a time of day in Japan on which a job runs every business day, e.g. 09:00 JST.
+type ScheduleSpec struct {
+	Hour   int
+	Minute int
+	Second int
+}
+
+// NextRun returns the first time after the given time that matches the spec on a business day of the calendar.
+func (c *Calendar) NextRun(after time.Time, spec ScheduleSpec) (time.Time, error) {
+	if spec.Hour < 0 || spec.Hour > 23 || spec.Minute < 0 || spec.Minute > 59 || spec.Second < 0 || spec.Second > 59 {
+		return time.Time{}, ErrInvalidArgument
+	}
+
+	y, m, d := after.In(jst).Date()
+	for i := 0; i <= maxClosedDays; i++ {
+		run := time.Date(y, m, d+i, spec.Hour, spec.Minute, spec.Second, 0, jst)
+		if run.After(after) && c.IsBusinessDay(run) {
+			return run, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("kenall: no business day is found: %w", ErrInvalidArgument)
+}
@@ -0,0 +1,46 @@
+package kenall_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestCalendar_NextRun(t *testing.T) {
+	t.Parallel()
+
+	cal := newTestingCalendar(t)
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	spec := kenall.ScheduleSpec{Hour: 9, Minute: 0, Second: 0}
+
+	cases := map[string]struct {
+		after     time.Time
+		spec      kenall.ScheduleSpec
+		want      time.Time
+		wantError error
+	}{
+		"Before the time":       {after: time.Date(2022, 1, 11, 8, 0, 0, 0, jst), spec: spec, want: time.Date(2022, 1, 11, 9, 0, 0, 0, jst), wantError: nil},
+		"Just the time":         {after: time.Date(2022, 1, 11, 9, 0, 0, 0, jst), spec: spec, want: time.Date(2022, 1, 12, 9, 0, 0, 0, jst), wantError: nil},
+		"Skip weekend/holiday":  {after: time.Date(2022, 1, 7, 10, 0, 0, 0, jst), spec: spec, want: time.Date(2022, 1, 11, 9, 0, 0, 0, jst), wantError: nil},
+		"Date in Japan":         {after: time.Date(2022, 1, 10, 23, 0, 0, 0, time.UTC), spec: spec, want: time.Date(2022, 1, 11, 9, 0, 0, 0, jst), wantError: nil},
+		"Invalid specification": {after: time.Date(2022, 1, 11, 8, 0, 0, 0, jst), spec: kenall.ScheduleSpec{Hour: 24}, want: time.Time{}, wantError: kenall.ErrInvalidArgument},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := cal.NextRun(c.after, c.spec)
+			if !errors.Is(err, c.wantError) {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if !got.Equal(c.want) {
+				t.Errorf("give: %v, want: %v", got, c.want)
+			}
+		})
+	}
+}
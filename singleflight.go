@@ -0,0 +1,106 @@
+package kenall
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"sync"
+)
+
+type (
+	// flightGroup coalesces the identical requests in flight into one, see kenall.WithSingleflight.
+	flightGroup struct {
+		mu      sync.Mutex
+		flights map[string]*flight
+	}
+
+	// sharedResponse is a response which must know that its values are referred by the coalesced callers.
+	sharedResponse interface {
+		markShared()
+	}
+
+	flight struct {
+		done chan struct{}
+		res  interface{}
+		err  error
+	}
+)
+
+func newFlightGroup() *flightGroup {
+	return &flightGroup{flights: map[string]*flight{}}
+}
+
+// coalesces reports whether the request is coalesced with the identical requests in flight.
+// Pooled responses are not shared since they are released by each caller.
+func (cli *Client) coalesces(req *http.Request) bool {
+	return cli.flights != nil && req.Method == http.MethodGet && !cli.pooled
+}
+
+// do sends the request with fn only if no identical request is in flight, otherwise it waits for the one
+// and copies the decoded response to res. The identical requests share the values referred by the response.
+// If the request in flight fails with the error of its own context, the waiting caller sends the request by itself.
+// The finish function is called with the successful response of each caller, before the response is shared.
+func (g *flightGroup) do(
+	req *http.Request, res interface{}, fn func(*http.Request, interface{}) error, finish func(interface{}),
+) error {
+	key := req.Method + " " + req.URL.String() + " " + req.Header.Get("Authorization")
+
+	g.mu.Lock()
+	if f, ok := g.flights[key]; ok {
+		g.mu.Unlock()
+
+		select {
+		case <-f.done:
+		case <-req.Context().Done():
+			return contextError(req.Context())
+		}
+
+		if errors.Is(f.err, errFlightCanceled) || reflect.TypeOf(f.res) != reflect.TypeOf(res) {
+			if err := fn(req, res); err != nil {
+				return err
+			}
+
+			finish(res)
+
+			return nil
+		}
+
+		if f.err != nil {
+			return f.err
+		}
+
+		reflect.ValueOf(res).Elem().Set(reflect.ValueOf(f.res).Elem())
+		finish(res)
+
+		return nil
+	}
+
+	f := &flight{done: make(chan struct{}), res: res}
+	g.flights[key] = f
+	g.mu.Unlock()
+
+	err := fn(req, res)
+	if err == nil {
+		finish(res)
+
+		if sr, ok := res.(sharedResponse); ok {
+			sr.markShared()
+		}
+	}
+
+	f.err = err
+	if err != nil && req.Context().Err() != nil {
+		f.err = errFlightCanceled
+	}
+
+	g.mu.Lock()
+	delete(g.flights, key)
+	g.mu.Unlock()
+	close(f.done)
+
+	return err
+}
+
+func (r *GetAddressResponse) markShared() {
+	r.shared = true
+}
@@ -0,0 +1,199 @@
+package kenall_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithSingleflight(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		time.Sleep(200 * time.Millisecond)
+
+		if r.URL.Path != "/postalcode/1008105" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithSingleflight())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const callers = 5
+
+	var (
+		wg   sync.WaitGroup
+		ress = make([]*kenall.GetAddressResponse, callers)
+		errs = make([]error, callers)
+	)
+
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			ress[i], errs[i] = cli.GetAddress(context.Background(), "1008105")
+		}(i)
+	}
+
+	wg.Wait()
+
+	for i := 0; i < callers; i++ {
+		if errs[i] != nil {
+			t.Fatal(errs[i])
+		}
+		if got := ress[i].Addresses[0].Town; got != "西新宿" {
+			t.Errorf("give: %v, want: %v", got, "西新宿")
+		}
+	}
+
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Errorf("give: %v, want: %v", n, 1)
+	}
+
+	atomic.StoreInt32(&requests, 0)
+
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, err := cli.GetAddress(context.Background(), "1000001"); err == nil {
+				t.Error("an error should not be nil")
+			}
+		}()
+	}
+
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		if _, err := cli.GetAddress(context.Background(), "1008105"); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&requests); n != 2 {
+		t.Errorf("give: %v, want: %v", n, 2)
+	}
+}
+
+func TestWithSingleflight_Canceled(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-time.After(100 * time.Millisecond):
+		}
+
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithSingleflight())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	t.Cleanup(cancel)
+
+	done := make(chan error, 1)
+
+	go func() {
+		_, err := cli.GetAddress(ctx, "1008105")
+		done <- err
+	}()
+
+	for atomic.LoadInt32(&requests) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	if _, err := cli.GetAddress(context.Background(), "1008105"); err != nil {
+		t.Errorf("the waiting caller should not fail with the canceled request: %v", err)
+	}
+	if err := <-done; err == nil {
+		t.Error("an error should not be nil")
+	}
+}
+
+func TestWithSingleflight_GetAddressInto(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&requests, 1) == 1 {
+			time.Sleep(200 * time.Millisecond)
+		}
+
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithSingleflight())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		wg   sync.WaitGroup
+		ress = []*kenall.GetAddressResponse{{}, {}}
+	)
+
+	for i := range ress {
+		wg.Add(1)
+
+		go func(res *kenall.GetAddressResponse) {
+			defer wg.Done()
+
+			if err := cli.GetAddressInto(context.Background(), "1008105", res); err != nil {
+				t.Error(err)
+			}
+		}(ress[i])
+	}
+
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("give: %v, want: %v", n, 1)
+	}
+
+	if err := cli.GetAddressInto(context.Background(), "1008105", ress[0]); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, res := range ress {
+		if got := res.Addresses[0].Town; got != "西新宿" {
+			t.Errorf("give: %v, want: %v", got, "西新宿")
+		}
+	}
+	if ress[0].Addresses[0] == ress[1].Addresses[0] {
+		t.Error("the shared address should not be reused")
+	}
+}
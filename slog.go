@@ -0,0 +1,105 @@
+//go:build go1.21
+
+package kenall
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+)
+
+// withLogger is declared here instead of options.go because log/slog requires Go 1.21.
+type withLogger struct {
+	logger *slog.Logger
+}
+
+// Apply implements kenall.ClientOption interface.
+func (w *withLogger) Apply(cli *Client) {
+	if w.logger == nil {
+		return
+	}
+
+	cli.observers = append(cli.observers, loggerObserver(w.logger))
+	cli.middlewares = append(cli.middlewares, loggerMiddleware(w.logger, cli))
+}
+
+// WithLogger injects optional logger emitting debug logs of the API calls to kenall.Client, i.e. the start and
+// the end of each call, the retries, the cache hits and the non-200 responses, with the structured fields such as
+// the operation, the status code and the latency. Nothing is logged without it.
+func WithLogger(logger *slog.Logger) ClientOption {
+	return &withLogger{logger: logger}
+}
+
+func loggerObserver(logger *slog.Logger) CallObserver {
+	return func(ctx context.Context, call *Call) (context.Context, func(*CallResult)) {
+		if !logger.Enabled(ctx, slog.LevelDebug) {
+			return ctx, nil
+		}
+
+		logger.LogAttrs(ctx, slog.LevelDebug, "kenall: request started",
+			slog.String("operation", call.Operation),
+			slog.String("method", call.Method),
+			slog.String("path", call.Path),
+		)
+
+		return ctx, func(res *CallResult) {
+			if res.CacheHit {
+				logger.LogAttrs(ctx, slog.LevelDebug, "kenall: cache hit",
+					slog.String("operation", call.Operation),
+					slog.String("path", call.Path),
+				)
+			}
+
+			attrs := []slog.Attr{
+				slog.String("operation", call.Operation),
+				slog.String("path", call.Path),
+				slog.Int("status", res.StatusCode),
+				slog.Duration("latency", res.Duration),
+				slog.Int("attempts", res.Attempts),
+				slog.Bool("cache_hit", res.CacheHit),
+			}
+			if res.Err != nil {
+				attrs = append(attrs, slog.String("error", res.Err.Error()))
+			}
+
+			logger.LogAttrs(ctx, slog.LevelDebug, "kenall: request finished", attrs...)
+		}
+	}
+}
+
+// loggerMiddleware logs the retries and the non-200 responses of each attempt.
+func loggerMiddleware(logger *slog.Logger, cli *Client) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			ctx := req.Context()
+			if !logger.Enabled(ctx, slog.LevelDebug) {
+				return next(req)
+			}
+
+			if s := callStateFrom(ctx); s != nil {
+				if attempt := atomic.LoadInt32(&s.attempts); attempt > 1 {
+					logger.LogAttrs(ctx, slog.LevelDebug, "kenall: retrying request",
+						slog.String("method", req.Method),
+						slog.String("path", req.URL.Path),
+						slog.Int("attempt", int(attempt)),
+					)
+				}
+			}
+
+			start := cli.clock.Now()
+			resp, err := next(req)
+
+			if err == nil && resp.StatusCode != http.StatusOK {
+				logger.LogAttrs(ctx, slog.LevelDebug, "kenall: non-200 response",
+					slog.String("method", req.Method),
+					slog.String("path", req.URL.Path),
+					slog.Int("status", resp.StatusCode),
+					slog.Duration("latency", cli.clock.Now().Sub(start)),
+				)
+			}
+
+			return resp, err
+		}
+	}
+}
@@ -0,0 +1,101 @@
+//go:build go1.21
+
+package kenall_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithLogger(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		level slog.Level
+		want  []string
+	}{
+		"Debug": {
+			level: slog.LevelDebug,
+			want: []string{
+				"kenall: request started",
+				"kenall: non-200 response",
+				"status=500",
+				"kenall: retrying request",
+				"attempt=2",
+				"kenall: request finished",
+				"operation=GetAddress",
+				"status=200",
+				"attempts=2",
+				"latency=",
+				"kenall: cache hit",
+			},
+		},
+		"Info": {level: slog.LevelInfo},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var count int32
+
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&count, 1) == 1 {
+					w.WriteHeader(http.StatusInternalServerError)
+
+					return
+				}
+
+				_, _ = w.Write(addressResponse)
+			}))
+			t.Cleanup(srv.Close)
+
+			buf := &bytes.Buffer{}
+			logger := slog.New(slog.NewTextHandler(buf, &slog.HandlerOptions{Level: c.level}))
+
+			cli, err := kenall.NewClient("opencollector",
+				kenall.WithEndpoint(srv.URL),
+				kenall.WithLogger(logger),
+				kenall.WithRetryPolicy(kenall.RetryPolicy{MaxRetries: 1, MinBackoff: time.Millisecond}),
+				kenall.WithCache(kenall.NewMemoryCache(10, time.Minute)),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i := 0; i < 2; i++ {
+				if _, err := cli.GetAddress(context.Background(), "1008105"); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			for _, want := range c.want {
+				if !strings.Contains(buf.String(), want) {
+					t.Errorf("give: %v, want: %v", buf.String(), want)
+				}
+			}
+
+			if c.want == nil && buf.Len() != 0 {
+				t.Errorf("give: %v, want: empty", buf.String())
+			}
+		})
+	}
+}
+
+func TestWithLogger_Nil(t *testing.T) {
+	t.Parallel()
+
+	if _, err := kenall.NewClient("opencollector", kenall.WithLogger(nil)); err != nil {
+		t.Errorf("give: %v, want: nil", err)
+	}
+}
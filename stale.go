@@ -0,0 +1,244 @@
+package kenall
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// defaultStaleRefreshTimeout bounds the background request of kenall.WithStaleOnTimeout if neither the call nor
+// the HTTP client has a timeout.
+const defaultStaleRefreshTimeout = 30 * time.Second
+
+type (
+	// staleMarker is a response which can be served from the previous result, see kenall.WithStaleOnTimeout.
+	staleMarker interface {
+		markStale()
+	}
+
+	// staleCache keeps the latest response bodies of the requests up to the limit, evicting the least recently used.
+	staleCache struct {
+		softDeadline time.Duration
+		maxEntries   int
+
+		mu      sync.Mutex
+		order   *list.List
+		entries map[string]*list.Element
+	}
+
+	staleEntry struct {
+		key  string
+		body []byte
+	}
+
+	// detachedContext keeps the values of the parent context but is never canceled.
+	detachedContext struct {
+		context.Context //nolint: containedctx
+	}
+)
+
+var (
+	_ staleMarker = (*GetAddressResponse)(nil)
+	_ staleMarker = (*GetCityResponse)(nil)
+	_ staleMarker = (*GetCorporationResponse)(nil)
+	_ staleMarker = (*GetHolidaysResponse)(nil)
+	_ staleMarker = (*GetNormalizeAddressResponse)(nil)
+	_ staleMarker = (*SearchCorporationsResponse)(nil)
+)
+
+func newStaleCache(softDeadline time.Duration, maxEntries int) *staleCache {
+	return &staleCache{
+		softDeadline: softDeadline,
+		maxEntries:   maxEntries,
+		order:        list.New(),
+		entries:      map[string]*list.Element{},
+	}
+}
+
+func (c *staleCache) get(key string) (*staleEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+
+	c.order.MoveToFront(e)
+
+	return e.Value.(*staleEntry), true //nolint: forcetypeassert
+}
+
+func (c *staleCache) put(key string, body []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if e, ok := c.entries[key]; ok {
+		e.Value = &staleEntry{key: key, body: body}
+		c.order.MoveToFront(e)
+
+		return
+	}
+
+	c.entries[key] = c.order.PushFront(&staleEntry{key: key, body: body})
+
+	for c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		e := c.order.Back()
+		c.order.Remove(e)
+		delete(c.entries, e.Value.(*staleEntry).key) //nolint: forcetypeassert
+	}
+}
+
+// cachesStale reports whether the response of the request is kept for kenall.WithStaleOnTimeout.
+func (cli *Client) cachesStale(req *http.Request, res interface{}) bool {
+	_, ok := res.(staleMarker)
+
+	return ok && cli.stale != nil && req.Method == http.MethodGet
+}
+
+// sendRequestWithStale sends the request in the background and, if it does not complete within the soft deadline,
+// returns the previous result of the same request flagged stale. Without a previous result it waits as usual.
+// The background request is not canceled with the ctx of the request, it is bounded by refreshTimeout instead,
+// and updates the kept result when it succeeds.
+func (cli *Client) sendRequestWithStale(req *http.Request, res interface{}) error {
+	fresh := reflect.New(reflect.TypeOf(res).Elem())
+	done := make(chan error, 1)
+
+	refreshCtx, cancelRefresh := context.WithTimeout(detachedContext{req.Context()}, cli.refreshTimeout(req.Context()))
+
+	go func(req *http.Request) {
+		defer cancelRefresh()
+
+		done <- cli.send(req, fresh.Interface())
+	}(req.Clone(refreshCtx))
+
+	softCtx, cancel := context.WithCancel(req.Context())
+	defer cancel()
+
+	expired := make(chan struct{})
+
+	go func() {
+		if cli.clock.Sleep(softCtx, cli.stale.softDeadline) == nil {
+			close(expired)
+		}
+	}()
+
+	select {
+	case err := <-done:
+		return cli.receiveFresh(err, res, fresh)
+	case <-expired:
+		if e, ok := cli.stale.get(cacheKey(req)); ok {
+			return cli.decodeStale(req, e, res)
+		}
+	case <-req.Context().Done():
+		return contextError(req.Context())
+	}
+
+	select {
+	case err := <-done:
+		return cli.receiveFresh(err, res, fresh)
+	case <-req.Context().Done():
+		return contextError(req.Context())
+	}
+}
+
+// refreshTimeout returns the deadline of the background request of kenall.WithStaleOnTimeout, which is the timeout
+// of the call, the timeout of the HTTP client or defaultStaleRefreshTimeout in this order.
+func (cli *Client) refreshTimeout(ctx context.Context) time.Duration {
+	if d := cli.timeoutOf(detachedContext{ctx}); d > 0 {
+		return d
+	}
+
+	if cli.HTTPClient != nil && cli.HTTPClient.Timeout > 0 {
+		return cli.HTTPClient.Timeout
+	}
+
+	return defaultStaleRefreshTimeout
+}
+
+func (cli *Client) receiveFresh(err error, res interface{}, fresh reflect.Value) error {
+	if err != nil {
+		return err
+	}
+
+	reflect.ValueOf(res).Elem().Set(fresh.Elem())
+
+	return nil
+}
+
+func (cli *Client) decodeStale(req *http.Request, e *staleEntry, res interface{}) error {
+	if err := cli.decodeKept(req, e.body, res); err != nil {
+		return err
+	}
+
+	res.(staleMarker).markStale() //nolint: forcetypeassert
+
+	return nil
+}
+
+// decodeKept decodes the response body kept by kenall.WithStaleOnTimeout or kenall.WithCache into the response
+// in the same way as a fresh response, except that the version is not observed again.
+func (cli *Client) decodeKept(req *http.Request, body []byte, res interface{}) error {
+	if err := cli.decodeBody(req, bytes.NewReader(body), res); err != nil {
+		return err
+	}
+
+	cli.validateResponse(endpointFamilyOf(cli.Endpoint, req.URL), res)
+	cli.kanaScript.apply(res)
+	cli.hooks.apply(res)
+
+	return nil
+}
+
+func contextError(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return ErrTimeout(ctx.Err())
+	}
+
+	return fmt.Errorf("kenall: failed to send a request: %w", ctx.Err())
+}
+
+// Deadline implements context.Context interface.
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+// Done implements context.Context interface.
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+// Err implements context.Context interface.
+func (detachedContext) Err() error {
+	return nil
+}
+
+func (r *GetAddressResponse) markStale() {
+	r.Stale = true
+}
+
+func (r *GetCityResponse) markStale() {
+	r.Stale = true
+}
+
+func (r *GetCorporationResponse) markStale() {
+	r.Stale = true
+}
+
+func (r *GetHolidaysResponse) markStale() {
+	r.Stale = true
+}
+
+func (r *GetNormalizeAddressResponse) markStale() {
+	r.Stale = true
+}
+
+func (r *SearchCorporationsResponse) markStale() {
+	r.Stale = true
+}
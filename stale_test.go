@@ -0,0 +1,185 @@
+package kenall_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithStaleOnTimeout(t *testing.T) {
+	t.Parallel()
+
+	if kenall.WithStaleOnTimeout(time.Second, 100) == nil {
+		t.Error("a return value should not be nil")
+	}
+}
+
+func TestClient_StaleOnTimeout(t *testing.T) {
+	t.Parallel()
+
+	var (
+		slow     atomic.Bool
+		requests atomic.Int32
+		release  = make(chan struct{})
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		if slow.Load() {
+			<-release
+		}
+
+		if r.URL.Path != "/postalcode/1008105" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithStaleOnTimeout(10*time.Millisecond, 1),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	res, err := cli.GetAddress(ctx, "1008105")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Stale {
+		t.Error("a fresh response should not be stale")
+	}
+
+	slow.Store(true)
+
+	res, err = cli.GetAddress(ctx, "1008105")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Stale || len(res.Addresses) == 0 || res.Addresses[0].Town != "西新宿" {
+		t.Errorf("give: %+v, want: the stale response", res)
+	}
+
+	// Without a kept result, the request waits for the fresh one.
+	errCh := make(chan error, 1)
+
+	go func() {
+		_, err := cli.GetAddress(ctx, "1000001")
+		errCh <- err
+	}()
+
+	select {
+	case err := <-errCh:
+		t.Errorf("give: %v, want: waiting for the slow request", err)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	slow.Store(false)
+	close(release)
+
+	if err := <-errCh; err == nil {
+		t.Error("an error should not be nil")
+	}
+
+	if got := requests.Load(); got != 3 {
+		t.Errorf("give: %v, want: %v", got, 3)
+	}
+}
+
+func TestClient_StaleOnTimeout_Canceled(t *testing.T) {
+	t.Parallel()
+
+	release := make(chan struct{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithStaleOnTimeout(time.Hour, 0),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := cli.GetAddress(ctx, "1008105"); err == nil {
+		t.Error("an error should not be nil")
+	}
+}
+
+func TestClient_StaleOnTimeout_RefreshTimeout(t *testing.T) {
+	t.Parallel()
+
+	var (
+		slow     atomic.Bool
+		canceled = make(chan struct{}, 1)
+		release  = make(chan struct{})
+	)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if slow.Load() {
+			select {
+			case <-r.Context().Done():
+				canceled <- struct{}{}
+
+				return
+			case <-release:
+			}
+		}
+
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(release) })
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL),
+		kenall.WithStaleOnTimeout(10*time.Millisecond, 0),
+		kenall.WithTimeout(50*time.Millisecond),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	if _, err := cli.GetAddress(ctx, "1008105"); err != nil {
+		t.Fatal(err)
+	}
+
+	slow.Store(true)
+
+	res, err := cli.GetAddress(ctx, "1008105")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Stale {
+		t.Errorf("give: %+v, want: the stale response", res)
+	}
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Error("the background request should be canceled by the timeout of the client")
+	}
+}
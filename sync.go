@@ -0,0 +1,254 @@
+package kenall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// defaultSyncBatchSize is the number of records listed at once by kenall.Syncer.
+const defaultSyncBatchSize = 100
+
+type (
+	// A Store is an address book of a CRM, ERP and so on synchronized by kenall.Syncer.
+	Store interface {
+		// List returns at most limit records whose ID is greater than after in ascending order of ID,
+		// an empty after lists from the beginning.
+		List(ctx context.Context, after string, limit int) ([]*StoredAddress, error)
+		// Get returns the current record of the ID.
+		Get(ctx context.Context, id string) (*StoredAddress, error)
+		// Update writes back the record.
+		Update(ctx context.Context, record *StoredAddress) error
+	}
+	// A SyncSummary is counts of the records processed by kenall.Syncer.
+	SyncSummary struct {
+		Listed    int
+		Updated   int
+		Unchanged int
+		// Unresolved is the number of records which do not match a current address, they are not updated.
+		Unresolved int
+		// Conflicted is the number of records modified in the store during the sync, they are not updated.
+		Conflicted int
+	}
+	// A Syncer refreshes the records of a kenall.Store with the current addresses and writes back normalized values.
+	// Requests are throttled by the rate limits of the client, see kenall.WithEndpointRateLimits.
+	Syncer struct {
+		// BatchSize is the number of records listed at once, it defaults to 100.
+		BatchSize int
+		// OnProgress is called for each record if it is not nil, the total is unknown.
+		// Unresolved and conflicted records are counted as errors.
+		OnProgress ProgressFunc
+		// Checkpoints persists the ID of the last synchronized batch if it is not nil, an interrupted sync is resumed
+		// from the next record.
+		Checkpoints CheckpointStore
+		// JobName is the name of the checkpoint, it defaults to "sync".
+		JobName string
+		// Config configures the concurrency and the request budget of the sync,
+		// the records of a batch are synchronized concurrently by the workers.
+		Config BulkConfig
+
+		cli   *Client
+		store Store
+		mu    sync.Mutex
+	}
+
+	syncOutcome int
+)
+
+const (
+	syncUnchanged syncOutcome = iota
+	syncUpdated
+	syncUnresolved
+	syncConflicted
+)
+
+// NewSyncer creates kenall.Syncer with the client and the store.
+func NewSyncer(cli *Client, store Store) *Syncer {
+	return &Syncer{BatchSize: defaultSyncBatchSize, cli: cli, store: store}
+}
+
+// Sync walks all records of the store and updates the ones whose normalized values differ from the stored values.
+// A record is normalized to the current address of the postal code which matches the prefecture, city and town
+// ignoring spaces and width.
+func (s *Syncer) Sync(ctx context.Context) (*SyncSummary, error) {
+	summary := &SyncSummary{}
+	progress := newProgressTracker(s.OnProgress, s.cli.clock, 0)
+
+	cfg, err := s.Config.resolve(s.cli, EndpointFamilyPostalCode)
+	if err != nil {
+		return summary, err
+	}
+
+	limiter := cfg.limiter()
+
+	batchSize := s.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultSyncBatchSize
+	}
+
+	job := s.JobName
+	if job == "" {
+		job = "sync"
+	}
+
+	after, err := loadCheckpoint(ctx, s.Checkpoints, job)
+	if err != nil {
+		return summary, fmt.Errorf("kenall: failed to load a checkpoint: %w", err)
+	}
+
+	var mu sync.Mutex
+
+	// NOTE: the lookups are scoped to the run, so that a later run sees the current addresses.
+	lookups := map[string]*GetAddressResponse{}
+
+	for {
+		records, err := s.store.List(ctx, after, batchSize)
+		if err != nil {
+			return summary, fmt.Errorf("kenall: failed to list records: %w", err)
+		}
+
+		err = runWorkers(ctx, cfg.Workers, len(records), func(ctx context.Context, i int) error {
+			outcome, err := s.sync(ctx, records[i], limiter, lookups)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			summary.Listed++
+			progress.done(err != nil || outcome == syncUnresolved || outcome == syncConflicted)
+
+			if err == nil {
+				summary.add(outcome)
+			}
+
+			return err
+		})
+		if err != nil {
+			return summary, err
+		}
+
+		if len(records) < batchSize {
+			if err := saveCheckpoint(ctx, s.Checkpoints, job, ""); err != nil {
+				return summary, fmt.Errorf("kenall: failed to save a checkpoint: %w", err)
+			}
+
+			return summary, nil
+		}
+
+		after = records[len(records)-1].ID
+
+		if err := saveCheckpoint(ctx, s.Checkpoints, job, after); err != nil {
+			return summary, fmt.Errorf("kenall: failed to save a checkpoint: %w", err)
+		}
+	}
+}
+
+func (s *Syncer) sync(
+	ctx context.Context, r *StoredAddress, limiter *tokenBucket, lookups map[string]*GetAddressResponse,
+) (syncOutcome, error) {
+	normalized, err := s.normalize(ctx, r, limiter, lookups)
+	if err != nil {
+		return syncUnresolved, err
+	}
+
+	switch {
+	case normalized == nil:
+		return syncUnresolved, nil
+	case normalized.equal(r):
+		return syncUnchanged, nil
+	}
+
+	current, err := s.store.Get(ctx, r.ID)
+	if err != nil {
+		return syncUnresolved, fmt.Errorf("kenall: failed to get a record: %w", err)
+	}
+
+	if current == nil || !current.equal(r) {
+		return syncConflicted, nil
+	}
+
+	if err := s.store.Update(ctx, normalized); err != nil {
+		return syncUnresolved, fmt.Errorf("kenall: failed to update a record: %w", err)
+	}
+
+	return syncUpdated, nil
+}
+
+func (s *Syncer) normalize(
+	ctx context.Context, r *StoredAddress, limiter *tokenBucket, lookups map[string]*GetAddressResponse,
+) (*StoredAddress, error) {
+	postalCode := strings.ReplaceAll(strings.TrimSpace(r.PostalCode), "-", "")
+
+	s.mu.Lock()
+	res, ok := lookups[postalCode]
+	s.mu.Unlock()
+
+	if !ok {
+		if err := limiter.Wait(ctx, s.cli.clock); err != nil {
+			return nil, fmt.Errorf("kenall: failed to wait for the rate limit: %w", err)
+		}
+
+		var err error
+
+		res, err = s.cli.GetAddress(ctx, postalCode)
+
+		switch {
+		case errors.Is(err, ErrNotFound) || errors.Is(err, ErrInvalidArgument):
+			res = nil
+		case err != nil:
+			return nil, err
+		}
+
+		s.mu.Lock()
+		lookups[postalCode] = res
+		s.mu.Unlock()
+	}
+
+	if res == nil {
+		return nil, nil
+	}
+
+	var matched *Address
+
+	for _, a := range res.Addresses {
+		if normalizeName(a.Prefecture) == normalizeName(r.Prefecture) &&
+			normalizeName(a.City) == normalizeName(r.City) &&
+			normalizeName(a.Town) == normalizeName(r.Town) {
+			matched = a
+
+			break
+		}
+	}
+
+	if matched == nil {
+		return nil, nil
+	}
+
+	return &StoredAddress{
+		ID:         r.ID,
+		PostalCode: matched.PostalCode,
+		Prefecture: matched.Prefecture,
+		City:       matched.City,
+		Town:       matched.Town,
+		Version:    res.Version,
+	}, nil
+}
+
+func (sm *SyncSummary) add(o syncOutcome) {
+	switch o {
+	case syncUnchanged:
+		sm.Unchanged++
+	case syncUpdated:
+		sm.Updated++
+	case syncUnresolved:
+		sm.Unresolved++
+	case syncConflicted:
+		sm.Conflicted++
+	}
+}
+
+func (a *StoredAddress) equal(b *StoredAddress) bool {
+	return a.ID == b.ID && a.PostalCode == b.PostalCode && a.Prefecture == b.Prefecture && a.City == b.City &&
+		a.Town == b.Town && a.Version.Equal(b.Version)
+}
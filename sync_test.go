@@ -0,0 +1,161 @@
+package kenall_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+type memoryStore struct {
+	mu      sync.Mutex
+	records map[string]*kenall.StoredAddress
+	// onGet is called before a record is returned by Get to simulate concurrent modifications.
+	onGet func(r *kenall.StoredAddress)
+}
+
+func (s *memoryStore) List(_ context.Context, after string, limit int) ([]*kenall.StoredAddress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ids := make([]string, 0, len(s.records))
+	for id := range s.records {
+		if id > after {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	records := make([]*kenall.StoredAddress, 0, len(ids))
+	for _, id := range ids {
+		r := *s.records[id]
+		records = append(records, &r)
+	}
+
+	return records, nil
+}
+
+func (s *memoryStore) Get(_ context.Context, id string) (*kenall.StoredAddress, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := *s.records[id]
+	if s.onGet != nil {
+		s.onGet(&r)
+	}
+
+	return &r, nil
+}
+
+func (s *memoryStore) Update(_ context.Context, record *kenall.StoredAddress) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[record.ID] = record
+
+	return nil
+}
+
+func TestSyncer_Sync(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/postalcode/1638001" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		body := `{"version":"2021-06-30","data":[{"postal_code":"1638001","prefecture":"東京都","city":"新宿区","town":"西新宿"}]}`
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	current := kenall.Version(time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC))
+
+	store := &memoryStore{records: map[string]*kenall.StoredAddress{
+		"1": {ID: "1", PostalCode: "163-8001", Prefecture: "東京都", City: "新宿区", Town: "西 新宿"},
+		"2": {ID: "2", PostalCode: "1638001", Prefecture: "東京都", City: "新宿区", Town: "西新宿", Version: current},
+		"3": {ID: "3", PostalCode: "9999999", Prefecture: "東京都", City: "千代田区", Town: "大手町"},
+		"4": {ID: "4", PostalCode: "1638001", Prefecture: "東京都", City: "千代田区", Town: "大手町"},
+		"5": {ID: "5", PostalCode: "1638001", Prefecture: "東京都", City: "新宿区", Town: "西新宿"},
+	}}
+	store.onGet = func(r *kenall.StoredAddress) {
+		if r.ID == "5" {
+			r.Town = "西新宿二丁目"
+		}
+	}
+
+	syncer := kenall.NewSyncer(cli, store)
+	syncer.BatchSize = 2
+
+	summary, err := syncer.Sync(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (kenall.SyncSummary{Listed: 5, Updated: 1, Unchanged: 1, Unresolved: 2, Conflicted: 1}); *summary != want {
+		t.Errorf("give: %+v, want: %+v", *summary, want)
+	}
+
+	if r := store.records["1"]; r.PostalCode != "1638001" || r.Town != "西新宿" || !time.Time(r.Version).Equal(time.Time(current)) {
+		t.Errorf("give: %+v, want: normalized record", r)
+	}
+}
+
+func TestSyncer_Sync_Lookups(t *testing.T) {
+	t.Parallel()
+
+	var requested atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested.Add(1)
+
+		body := `{"version":"2021-06-30","data":[{"postal_code":"1638001","prefecture":"東京都","city":"新宿区","town":"西新宿"}]}`
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	store := &memoryStore{records: map[string]*kenall.StoredAddress{
+		"1": {ID: "1", PostalCode: "1638001", Prefecture: "東京都", City: "新宿区", Town: "西新宿"},
+		"2": {ID: "2", PostalCode: "1638001", Prefecture: "東京都", City: "新宿区", Town: "西新宿"},
+	}}
+
+	syncer := kenall.NewSyncer(cli, store)
+
+	for i := 1; i <= 2; i++ {
+		if _, err := syncer.Sync(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+
+		// NOTE: a postal code is looked up once per run, and again in the next run.
+		if got := requested.Load(); got != int32(i) {
+			t.Errorf("give: %v, want: %v", got, i)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithTokenProvider(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	errVault := errors.New("vault is sealed")
+
+	cases := map[string]struct {
+		tokens  []string
+		err     error
+		wantErr []error
+	}{
+		"Rotated token":  {tokens: []string{"opencollector", "expired"}, wantErr: []error{nil, kenall.ErrUnauthorized}},
+		"Provider error": {err: errVault, wantErr: []error{errVault}},
+		"Empty token":    {tokens: []string{""}, wantErr: []error{kenall.ErrInvalidArgument}},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			calls := 0
+			provider := func(ctx context.Context) (string, error) {
+				defer func() { calls++ }()
+				if c.err != nil {
+					return "", c.err
+				}
+
+				return c.tokens[calls], nil
+			}
+
+			cli, err := kenall.NewClient("", kenall.WithEndpoint(srv.URL), kenall.WithTokenProvider(provider))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for i, want := range c.wantErr {
+				if _, err := cli.GetAddress(context.Background(), "1008105"); !errors.Is(err, want) {
+					t.Errorf("%d: give: %v, want: %v", i, err, want)
+				}
+			}
+
+			if calls != len(c.wantErr) {
+				t.Errorf("give: %d calls, want: %d calls", calls, len(c.wantErr))
+			}
+		})
+	}
+}
+
+func TestContextWithToken(t *testing.T) {
+	t.Parallel()
+
+	srv := runTestingServer(t)
+	t.Cleanup(srv.Close)
+
+	errVault := errors.New("vault is sealed")
+	provider := func(ctx context.Context) (string, error) {
+		return "", errVault
+	}
+
+	cases := map[string]struct {
+		opts    []kenall.ClientOption
+		ctx     context.Context
+		wantErr error
+	}{
+		"Override the token":    {ctx: kenall.ContextWithToken(context.Background(), "opencollector"), wantErr: nil},
+		"Override the provider": {opts: []kenall.ClientOption{kenall.WithTokenProvider(provider)}, ctx: kenall.ContextWithToken(context.Background(), "opencollector"), wantErr: nil},
+		"Request option":        {ctx: kenall.ContextWithRequestOptions(context.Background(), kenall.WithToken("opencollector")), wantErr: nil},
+		"Last token wins":       {ctx: kenall.ContextWithToken(kenall.ContextWithToken(context.Background(), "opencollector"), "tenant"), wantErr: kenall.ErrUnauthorized},
+		"Empty token":           {ctx: kenall.ContextWithToken(context.Background(), ""), wantErr: kenall.ErrInvalidArgument},
+		"Client token":          {ctx: context.Background(), wantErr: kenall.ErrUnauthorized},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			cli, err := kenall.NewClient("tenant", append(c.opts, kenall.WithEndpoint(srv.URL))...)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if _, err := cli.GetAddress(c.ctx, "1008105"); !errors.Is(err, c.wantErr) {
+				t.Errorf("give: %v, want: %v", err, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestContextWithToken_Cache(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	tokens := map[string]int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		tokens[r.Header.Get("Authorization")]++
+		mu.Unlock()
+
+		_, _ = w.Write(addressResponse)
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector",
+		kenall.WithEndpoint(srv.URL), kenall.WithCache(kenall.NewMemoryCache(0, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctxs := []context.Context{
+		context.Background(),
+		kenall.ContextWithToken(context.Background(), "tenant-a"),
+		kenall.ContextWithToken(context.Background(), "tenant-b"),
+	}
+
+	for i := 0; i < 2; i++ {
+		for _, ctx := range ctxs {
+			if _, err := cli.GetAddress(ctx, "1008105"); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	want := map[string]int{"token opencollector": 1, "token tenant-a": 1, "token tenant-b": 1}
+	if !reflect.DeepEqual(tokens, want) {
+		t.Errorf("give: %v, want: %v", tokens, want)
+	}
+}
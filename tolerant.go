@@ -0,0 +1,136 @@
+package kenall
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// maxResponseBodySize is the maximum size of a response body to be decoded.
+const maxResponseBodySize = 32 << 20
+
+type (
+	// A DecodeErrorHandler is called for each malformed element of a list response skipped in the tolerant decoding,
+	// see kenall.WithTolerantDecoding.
+	DecodeErrorHandler func(family EndpointFamily, err error)
+	partialResponse    interface {
+		setDecodeErrors(errs []error)
+	}
+	// An ElementDecodeError is an error of a malformed element of a list response.
+	ElementDecodeError struct {
+		// Index is the index of the element in the "data" array of the response.
+		Index int
+		Err   error
+	}
+)
+
+var (
+	_ partialResponse = (*GetAddressResponse)(nil)
+	_ partialResponse = (*GetCityResponse)(nil)
+	_ partialResponse = (*GetHolidaysResponse)(nil)
+	_ partialResponse = (*GetNormalizeAddressResponse)(nil)
+	_ partialResponse = (*SearchCorporationsResponse)(nil)
+)
+
+// Error implements error interface.
+func (e *ElementDecodeError) Error() string {
+	return fmt.Sprintf("kenall: failed to decode the element %d: %s", e.Index, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *ElementDecodeError) Unwrap() error {
+	return e.Err
+}
+
+// decodeTolerant decodes the response skipping malformed elements of the "data" array and returns their errors.
+// The response is decoded as usual if it does not have the "data" array.
+func decodeTolerant(r io.Reader, res interface{}) ([]error, error) {
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("kenall: failed to read the response: %w", err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return nil, fmt.Errorf("kenall: failed to decode to response: %w", err)
+	}
+
+	var elems []json.RawMessage
+
+	list := dataSlice(res)
+	if list.IsValid() && json.Unmarshal(fields["data"], &elems) == nil {
+		delete(fields, "data")
+
+		if body, err = json.Marshal(fields); err != nil {
+			return nil, fmt.Errorf("kenall: failed to decode to response: %w", err)
+		}
+	}
+
+	if err := json.Unmarshal(body, res); err != nil {
+		return nil, fmt.Errorf("kenall: failed to decode to response: %w", err)
+	}
+
+	if elems == nil {
+		return nil, nil
+	}
+
+	var errs []error
+
+	values := reflect.MakeSlice(list.Type(), 0, len(elems))
+
+	for i, elem := range elems {
+		v := reflect.New(list.Type().Elem())
+		if err := json.Unmarshal(elem, v.Interface()); err != nil {
+			errs = append(errs, &ElementDecodeError{Index: i, Err: err})
+
+			continue
+		}
+
+		values = reflect.Append(values, v.Elem())
+	}
+
+	list.Set(values)
+
+	return errs, nil
+}
+
+// dataSlice returns the slice field tagged "data" of the response, the zero value if there is none.
+func dataSlice(res interface{}) reflect.Value {
+	v := reflect.ValueOf(res)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return reflect.Value{}
+	}
+
+	v = v.Elem()
+
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Type().Field(i)
+		if name, _, _ := strings.Cut(f.Tag.Get("json"), ","); name == "data" && f.Type.Kind() == reflect.Slice {
+			return v.Field(i)
+		}
+	}
+
+	return reflect.Value{}
+}
+
+func (r *GetAddressResponse) setDecodeErrors(errs []error) {
+	r.DecodeErrors = errs
+}
+
+func (r *GetCityResponse) setDecodeErrors(errs []error) {
+	r.DecodeErrors = errs
+}
+
+func (r *GetHolidaysResponse) setDecodeErrors(errs []error) {
+	r.DecodeErrors = errs
+}
+
+func (r *GetNormalizeAddressResponse) setDecodeErrors(errs []error) {
+	r.DecodeErrors = errs
+}
+
+func (r *SearchCorporationsResponse) setDecodeErrors(errs []error) {
+	r.DecodeErrors = errs
+}
@@ -0,0 +1,109 @@
+package kenall_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithTolerantDecoding(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := `{"version":"2021-04-30","data":[` +
+			`{"jisx0402":"13101","city":"千代田区"},` +
+			`{"jisx0402":13102,"city":"中央区"},` +
+			`{"jisx0402":"13103","city":"港区"}]}`
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	strict, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := strict.GetCity(context.Background(), "13"); err == nil {
+		t.Error("an error should not be nil without the tolerant decoding")
+	}
+
+	var errs []error
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithTolerantDecoding(func(family kenall.EndpointFamily, err error) {
+		if family != kenall.EndpointFamilyCities {
+			t.Errorf("give: %v, want: %v", family, kenall.EndpointFamilyCities)
+		}
+		errs = append(errs, err)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := cli.GetCity(context.Background(), "13")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(res.Cities) != 2 || res.Cities[1].City != "港区" {
+		t.Errorf("give: %+v, want: 千代田区 and 港区", res.Cities)
+	}
+	if time.Time(res.Version).IsZero() {
+		t.Error("the version should be decoded")
+	}
+
+	if len(res.DecodeErrors) != 1 {
+		t.Errorf("give: %v, want: an error of the element 1", res.DecodeErrors)
+	}
+
+	var ede *kenall.ElementDecodeError
+	if len(errs) != 1 || !errors.As(errs[0], &ede) || ede.Index != 1 {
+		t.Errorf("give: %v, want: an error of the element 1", errs)
+	}
+}
+
+func TestBusinessDay_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give             string
+		wantError        bool
+		wantDate         string
+		wantLegalHoliday bool
+	}{
+		"Date":        {give: `"2022-01-01"`, wantDate: "2022-01-01"},
+		"RFC3339":     {give: `"2022-01-01T00:00:00+09:00"`, wantDate: "2022-01-01"},
+		"Object":      {give: `{"date":"2022-01-01","is_legal_holiday":true}`, wantDate: "2022-01-01", wantLegalHoliday: true},
+		"Wrong date":  {give: `"2022-13-01"`, wantError: true},
+		"Wrong value": {give: `1`, wantError: true},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var bd kenall.BusinessDay
+			err := bd.UnmarshalJSON([]byte(c.give))
+			if (err != nil) != c.wantError {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+			if err != nil {
+				return
+			}
+			if got := bd.Format(kenall.RFC3339DateFormat); got != c.wantDate {
+				t.Errorf("give: %v, want: %v", got, c.wantDate)
+			}
+			if bd.LegalHoliday != c.wantLegalHoliday {
+				t.Errorf("give: %v, want: %v", bd.LegalHoliday, c.wantLegalHoliday)
+			}
+		})
+	}
+}
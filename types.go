@@ -1,11 +1,16 @@
 package kenall
 
+//go:generate go run ./internal/cmd/typegen -schema schema/kenall.json -out types_gen.go
+
 import (
 	"bytes"
+	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"strings"
 	"time"
 )
@@ -25,6 +30,13 @@ type (
 		DayOfWeek     int    `json:"day_of_week"`
 		DayOfWeekText string `json:"day_of_week_text"`
 	}
+
+	businessDay struct {
+		Date         string `json:"date"`
+		LegalHoliday bool   `json:"is_legal_holiday"`
+		Weekend      bool   `json:"is_weekend"`
+		HolidayTitle string `json:"holiday_title,omitempty"`
+	}
 )
 
 type (
@@ -58,53 +70,6 @@ type (
 			CodeType    json.Number `json:"code_type"`
 		} `json:"corporation"`
 	}
-	// A City is a city associated with the prefecture code defined by JIS X 0401.
-	City struct {
-		JISX0402       string `json:"jisx0402"`
-		PrefectureCode string `json:"prefecture_code"`
-		CityCode       string `json:"city_code"`
-		PrefectureKana string `json:"prefecture_kana"`
-		CityKana       string `json:"city_kana"`
-		Prefecture     string `json:"prefecture"`
-		City           string `json:"city"`
-	}
-	// A Corporation is a corporation associated with the corporate number defined by National Tax Agency Japan.
-	Corporation struct {
-		PublishedDate            string      `json:"published_date"`
-		SequenceNumber           json.Number `json:"sequence_number"`
-		CorporateNumber          string      `json:"corporate_number"`
-		Process                  json.Number `json:"process"`
-		Correct                  json.Number `json:"correct"`
-		UpdateDate               string      `json:"update_date"`
-		ChangeDate               string      `json:"change_date"`
-		Name                     string      `json:"name"`
-		NameImageID              NullString  `json:"name_image_id"`
-		Kind                     string      `json:"kind"`
-		PrefectureName           string      `json:"prefecture_name"`
-		CityName                 string      `json:"city_name"`
-		StreetNumber             string      `json:"street_number"`
-		Town                     NullString  `json:"town"`
-		KyotoStreet              NullString  `json:"kyoto_street"`
-		BlockLotNum              NullString  `json:"block_lot_num"`
-		Building                 NullString  `json:"building"`
-		FloorRoom                NullString  `json:"floor_room"`
-		AddressImageID           NullString  `json:"address_image_id"`
-		JISX0402                 string      `json:"jisx0402"`
-		PostCode                 string      `json:"post_code"`
-		AddressOutside           string      `json:"address_outside"`
-		AddressOutsideImageID    NullString  `json:"address_outside_image_id"`
-		CloseDate                NullString  `json:"close_date"`
-		CloseCause               NullString  `json:"close_cause"`
-		SuccessorCorporateNumber NullString  `json:"successor_corporate_number"`
-		ChangeCause              string      `json:"change_cause"`
-		AssignmentDate           string      `json:"assignment_date"`
-		EnName                   string      `json:"en_name"`
-		EnPrefectureName         string      `json:"en_prefecture_name"`
-		EnAddressLine            NullString  `json:"en_address_line"`
-		EnAddressOutside         NullString  `json:"en_address_outside"`
-		Furigana                 string      `json:"furigana"`
-		Hihyoji                  string      `json:"hihyoji"`
-	}
 	// A RemoteAddress is an IP address from access point.
 	RemoteAddress struct {
 		Type    string      `json:"type"`
@@ -119,6 +84,11 @@ type (
 	// A BusinessDay is Japan's business detail.
 	BusinessDay struct {
 		LegalHoliday bool `json:"is_legal_holiday"`
+		// Weekend is true if the date is Saturday or Sunday in Japan.
+		Weekend bool `json:"is_weekend"`
+		// HolidayTitle is the title of the national holiday on the date joined from the holidays API,
+		// it is set by kenall.Client.GetBusinessDaysByPeriod.
+		HolidayTitle string `json:"holiday_title,omitempty"`
 		time.Time
 	}
 	// A Query is data normalized to an address.
@@ -134,6 +104,11 @@ type (
 		BlockLotNum NullString `json:"block_lot_num"`
 		Building    NullString `json:"building"`
 		FloorRoom   NullString `json:"floor_room"`
+		// PrefectureKana, CityKana and TownKana are the furigana of the components,
+		// they are filled from the matched addresses if the kenall service does not return them.
+		PrefectureKana NullString `json:"prefecture_kana"`
+		CityKana       NullString `json:"city_kana"`
+		TownKana       NullString `json:"town_kana"`
 	}
 )
 
@@ -151,6 +126,13 @@ var (
 
 	_ json.Marshaler = (*Holiday)(nil)
 	_ json.Marshaler = (*BusinessDay)(nil)
+	_ json.Marshaler = NullString{}
+	_ json.Marshaler = Version{}
+
+	_ fmt.Stringer = Version{}
+
+	_ sql.Scanner   = (*NullString)(nil)
+	_ driver.Valuer = NullString{}
 
 	_ net.Addr = (*RemoteAddress)(nil)
 )
@@ -171,6 +153,45 @@ func (v *Version) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler interface, it marshals the zero value to null.
+func (v Version) MarshalJSON() ([]byte, error) {
+	if v.IsZero() {
+		return nullLiteral, nil
+	}
+
+	return []byte(`"` + v.String() + `"`), nil
+}
+
+// Time returns the version as time.Time.
+func (v Version) Time() time.Time {
+	return time.Time(v)
+}
+
+// IsZero reports whether the version is unknown, e.g. it is missing in the response.
+func (v Version) IsZero() bool {
+	return v.Time().IsZero()
+}
+
+// Before reports whether the version is before u.
+func (v Version) Before(u Version) bool {
+	return v.Time().Before(u.Time())
+}
+
+// After reports whether the version is after u.
+func (v Version) After(u Version) bool {
+	return v.Time().After(u.Time())
+}
+
+// Equal reports whether the version is the same date as u.
+func (v Version) Equal(u Version) bool {
+	return v.Time().Equal(u.Time())
+}
+
+// String implements fmt.Stringer interface, it returns the date in RFC3339-Date format, e.g. "2022-11-30".
+func (v Version) String() string {
+	return v.Time().Format(RFC3339DateFormat)
+}
+
 // UnmarshalJSON implements json.Unmarshaler interface.
 func (ns *NullString) UnmarshalJSON(data []byte) error {
 	if bytes.Equal(data, nullLiteral) {
@@ -186,6 +207,41 @@ func (ns *NullString) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// MarshalJSON implements json.Marshaler interface, it marshals an invalid value to null.
+func (ns NullString) MarshalJSON() ([]byte, error) {
+	if !ns.Valid {
+		return nullLiteral, nil
+	}
+
+	b, err := json.Marshal(ns.String)
+	if err != nil {
+		return nil, fmt.Errorf("kenall: failed to marshal NullString: %w", err)
+	}
+
+	return b, nil
+}
+
+// Scan implements sql.Scanner interface.
+func (ns *NullString) Scan(value interface{}) error {
+	var s sql.NullString
+	if err := s.Scan(value); err != nil {
+		return fmt.Errorf("kenall: failed to scan NullString: %w", err)
+	}
+
+	ns.String, ns.Valid = s.String, s.Valid
+
+	return nil
+}
+
+// Value implements driver.Valuer interface.
+func (ns NullString) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil //nolint: nilnil
+	}
+
+	return ns.String, nil
+}
+
 // UnmarshalJSON implements json.Unmarshaler interface.
 func (ra *RemoteAddress) UnmarshalJSON(data []byte) error {
 	type Alias RemoteAddress
@@ -195,22 +251,26 @@ func (ra *RemoteAddress) UnmarshalJSON(data []byte) error {
 		return fmt.Errorf("kenall: failed to parse RemoteAddress: %w", err)
 	}
 
-	switch tmp.Type {
-	case "v4":
-		var err error
-		if tmp.IPAddr, err = net.ResolveIPAddr("ip4", tmp.Address); err != nil {
-			return fmt.Errorf("kenall: failde to resolve IP address: %w", err)
-		}
-	case "v6":
-		var err error
-		if tmp.IPAddr, err = net.ResolveIPAddr("ip6", tmp.Address); err != nil {
-			return fmt.Errorf("kenall: failed to resolve IP address: %w", err)
-		}
+	// NOTE: the address is parsed without name resolution not to look up hostnames in untrusted input.
+	addr, err := netip.ParseAddr(tmp.Address)
+	if err != nil {
+		return fmt.Errorf("kenall: failed to parse IP address: %w", err)
+	}
+
+	switch {
+	case tmp.Type == "v4" && addr.Unmap().Is4():
+		addr = addr.Unmap()
+	case tmp.Type == "v6" && addr.Is6():
+	case tmp.Type == "v4" || tmp.Type == "v6":
+		//nolint: goerr113
+		return errors.New("kenall: mismatched type of RemoteAddress, type = " + tmp.Type + ", address = " + tmp.Address)
 	default:
 		//nolint: goerr113
 		return errors.New("kenall: undefined type of RemoteAddress, type = " + tmp.Type)
 	}
 
+	tmp.IPAddr = &net.IPAddr{IP: addr.AsSlice(), Zone: addr.Zone()}
+
 	return nil
 }
 
@@ -241,6 +301,58 @@ func (h *Holiday) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// UnmarshalJSON implements json.Unmarshaler interface.
+// It accepts a date string, or an object with "date", "is_legal_holiday", "is_weekend" and "holiday_title".
+func (bd *BusinessDay) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, nullLiteral) {
+		return nil
+	}
+
+	var tmp businessDay
+
+	if err := json.Unmarshal(data, &tmp.Date); err != nil {
+		if err := json.Unmarshal(data, &tmp); err != nil {
+			return fmt.Errorf("kenall: failed to parse BusinessDay: %w", err)
+		}
+	}
+
+	t, err := time.ParseInLocation(RFC3339DateFormat, tmp.Date, jst)
+	if err != nil {
+		if t, err = time.Parse(time.RFC3339, tmp.Date); err != nil {
+			return fmt.Errorf("kenall: failed to parse BusinessDay: %w", err)
+		}
+	}
+
+	bd.Time = t
+	bd.LegalHoliday = tmp.LegalHoliday
+	bd.Weekend = tmp.Weekend
+	bd.HolidayTitle = tmp.HolidayTitle
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler interface, the output is accepted by kenall.BusinessDay.UnmarshalJSON.
+func (bd BusinessDay) MarshalJSON() ([]byte, error) {
+	//nolint: wrapcheck
+	return json.Marshal(&businessDay{
+		Date:         bd.In(jst).Format(RFC3339DateFormat),
+		LegalHoliday: bd.LegalHoliday,
+		Weekend:      bd.Weekend,
+		HolidayTitle: bd.HolidayTitle,
+	})
+}
+
+// IsHoliday reports whether the date is a national holiday, it is known only if kenall.BusinessDay.HolidayTitle is set.
+func (bd *BusinessDay) IsHoliday() bool {
+	return bd.HolidayTitle != ""
+}
+
+func isWeekend(t time.Time) bool {
+	wd := t.In(jst).Weekday()
+
+	return wd == time.Saturday || wd == time.Sunday
+}
+
 // MarshalJSON implements json.Marshaler interface.
 func (h Holiday) MarshalJSON() ([]byte, error) {
 	//nolint: wrapcheck
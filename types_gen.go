@@ -0,0 +1,96 @@
+// Code generated by typegen from schema/kenall.json. DO NOT EDIT.
+
+package kenall
+
+import "encoding/json"
+
+// A Bank is a financial institution associated with the bank code defined by Japanese Bankers Association.
+type Bank struct {
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	Katakana string `json:"katakana"`
+	Hiragana string `json:"hiragana"`
+	Romaji   string `json:"romaji"`
+}
+
+// A BankBranch is a branch of the financial institution associated with the branch code.
+type BankBranch struct {
+	Code     string `json:"code"`
+	Name     string `json:"name"`
+	Katakana string `json:"katakana"`
+	Hiragana string `json:"hiragana"`
+	Romaji   string `json:"romaji"`
+}
+
+// A City is a city associated with the prefecture code defined by JIS X 0401.
+type City struct {
+	JISX0402       string `json:"jisx0402"`
+	PrefectureCode string `json:"prefecture_code"`
+	CityCode       string `json:"city_code"`
+	PrefectureKana string `json:"prefecture_kana"`
+	CityKana       string `json:"city_kana"`
+	Prefecture     string `json:"prefecture"`
+	City           string `json:"city"`
+}
+
+// A Corporation is a corporation associated with the corporate number defined by National Tax Agency Japan.
+type Corporation struct {
+	PublishedDate            string      `json:"published_date"`
+	SequenceNumber           json.Number `json:"sequence_number"`
+	CorporateNumber          string      `json:"corporate_number"`
+	Process                  json.Number `json:"process"`
+	Correct                  json.Number `json:"correct"`
+	UpdateDate               string      `json:"update_date"`
+	ChangeDate               string      `json:"change_date"`
+	Name                     string      `json:"name"`
+	NameImageID              NullString  `json:"name_image_id"`
+	Kind                     string      `json:"kind"`
+	PrefectureName           string      `json:"prefecture_name"`
+	CityName                 string      `json:"city_name"`
+	StreetNumber             string      `json:"street_number"`
+	Town                     NullString  `json:"town"`
+	KyotoStreet              NullString  `json:"kyoto_street"`
+	BlockLotNum              NullString  `json:"block_lot_num"`
+	Building                 NullString  `json:"building"`
+	FloorRoom                NullString  `json:"floor_room"`
+	AddressImageID           NullString  `json:"address_image_id"`
+	JISX0402                 string      `json:"jisx0402"`
+	PostCode                 string      `json:"post_code"`
+	AddressOutside           string      `json:"address_outside"`
+	AddressOutsideImageID    NullString  `json:"address_outside_image_id"`
+	CloseDate                NullString  `json:"close_date"`
+	CloseCause               NullString  `json:"close_cause"`
+	SuccessorCorporateNumber NullString  `json:"successor_corporate_number"`
+	ChangeCause              string      `json:"change_cause"`
+	AssignmentDate           string      `json:"assignment_date"`
+	EnName                   string      `json:"en_name"`
+	EnPrefectureName         string      `json:"en_prefecture_name"`
+	EnAddressLine            NullString  `json:"en_address_line"`
+	EnAddressOutside         NullString  `json:"en_address_outside"`
+	Furigana                 string      `json:"furigana"`
+	Hihyoji                  string      `json:"hihyoji"`
+}
+
+// An InvoiceIssuer is a qualified invoice issuer associated with the registration number
+// defined by National Tax Agency Japan.
+type InvoiceIssuer struct {
+	PublishedDate         string      `json:"published_date"`
+	SequenceNumber        json.Number `json:"sequence_number"`
+	RegistratedNumber     string      `json:"registrated_number"`
+	Process               string      `json:"process"`
+	Correct               string      `json:"correct"`
+	Kind                  string      `json:"kind"`
+	Country               string      `json:"country"`
+	Latest                string      `json:"latest"`
+	RegistrationDate      string      `json:"registration_date"`
+	UpdateDate            string      `json:"update_date"`
+	DisposalDate          NullString  `json:"disposal_date"`
+	ExpireDate            NullString  `json:"expire_date"`
+	Address               string      `json:"address"`
+	AddressPrefectureCode string      `json:"address_prefecture_code"`
+	AddressCityCode       string      `json:"address_city_code"`
+	AddressInside         NullString  `json:"address_inside"`
+	Name                  string      `json:"name"`
+	Kana                  NullString  `json:"kana"`
+	TradeName             NullString  `json:"trade_name"`
+}
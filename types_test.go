@@ -2,6 +2,7 @@ package kenall_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -197,3 +198,166 @@ func TestHoliday_MarshalJSON(t *testing.T) {
 		})
 	}
 }
+
+func TestBusinessDay_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+
+	cases := map[string]struct {
+		give *kenall.BusinessDay
+		want string
+	}{
+		"Holiday": {give: &kenall.BusinessDay{LegalHoliday: true, HolidayTitle: "成人の日", Time: time.Date(2022, 1, 10, 0, 0, 0, 0, jst)}, want: `{"date":"2022-01-10","is_legal_holiday":true,"is_weekend":false,"holiday_title":"成人の日"}`},
+		"Weekend": {give: &kenall.BusinessDay{Weekend: true, Time: time.Date(2022, 1, 8, 0, 0, 0, 0, jst)}, want: `{"date":"2022-01-08","is_legal_holiday":false,"is_weekend":true}`},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := json.Marshal(c.give)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(b) != c.want {
+				t.Errorf("give: %s, want: %s", b, c.want)
+			}
+
+			var got kenall.BusinessDay
+			if err := json.Unmarshal(b, &got); err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(c.give.Time) || got.LegalHoliday != c.give.LegalHoliday ||
+				got.Weekend != c.give.Weekend || got.HolidayTitle != c.give.HolidayTitle {
+				t.Errorf("give: %+v, want: %+v", got, c.give)
+			}
+			if got.IsHoliday() != (c.give.HolidayTitle != "") {
+				t.Errorf("give: %v, want: %v", got.IsHoliday(), c.give.HolidayTitle != "")
+			}
+		})
+	}
+}
+
+func TestNullString_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give kenall.NullString
+		want string
+	}{
+		"Valid string": {give: kenall.NullString{String: "港区", Valid: true}, want: `"港区"`},
+		"Valid empty":  {give: kenall.NullString{Valid: true}, want: `""`},
+		"Null":         {give: kenall.NullString{String: "ignored"}, want: `null`},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := json.Marshal(struct{ V kenall.NullString }{V: c.give})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if want := `{"V":` + c.want + `}`; string(b) != want {
+				t.Errorf("give: %s, want: %s", b, want)
+			}
+
+			var rt struct{ V kenall.NullString }
+			if err := json.Unmarshal(b, &rt); err != nil {
+				t.Fatal(err)
+			}
+
+			if rt.V.Valid != c.give.Valid || (c.give.Valid && rt.V.String != c.give.String) {
+				t.Errorf("give: %+v, want: %+v", rt.V, c.give)
+			}
+		})
+	}
+}
+
+func TestNullString_Scan(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		give      interface{}
+		want      kenall.NullString
+		wantError bool
+	}{
+		"String": {give: "千代田区", want: kenall.NullString{String: "千代田区", Valid: true}},
+		"Bytes":  {give: []byte("千代田区"), want: kenall.NullString{String: "千代田区", Valid: true}},
+		"Number": {give: int64(13), want: kenall.NullString{String: "13", Valid: true}},
+		"Nil":    {give: nil, want: kenall.NullString{}},
+		"Struct": {give: struct{}{}, wantError: true},
+	}
+
+	for name, c := range cases {
+		c := c
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var ns kenall.NullString
+			if err := ns.Scan(c.give); (err != nil) != c.wantError {
+				t.Fatalf("give: %v, want: %v", err, c.wantError)
+			}
+
+			if !c.wantError && ns != c.want {
+				t.Errorf("give: %+v, want: %+v", ns, c.want)
+			}
+
+			if c.wantError {
+				return
+			}
+
+			v, err := ns.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if (v == nil) == c.want.Valid || (v != nil && v.(string) != c.want.String) {
+				t.Errorf("give: %v, want: %+v", v, c.want)
+			}
+		})
+	}
+}
+
+func TestVersion_Methods(t *testing.T) {
+	t.Parallel()
+
+	var v, older, zero kenall.Version
+	if err := json.Unmarshal([]byte(`"2022-11-30"`), &v); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := json.Unmarshal([]byte(`"2022-10-31"`), &older); err != nil {
+		t.Fatal(err)
+	}
+
+	if v.String() != "2022-11-30" || v.Time() != time.Date(2022, 11, 30, 0, 0, 0, 0, time.UTC) {
+		t.Errorf("give: %v, want: 2022-11-30", v)
+	}
+
+	if !older.Before(v) || older.After(v) || !v.After(older) || v.Equal(older) || !v.Equal(v) {
+		t.Errorf("give: %v and %v, want: ordered versions", older, v)
+	}
+
+	if v.IsZero() || !zero.IsZero() {
+		t.Errorf("give: %v, want: the zero value is only zero", v)
+	}
+
+	for give, want := range map[kenall.Version]string{v: `"2022-11-30"`, zero: `null`} {
+		b, err := json.Marshal(give)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(b) != want {
+			t.Errorf("give: %s, want: %s", b, want)
+		}
+	}
+}
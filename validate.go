@@ -0,0 +1,187 @@
+package kenall
+
+import (
+	"fmt"
+	"unicode"
+)
+
+const (
+	postalCodeLength      = 7
+	jisx0402Length        = 5
+	prefectureCodeLength  = 2
+	corporateNumberLength = 13
+)
+
+type (
+	// A ValidationWarning is an anomaly found in a response by the validation, see kenall.WithValidation.
+	ValidationWarning struct {
+		Family EndpointFamily
+		// Field is the path of the field in the response, e.g. "data[0].postal_code".
+		Field   string
+		Value   string
+		Message string
+	}
+	// A ValidationHandler is called for each warning found in a response by the validation.
+	ValidationHandler func(ValidationWarning)
+
+	validator interface {
+		validate(v *validation)
+	}
+	validation struct {
+		family  EndpointFamily
+		prefix  string
+		handler ValidationHandler
+	}
+)
+
+var (
+	_ validator = (*GetAddressResponse)(nil)
+	_ validator = (*GetCityResponse)(nil)
+	_ validator = (*GetCorporationResponse)(nil)
+	_ validator = (*GetNormalizeAddressResponse)(nil)
+	_ validator = (*SearchCorporationsResponse)(nil)
+)
+
+// validateResponse validates the response if the handler is set and the response supports it.
+func (cli *Client) validateResponse(family EndpointFamily, res interface{}) {
+	if v, ok := res.(validator); ok && cli.validationHandler != nil {
+		v.validate(&validation{family: family, handler: cli.validationHandler})
+	}
+}
+
+func (v *validation) at(format string, args ...interface{}) *validation {
+	return &validation{family: v.family, prefix: v.prefix + fmt.Sprintf(format, args...), handler: v.handler}
+}
+
+func (v *validation) warn(field, value, message string) {
+	v.handler(ValidationWarning{Family: v.family, Field: v.prefix + field, Value: value, Message: message})
+}
+
+// null warns of a null element of an array in the response, e.g. "data[0]".
+func (v *validation) null(format string, args ...interface{}) {
+	v.warn(fmt.Sprintf(format, args...), "null", "element is null")
+}
+
+func (v *validation) postalCode(field, value string) {
+	if !isDigits(value, postalCodeLength) {
+		v.warn(field, value, "postal code is not 7 digits")
+	}
+}
+
+func (v *validation) jisx0402(field, value string) {
+	if !isDigits(value, jisx0402Length) {
+		v.warn(field, value, "JIS X 0402 code is not 5 digits")
+
+		return
+	}
+
+	v.prefectureCode(field, value[:prefectureCodeLength])
+}
+
+func (v *validation) prefectureCode(field, value string) {
+	if _, err := prefectureByCode(value); err != nil {
+		v.warn(field, value, "unknown prefecture code")
+	}
+}
+
+func (v *validation) kana(field, value string) {
+	for _, r := range value {
+		if !isKanaTextRune(r) {
+			v.warn(field, value, fmt.Sprintf("unexpected character %q in kana", r))
+
+			return
+		}
+	}
+}
+
+// isKanaTextRune reports whether the rune may appear in a kana field: katakana, half-width katakana,
+// digits, punctuation and spaces.
+func isKanaTextRune(r rune) bool {
+	return unicode.In(r, unicode.Katakana) || r == 'ー' || r == 'ｰ' || r == 'ﾞ' || r == 'ﾟ' ||
+		unicode.IsDigit(r) || unicode.IsPunct(r) || unicode.IsSpace(r)
+}
+
+func (a *Address) validate(v *validation) {
+	v.postalCode("postal_code", a.PostalCode)
+	v.jisx0402("jisx0402", a.JISX0402)
+	v.kana("prefecture_kana", a.PrefectureKana)
+	v.kana("city_kana", a.CityKana)
+	v.kana("town_kana", a.TownKana)
+}
+
+func (c *City) validate(v *validation) {
+	v.jisx0402("jisx0402", c.JISX0402)
+	v.prefectureCode("prefecture_code", c.PrefectureCode)
+	v.kana("prefecture_kana", c.PrefectureKana)
+	v.kana("city_kana", c.CityKana)
+}
+
+func (c *Corporation) validate(v *validation) {
+	if !isDigits(c.CorporateNumber, corporateNumberLength) {
+		v.warn("corporate_number", c.CorporateNumber, "corporate number is not 13 digits")
+	}
+
+	if c.PostCode != "" {
+		v.postalCode("post_code", c.PostCode)
+	}
+
+	if c.JISX0402 != "" {
+		v.jisx0402("jisx0402", c.JISX0402)
+	}
+
+	v.kana("furigana", c.Furigana)
+}
+
+func (r *GetAddressResponse) validate(v *validation) {
+	for i, a := range r.Addresses {
+		if a == nil {
+			v.null("data[%d]", i)
+
+			continue
+		}
+
+		a.validate(v.at("data[%d].", i))
+	}
+}
+
+func (r *GetCityResponse) validate(v *validation) {
+	for i, c := range r.Cities {
+		if c == nil {
+			v.null("data[%d]", i)
+
+			continue
+		}
+
+		c.validate(v.at("data[%d].", i))
+	}
+}
+
+func (r *GetCorporationResponse) validate(v *validation) {
+	if r.Corporation != nil {
+		r.Corporation.validate(v.at("data."))
+	}
+}
+
+func (r *GetNormalizeAddressResponse) validate(v *validation) {
+	for i, a := range r.Addresses {
+		if a == nil {
+			v.null("data[%d]", i)
+
+			continue
+		}
+
+		a.validate(v.at("data[%d].", i))
+	}
+}
+
+func (r *SearchCorporationsResponse) validate(v *validation) {
+	for i, c := range r.Corporations {
+		if c == nil {
+			v.null("data[%d]", i)
+
+			continue
+		}
+
+		c.validate(v.at("data[%d].", i))
+	}
+}
@@ -0,0 +1,77 @@
+package kenall_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestWithValidation_Warnings(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := `{"version":"2021-06-30","data":[` +
+			`{"postal_code":"1000001","jisx0402":"13101","prefecture_kana":"トウキョウト","city_kana":"チヨダク","town_kana":"チヨダ"},` +
+			`{"postal_code":"100-001","jisx0402":"99101","prefecture_kana":"とうきょうと","city_kana":"ﾁﾖﾀﾞｸ","town_kana":"チヨダ（１）"}]}`
+		if _, err := fmt.Fprint(w, body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	var fields []string
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithValidation(func(w kenall.ValidationWarning) {
+		if w.Family != kenall.EndpointFamilyPostalCode || w.Message == "" {
+			t.Errorf("give: %+v, want: a warning of postalcode", w)
+		}
+		fields = append(fields, w.Field)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetAddress(context.Background(), "1000001"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"data[1].postal_code", "data[1].jisx0402", "data[1].prefecture_kana"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("give: %v, want: %v", fields, want)
+	}
+}
+
+func TestWithValidation_NullElements(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, err := fmt.Fprint(w, `{"version":"2021-06-30","data":[null]}`); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	var fields []string
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithValidation(func(w kenall.ValidationWarning) {
+		fields = append(fields, string(w.Family)+":"+w.Field)
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := cli.GetAddress(context.Background(), "1000001"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := cli.GetCity(context.Background(), "13"); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"postalcode:data[0]", "cities:data[0]"}
+	if !reflect.DeepEqual(fields, want) {
+		t.Errorf("give: %v, want: %v", fields, want)
+	}
+}
@@ -0,0 +1,82 @@
+package kenall
+
+import (
+	"sync"
+)
+
+type (
+	// A VersionSkewHandler is called when a response reports a different data version
+	// from the previous response of the same endpoint family, e.g. during a data rollout of the kenall service.
+	VersionSkewHandler func(family EndpointFamily, previous, current Version)
+
+	versionedResponse interface {
+		dataVersion() Version
+		markVersionSkewed()
+	}
+
+	versionTracker struct {
+		mu      sync.Mutex
+		last    map[EndpointFamily]Version
+		handler VersionSkewHandler
+	}
+)
+
+func (vt *versionTracker) observe(family EndpointFamily, res interface{}) {
+	vr, ok := res.(versionedResponse)
+	if !ok {
+		return
+	}
+
+	current := vr.dataVersion()
+	if current.IsZero() {
+		return
+	}
+
+	vt.mu.Lock()
+	previous, seen := vt.last[family]
+	vt.last[family] = current
+	handler := vt.handler
+	vt.mu.Unlock()
+
+	if !seen || previous.Equal(current) {
+		return
+	}
+
+	vr.markVersionSkewed()
+
+	if handler != nil {
+		handler(family, previous, current)
+	}
+}
+
+func (r *GetAddressResponse) dataVersion() Version {
+	return r.Version
+}
+
+func (r *GetAddressResponse) markVersionSkewed() {
+	r.VersionSkewed = true
+}
+
+func (r *GetCityResponse) dataVersion() Version {
+	return r.Version
+}
+
+func (r *GetCityResponse) markVersionSkewed() {
+	r.VersionSkewed = true
+}
+
+func (r *GetCorporationResponse) dataVersion() Version {
+	return r.Version
+}
+
+func (r *GetCorporationResponse) markVersionSkewed() {
+	r.VersionSkewed = true
+}
+
+func (r *GetNormalizeAddressResponse) dataVersion() Version {
+	return r.Version
+}
+
+func (r *GetNormalizeAddressResponse) markVersionSkewed() {
+	r.VersionSkewed = true
+}
@@ -0,0 +1,125 @@
+package kenall_test
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/osamingo/go-kenall/v2"
+)
+
+func TestClient_VersionSkew(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := addressResponse
+		if atomic.AddInt32(&requests, 1) > 2 {
+			body = bytes.Replace(body, []byte(`"2021-06-30"`), []byte(`"2021-07-31"`), 1)
+		}
+		if _, err := w.Write(body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	var calls int
+	var gotFamily kenall.EndpointFamily
+	var gotPrevious, gotCurrent kenall.Version
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL), kenall.WithVersionSkewHandler(
+		func(family kenall.EndpointFamily, previous, current kenall.Version) {
+			calls++
+			gotFamily, gotPrevious, gotCurrent = family, previous, current
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantSkewed := []bool{false, false, true, false}
+	for i, want := range wantSkewed {
+		res, err := cli.GetAddress(context.Background(), "1008105")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.VersionSkewed != want {
+			t.Errorf("response %d, give: %v, want: %v", i, res.VersionSkewed, want)
+		}
+	}
+
+	if calls != 1 {
+		t.Fatalf("give: %v, want: %v", calls, 1)
+	}
+	if gotFamily != kenall.EndpointFamilyPostalCode {
+		t.Errorf("give: %v, want: %v", gotFamily, kenall.EndpointFamilyPostalCode)
+	}
+	if want := time.Date(2021, 6, 30, 0, 0, 0, 0, time.UTC); !time.Time(gotPrevious).Equal(want) {
+		t.Errorf("give: %v, want: %v", time.Time(gotPrevious), want)
+	}
+	if want := time.Date(2021, 7, 31, 0, 0, 0, 0, time.UTC); !time.Time(gotCurrent).Equal(want) {
+		t.Errorf("give: %v, want: %v", time.Time(gotCurrent), want)
+	}
+}
+
+func TestClient_HolidaysVersion(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body []byte
+
+		switch r.URL.Path {
+		case "/holidays":
+			if r.URL.Query().Get("year") == "2022" {
+				body = bytes.Replace(holidaysResponse, []byte(`"data"`), []byte(`"version":"2022-02-01","data"`), 1)
+			} else {
+				w.Header().Set("Last-Modified", "Mon, 31 Jan 2022 16:00:00 GMT")
+				body = holidaysResponse
+			}
+		case "/businessdays/check":
+			w.Header().Set("Date", "Tue, 01 Feb 2022 03:00:00 GMT")
+			body = businessDaysResponse
+		}
+
+		if _, err := w.Write(body); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cli, err := kenall.NewClient("opencollector", kenall.WithEndpoint(srv.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	byYear, err := cli.GetHolidaysByYear(ctx, 2022)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC); !time.Time(byYear.Version).Equal(want) {
+		t.Errorf("give: %v, want: %v", time.Time(byYear.Version), want)
+	}
+
+	all, err := cli.GetHolidays(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !all.Version.IsZero() {
+		t.Errorf("give: %v, want: the zero version without the version in the body", time.Time(all.Version))
+	}
+	if want := time.Date(2022, 1, 31, 16, 0, 0, 0, time.UTC); !all.LastModified.Equal(want) {
+		t.Errorf("give: %v, want: %v", all.LastModified, want)
+	}
+
+	bd, err := cli.GetBusinessDays(ctx, time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bd.Version.IsZero() || !bd.LastModified.IsZero() {
+		t.Errorf("give: %v, %v, want: the zero version and time without the headers", time.Time(bd.Version), bd.LastModified)
+	}
+}